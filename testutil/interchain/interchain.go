@@ -0,0 +1,303 @@
+package interchain
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v2/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v2/testing"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmoscmd"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmdb "github.com/tendermint/tm-db"
+
+	"interchange/app"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+const (
+	// MarsChainID and VenusChainID mirror the chains of mars.yml and venus.yml
+	MarsChainID  = "mars"
+	VenusChainID = "venus"
+
+	// DefaultTimeout is the timeout given to the packets sent by the tests
+	DefaultTimeout = time.Hour
+)
+
+// SetupTestingApp creates an in-memory app for the ibc-go testing package
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	encoding := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+	testingApp := app.New(
+		log.NewNopLogger(), tmdb.NewMemDB(), nil, true, map[int64]bool{}, app.DefaultNodeHome, 0,
+		encoding,
+		simapp.EmptyAppOptions{},
+	).(*app.App)
+	return testingApp, app.NewDefaultGenesisState(encoding.Marshaler)
+}
+
+// Harness runs the mars and venus chains in process, connected by a dex channel.
+// Packets are only relayed when the test asks for it, so the flows are deterministic.
+type Harness struct {
+	t *testing.T
+
+	Coordinator *ibctesting.Coordinator
+	Mars        *ibctesting.TestChain
+	Venus       *ibctesting.TestChain
+	Path        *ibctesting.Path
+}
+
+// New starts the two chains and opens a dex channel between them
+func New(t *testing.T) *Harness {
+	ibctesting.DefaultTestingAppInit = SetupTestingApp
+
+	coord := ibctesting.NewCoordinator(t, 0)
+	mars := ibctesting.NewTestChain(t, coord, MarsChainID)
+	venus := ibctesting.NewTestChain(t, coord, VenusChainID)
+	coord.Chains[MarsChainID] = mars
+	coord.Chains[VenusChainID] = venus
+
+	path := ibctesting.NewPath(mars, venus)
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = types.PortID
+		endpoint.ChannelConfig.Version = types.Version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	coord.Setup(path)
+
+	return &Harness{
+		t:           t,
+		Coordinator: coord,
+		Mars:        mars,
+		Venus:       venus,
+		Path:        path,
+	}
+}
+
+// App returns the app of the chain
+func (h *Harness) App(chain *ibctesting.TestChain) *app.App {
+	testingApp, ok := chain.App.(*app.App)
+	require.True(h.t, ok)
+	return testingApp
+}
+
+// Keeper returns the dex keeper of the chain
+func (h *Harness) Keeper(chain *ibctesting.TestChain) keeper.Keeper {
+	return h.App(chain).DexKeeper
+}
+
+// Endpoint returns the dex channel endpoint of the chain
+func (h *Harness) Endpoint(chain *ibctesting.TestChain) *ibctesting.Endpoint {
+	if chain == h.Mars {
+		return h.Path.EndpointA
+	}
+	return h.Path.EndpointB
+}
+
+// Counterparty returns the other chain of the channel
+func (h *Harness) Counterparty(chain *ibctesting.TestChain) *ibctesting.TestChain {
+	return h.Endpoint(chain).Counterparty.Chain
+}
+
+// Address returns the address of the account sending the transactions on the chain
+func (h *Harness) Address(chain *ibctesting.TestChain) string {
+	return chain.SenderAccount.GetAddress().String()
+}
+
+// Fund mints coins to the account sending the transactions on the chain
+func (h *Harness) Fund(chain *ibctesting.TestChain, coins ...sdk.Coin) {
+	ctx := chain.GetContext()
+	bankKeeper := h.App(chain).BankKeeper
+	require.NoError(h.t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(h.t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, chain.SenderAccount.GetAddress(), coins))
+	h.Coordinator.CommitBlock(chain)
+}
+
+// Balance returns the balance of the account sending the transactions on the chain
+func (h *Harness) Balance(chain *ibctesting.TestChain, denom string) int64 {
+	return h.App(chain).BankKeeper.GetBalance(chain.GetContext(), chain.SenderAccount.GetAddress(), denom).Amount.Int64()
+}
+
+// Escrow returns the tokens of the chain locked in the escrow account of the dex channel
+func (h *Harness) Escrow(chain *ibctesting.TestChain, denom string) int64 {
+	endpoint := h.Endpoint(chain)
+	escrowAddress := ibctransfertypes.GetEscrowAddress(endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	return h.App(chain).BankKeeper.GetBalance(chain.GetContext(), escrowAddress, denom).Amount.Int64()
+}
+
+// VoucherDenom returns the denom of the vouchers minted on the chain for a token of the counterparty
+func (h *Harness) VoucherDenom(chain *ibctesting.TestChain, denom string) string {
+	endpoint := h.Endpoint(chain)
+	return keeper.VoucherDenom(endpoint.ChannelConfig.PortID, endpoint.ChannelID, denom)
+}
+
+// TimeoutTimestamp returns a packet timeout DefaultTimeout after the current time
+func (h *Harness) TimeoutTimestamp() uint64 {
+	return uint64(h.Coordinator.CurrentTime.Add(DefaultTimeout).UnixNano())
+}
+
+// Send delivers the messages on the chain and returns the packets they sent.
+// The ibc-go testing package fails the test if the transaction fails.
+func (h *Harness) Send(chain *ibctesting.TestChain, msgs ...sdk.Msg) ([]channeltypes.Packet, error) {
+	res, err := chain.SendMsgs(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	// The counterparty needs the new block to prove the packet commitments
+	if err := h.Endpoint(h.Counterparty(chain)).UpdateClient(); err != nil {
+		return nil, err
+	}
+	return ParsePackets(res.Events)
+}
+
+// Relay receives on the counterparty a packet sent from the chain, then acknowledges it on the chain.
+// It returns the written acknowledgement.
+func (h *Harness) Relay(chain *ibctesting.TestChain, packet channeltypes.Packet) (channeltypes.Acknowledgement, error) {
+	ack, _, err := h.RelayNext(chain, packet)
+	return ack, err
+}
+
+// RelayNext relays a packet like Relay and also returns the packets sent by the chain
+// when it handles the acknowledgement
+func (h *Harness) RelayNext(chain *ibctesting.TestChain, packet channeltypes.Packet) (channeltypes.Acknowledgement, []channeltypes.Packet, error) {
+	var ack channeltypes.Acknowledgement
+
+	endpoint := h.Endpoint(chain)
+	counterparty := endpoint.Counterparty
+
+	// パケットを相手方チェーンで受信する
+	proof, proofHeight := chain.QueryProof(host.PacketCommitmentKey(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+	recv := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, h.Address(counterparty.Chain))
+	res, err := counterparty.Chain.SendMsgs(recv)
+	if err != nil {
+		return ack, nil, err
+	}
+	ackBytes, err := ParseAck(res.Events)
+	if err != nil {
+		return ack, nil, err
+	}
+	if err := endpoint.UpdateClient(); err != nil {
+		return ack, nil, err
+	}
+
+	// 確認応答を送信元チェーンに返す
+	proof, proofHeight = counterparty.Chain.QueryProof(host.PacketAcknowledgementKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence))
+	acknowledge := channeltypes.NewMsgAcknowledgement(packet, ackBytes, proof, proofHeight, h.Address(chain))
+	res, err = chain.SendMsgs(acknowledge)
+	if err != nil {
+		return ack, nil, err
+	}
+	if err := counterparty.UpdateClient(); err != nil {
+		return ack, nil, err
+	}
+	next, err := ParsePackets(res.Events)
+	if err != nil {
+		return ack, nil, err
+	}
+
+	if err := types.ModuleCdc.UnmarshalJSON(ackBytes, &ack); err != nil {
+		return ack, nil, err
+	}
+	return ack, next, nil
+}
+
+// RelayAll relays the packets in order and returns their acknowledgements
+func (h *Harness) RelayAll(chain *ibctesting.TestChain, packets []channeltypes.Packet) []channeltypes.Acknowledgement {
+	acks := make([]channeltypes.Acknowledgement, len(packets))
+	for i, packet := range packets {
+		ack, err := h.Relay(chain, packet)
+		require.NoError(h.t, err)
+		acks[i] = ack
+	}
+	return acks
+}
+
+// Timeout lets the packet sent from the chain expire on the counterparty and times it out on the chain
+func (h *Harness) Timeout(chain *ibctesting.TestChain, packet channeltypes.Packet) error {
+	endpoint := h.Endpoint(chain)
+
+	//相手方チェーンの時刻をタイムアウト以降に進める
+	timeout := time.Unix(0, int64(packet.TimeoutTimestamp))
+	if h.Coordinator.CurrentTime.Before(timeout) {
+		h.Coordinator.IncrementTimeBy(timeout.Sub(h.Coordinator.CurrentTime) + time.Second)
+	}
+	h.Coordinator.CommitBlock(endpoint.Counterparty.Chain)
+	if err := endpoint.UpdateClient(); err != nil {
+		return err
+	}
+
+	if err := endpoint.TimeoutPacket(packet); err != nil {
+		return err
+	}
+	return endpoint.Counterparty.UpdateClient()
+}
+
+// ParsePackets returns the packets sent by the events of a transaction
+func ParsePackets(events []abci.Event) ([]channeltypes.Packet, error) {
+	var packets []channeltypes.Packet
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+		attributes := eventAttributes(event)
+
+		data, err := hex.DecodeString(attributes[channeltypes.AttributeKeyDataHex])
+		if err != nil {
+			return nil, err
+		}
+		sequence, err := strconv.ParseUint(attributes[channeltypes.AttributeKeySequence], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		timeoutHeight, err := clienttypes.ParseHeight(attributes[channeltypes.AttributeKeyTimeoutHeight])
+		if err != nil {
+			return nil, err
+		}
+		timeoutTimestamp, err := strconv.ParseUint(attributes[channeltypes.AttributeKeyTimeoutTimestamp], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		packets = append(packets, channeltypes.NewPacket(
+			data,
+			sequence,
+			attributes[channeltypes.AttributeKeySrcPort],
+			attributes[channeltypes.AttributeKeySrcChannel],
+			attributes[channeltypes.AttributeKeyDstPort],
+			attributes[channeltypes.AttributeKeyDstChannel],
+			timeoutHeight,
+			timeoutTimestamp,
+		))
+	}
+	return packets, nil
+}
+
+// ParseAck returns the acknowledgement written by the events of a transaction
+func ParseAck(events []abci.Event) ([]byte, error) {
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeWriteAck {
+			continue
+		}
+		return hex.DecodeString(eventAttributes(event)[channeltypes.AttributeKeyAckHex])
+	}
+	return nil, fmt.Errorf("no acknowledgement written")
+}
+
+func eventAttributes(event abci.Event) map[string]string {
+	attributes := make(map[string]string, len(event.Attributes))
+	for _, attribute := range event.Attributes {
+		attributes[string(attribute.Key)] = string(attribute.Value)
+	}
+	return attributes
+}
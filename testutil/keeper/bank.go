@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+// BankFixture is a dex keeper wired to in-memory auth and bank keepers,
+// so the token movements of the keeper can be asserted on real balances
+type BankFixture struct {
+	t testing.TB
+
+	Keeper        *keeper.Keeper
+	Ctx           sdk.Context
+	AccountKeeper authkeeper.AccountKeeper
+	BankKeeper    bankkeeper.BaseKeeper
+}
+
+// DexKeeperWithBank creates a dex keeper with real auth and bank keepers
+func DexKeeperWithBank(t testing.TB) *BankFixture {
+	logger := log.NewNopLogger()
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+	authStoreKey := sdk.NewKVStoreKey(authtypes.StoreKey)
+	bankStoreKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	paramsStoreKey := sdk.NewKVStoreKey(typesparams.StoreKey)
+	paramsTStoreKey := sdk.NewTransientStoreKey(typesparams.TStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(memStoreKey, sdk.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(authStoreKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(bankStoreKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(paramsStoreKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(paramsTStoreKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+	appCodec := codec.NewProtoCodec(registry)
+	amino := codec.NewLegacyAmino()
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		appCodec,
+		authStoreKey,
+		typesparams.NewSubspace(appCodec, amino, paramsStoreKey, paramsTStoreKey, authtypes.ModuleName),
+		authtypes.ProtoBaseAccount,
+		map[string][]string{
+			minttypes.ModuleName:  {authtypes.Minter},
+			distrtypes.ModuleName: nil,
+			types.ModuleName:      {authtypes.Minter, authtypes.Burner},
+		},
+	)
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		appCodec,
+		bankStoreKey,
+		accountKeeper,
+		typesparams.NewSubspace(appCodec, amino, paramsStoreKey, paramsTStoreKey, banktypes.ModuleName),
+		nil,
+	)
+	k := newDexKeeper(appCodec, storeKey, memStoreKey, bankKeeper, communityPool{bankKeeper})
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, logger)
+
+	// Initialize params
+	accountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	bankKeeper.SetParams(ctx, banktypes.DefaultParams())
+	k.SetParams(ctx, types.DefaultParams())
+
+	return &BankFixture{
+		t:             t,
+		Keeper:        k,
+		Ctx:           ctx,
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+	}
+}
+
+// Fund mints coins to the address
+func (f *BankFixture) Fund(addr sdk.AccAddress, coins ...sdk.Coin) {
+	require.NoError(f.t, f.BankKeeper.MintCoins(f.Ctx, minttypes.ModuleName, coins))
+	require.NoError(f.t, f.BankKeeper.SendCoinsFromModuleToAccount(f.Ctx, minttypes.ModuleName, addr, coins))
+}
+
+// FundCommunityPool mints coins to the community pool
+func (f *BankFixture) FundCommunityPool(coins ...sdk.Coin) {
+	require.NoError(f.t, f.BankKeeper.MintCoins(f.Ctx, minttypes.ModuleName, coins))
+	require.NoError(f.t, f.BankKeeper.SendCoinsFromModuleToModule(f.Ctx, minttypes.ModuleName, distrtypes.ModuleName, coins))
+}
+
+// FundEscrow mints coins to the escrow address of the channel
+func (f *BankFixture) FundEscrow(port string, channel string, coins ...sdk.Coin) {
+	f.Fund(EscrowAddress(port, channel), coins...)
+}
+
+// RequireBalance asserts the balance of the address in the denom
+func (f *BankFixture) RequireBalance(addr sdk.AccAddress, denom string, amount int64) {
+	require.True(f.t,
+		f.BankKeeper.GetBalance(f.Ctx, addr, denom).Amount.Equal(sdk.NewInt(amount)),
+		"balance of %s is %s, expected %d%s", addr, f.BankKeeper.GetBalance(f.Ctx, addr, denom), amount, denom,
+	)
+}
+
+// RequireEscrow asserts the balance locked in the escrow address of the channel
+func (f *BankFixture) RequireEscrow(port string, channel string, denom string, amount int64) {
+	f.RequireBalance(EscrowAddress(port, channel), denom, amount)
+}
+
+// RequireSupply asserts the total supply of the denom
+func (f *BankFixture) RequireSupply(denom string, amount int64) {
+	require.True(f.t,
+		f.BankKeeper.GetSupply(f.Ctx, denom).Amount.Equal(sdk.NewInt(amount)),
+		"supply is %s, expected %d%s", f.BankKeeper.GetSupply(f.Ctx, denom), amount, denom,
+	)
+}
+
+// EscrowAddress returns the address locking the native tokens sent through the channel
+func EscrowAddress(port string, channel string) sdk.AccAddress {
+	return ibctransfertypes.GetEscrowAddress(port, channel)
+}
+
+// communityPool spends the balance of the distribution module account like the community pool
+type communityPool struct {
+	bankKeeper bankkeeper.BaseKeeper
+}
+
+// DistributeFromFeePool sends coins of the community pool to the address
+func (p communityPool) DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error {
+	return p.bankKeeper.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, receiveAddr, amount)
+}
+
+// FundCommunityPool sends coins of the sender to the community pool
+func (p communityPool) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return p.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, distrtypes.ModuleName, amount)
+}
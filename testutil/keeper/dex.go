@@ -33,6 +33,18 @@ func DexKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 
 	registry := codectypes.NewInterfaceRegistry()
 	appCodec := codec.NewProtoCodec(registry)
+	k := newDexKeeper(appCodec, storeKey, memStoreKey, nil, nil)
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, logger)
+
+	// Initialize params
+	k.SetParams(ctx, types.DefaultParams())
+
+	return k, ctx
+}
+
+// newDexKeeper creates a dex keeper whose IBC keepers share the dex stores
+func newDexKeeper(appCodec codec.Codec, storeKey *sdk.KVStoreKey, memStoreKey *storetypes.MemoryStoreKey, bankKeeper types.BankKeeper, distrKeeper types.DistributionKeeper) *keeper.Keeper {
 	capabilityKeeper := capabilitykeeper.NewKeeper(appCodec, storeKey, memStoreKey)
 
 	ss := typesparams.NewSubspace(appCodec,
@@ -56,7 +68,7 @@ func DexKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		memStoreKey,
 		"DexParams",
 	)
-	k := keeper.NewKeeper(
+	return keeper.NewKeeper(
 		appCodec,
 		storeKey,
 		memStoreKey,
@@ -64,13 +76,8 @@ func DexKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		IBCKeeper.ChannelKeeper,
 		&IBCKeeper.PortKeeper,
 		capabilityKeeper.ScopeToModule("DexScopedKeeper"),
+		bankKeeper,
 		nil,
+		distrKeeper,
 	)
-
-	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, logger)
-
-	// Initialize params
-	k.SetParams(ctx, types.DefaultParams())
-
-	return k, ctx
 }
@@ -31,6 +31,20 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowBuyOrderBook())
 	cmd.AddCommand(CmdListDenomTrace())
 	cmd.AddCommand(CmdShowDenomTrace())
+	cmd.AddCommand(CmdDenomTraceByHash())
+	cmd.AddCommand(CmdPendingOrders())
+	cmd.AddCommand(CmdRateLimitQuota())
+	cmd.AddCommand(CmdListTriggerOrder())
+	cmd.AddCommand(CmdShowTriggerOrder())
+	cmd.AddCommand(CmdCircuitBreaker())
+	cmd.AddCommand(CmdSimulateOrder())
+	cmd.AddCommand(CmdCandles())
+	cmd.AddCommand(CmdTicker())
+	cmd.AddCommand(CmdTWAP())
+	cmd.AddCommand(CmdShowIncentivePool())
+	cmd.AddCommand(CmdShowMakerReward())
+	cmd.AddCommand(CmdShowLiquidityPool())
+	cmd.AddCommand(CmdRoute())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
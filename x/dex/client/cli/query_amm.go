@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+func CmdShowLiquidityPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-liquidity-pool [pair-index]",
+		Short: "shows the liquidity pool of a pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetLiquidityPoolRequest{
+				PairIndex: args[0],
+			}
+
+			res, err := queryClient.LiquidityPool(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
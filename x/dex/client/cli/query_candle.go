@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+func CmdCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "candles [pair-index] [interval]",
+		Short: "list the candles of a pair for an interval, like 1m, 1h or 24h",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			interval, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryCandlesRequest{
+				PairIndex:  args[0],
+				Interval:   interval,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Candles(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdTicker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ticker [pair-index]",
+		Short: "shows the market stats of a pair over the last 24 hours",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryTickerRequest{
+				PairIndex: args[0],
+			}
+
+			res, err := queryClient.Ticker(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,76 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"interchange/testutil/network"
+	"interchange/x/dex/client/cli"
+	"interchange/x/dex/types"
+)
+
+func TestCandlesAndTicker(t *testing.T) {
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "marscoin", "venuscoin")
+	// The candles are recent enough not to be pruned by the blocks of the network
+	openTime := time.Now().UTC().Truncate(time.Minute).Add(-time.Minute)
+	candles := []types.Candle{
+		types.NewCandle(pairIndex, time.Minute, openTime, 10),
+		types.NewCandle(pairIndex, time.Minute, openTime.Add(time.Minute), 12),
+	}
+	state.CandleList = append(state.CandleList, candles...)
+	state.LastPriceList = append(state.LastPriceList, types.LastPrice{Index: pairIndex, Price: 12})
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	net := network.New(t, cfg)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	t.Run("Candles", func(t *testing.T) {
+		args := append([]string{pairIndex, "1m"}, common...)
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdCandles(), args)
+		require.NoError(t, err)
+		var resp types.QueryCandlesResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, candles, resp.Candles)
+	})
+	t.Run("CandlesOtherInterval", func(t *testing.T) {
+		args := append([]string{pairIndex, "1h"}, common...)
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdCandles(), args)
+		require.NoError(t, err)
+		var resp types.QueryCandlesResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Empty(t, resp.Candles)
+	})
+	t.Run("Ticker", func(t *testing.T) {
+		args := append([]string{pairIndex}, common...)
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTicker(), args)
+		require.NoError(t, err)
+		var resp types.QueryTickerResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, types.Ticker{
+			PairIndex:  pairIndex,
+			LastPrice:  12,
+			Open:       12,
+			High:       12,
+			Low:        12,
+			ChangeRate: sdk.ZeroDec(),
+		}, resp.Ticker)
+	})
+	t.Run("TickerNotFound", func(t *testing.T) {
+		args := append([]string{"other"}, common...)
+		_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTicker(), args)
+		require.Error(t, err)
+	})
+}
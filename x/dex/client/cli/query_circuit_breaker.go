@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+func CmdCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker",
+		Short: "shows the message and packet types disabled by the circuit breaker",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CircuitBreaker(context.Background(), &types.QueryCircuitBreakerRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
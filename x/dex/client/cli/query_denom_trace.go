@@ -71,3 +71,32 @@ func CmdShowDenomTrace() *cobra.Command {
 
 	return cmd
 }
+
+func CmdDenomTraceByHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-trace-by-hash [hash]",
+		Short:   "resolves a voucher to the denom path on its origin chain",
+		Example: "denom-trace-by-hash ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDenomTraceByHashRequest{
+				Hash: args[0],
+			}
+
+			res, err := queryClient.DenomTraceByHash(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli_test
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -160,3 +161,61 @@ func TestListDenomTrace(t *testing.T) {
 		)
 	})
 }
+
+func TestDenomTraceByHash(t *testing.T) {
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+	obj := types.NewDenomTrace("dex", "channel-0", "transfer/channel-1/uatom", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2")
+	state.DenomTraceList = append(state.DenomTraceList, obj)
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	net := network.New(t, cfg)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc string
+		hash string
+		err  error
+	}{
+		{
+			desc: "voucher",
+			hash: obj.Index,
+		},
+		{
+			desc: "hash",
+			hash: strings.ToLower(strings.TrimPrefix(obj.Index, "ibc/")),
+		},
+		{
+			desc: "not found",
+			hash: strings.Repeat("A", 64),
+			err:  status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "invalid hash",
+			hash: "stake",
+			err:  status.Error(codes.InvalidArgument, "invalid denom trace hash"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			args := append([]string{tc.hash}, common...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdDenomTraceByHash(), args)
+			if tc.err != nil {
+				stat, ok := status.FromError(tc.err)
+				require.True(t, ok)
+				require.Contains(t, err.Error(), stat.Message())
+			} else {
+				require.NoError(t, err)
+				var resp types.QueryDenomTraceByHashResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.Equal(t, obj, resp.DenomTrace)
+				require.Equal(t, "dex/channel-0/transfer/channel-1/uatom", resp.FullDenomPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+func CmdShowIncentivePool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-incentive-pool [pair-index]",
+		Short: "shows the incentive pool of a pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetIncentivePoolRequest{
+				PairIndex: args[0],
+			}
+
+			res, err := queryClient.IncentivePool(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowMakerReward() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-maker-reward [address]",
+		Short: "shows the liquidity mining rewards accrued by a maker",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetMakerRewardRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.MakerReward(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
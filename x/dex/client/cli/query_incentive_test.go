@@ -0,0 +1,63 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"interchange/testutil/network"
+	"interchange/testutil/nullify"
+	"interchange/testutil/sample"
+	"interchange/x/dex/client/cli"
+	"interchange/x/dex/types"
+)
+
+func TestIncentiveQueries(t *testing.T) {
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+	pool := types.IncentivePool{
+		PairIndex: types.OrderBookIndex("dex", "channel-0", "marscoin", "venuscoin"),
+		Balance:   sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+	}
+	reward := types.MakerReward{
+		Address: sample.AccAddress(),
+		Rewards: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	}
+	state.IncentivePoolList = append(state.IncentivePoolList, pool)
+	state.MakerRewardList = append(state.MakerRewardList, reward)
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	net := network.New(t, cfg)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	t.Run("incentive pool", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowIncentivePool(), append([]string{pool.PairIndex}, common...))
+		require.NoError(t, err)
+		var resp types.QueryGetIncentivePoolResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, nullify.Fill(&pool), nullify.Fill(&resp.IncentivePool))
+
+		_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdShowIncentivePool(), append([]string{"unknown"}, common...))
+		require.Error(t, err)
+	})
+	t.Run("maker reward", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowMakerReward(), append([]string{reward.Address}, common...))
+		require.NoError(t, err)
+		var resp types.QueryGetMakerRewardResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, nullify.Fill(&reward), nullify.Fill(&resp.MakerReward))
+
+		_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdShowMakerReward(), append([]string{sample.AccAddress()}, common...))
+		require.Error(t, err)
+	})
+}
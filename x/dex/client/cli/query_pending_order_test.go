@@ -0,0 +1,89 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"interchange/testutil/network"
+	"interchange/testutil/nullify"
+	"interchange/testutil/sample"
+	"interchange/x/dex/client/cli"
+	"interchange/x/dex/types"
+)
+
+func networkWithPendingOrderObjects(t *testing.T, owner string, n int) (*network.Network, []types.PendingOrder) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	for i := 0; i < n; i++ {
+		pendingOrder := types.PendingOrder{
+			Port:     "dex",
+			Channel:  "channel-0",
+			Sequence: uint64(i),
+			Owner:    owner,
+		}
+		nullify.Fill(&pendingOrder)
+		state.PendingOrderList = append(state.PendingOrderList, pendingOrder)
+	}
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), state.PendingOrderList
+}
+
+func TestPendingOrders(t *testing.T) {
+	owner := sample.AccAddress()
+	net, objs := networkWithPendingOrderObjects(t, owner, 5)
+
+	ctx := net.Validators[0].ClientCtx
+	request := func(next []byte, offset, limit uint64, total bool) []string {
+		args := []string{
+			owner,
+			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		}
+		if next == nil {
+			args = append(args, fmt.Sprintf("--%s=%d", flags.FlagOffset, offset))
+		} else {
+			args = append(args, fmt.Sprintf("--%s=%s", flags.FlagPageKey, next))
+		}
+		args = append(args, fmt.Sprintf("--%s=%d", flags.FlagLimit, limit))
+		if total {
+			args = append(args, fmt.Sprintf("--%s", flags.FlagCountTotal))
+		}
+		return args
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(objs); i += step {
+			args := request(nil, uint64(i), uint64(step), false)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdPendingOrders(), args)
+			require.NoError(t, err)
+			var resp types.QueryPendingOrdersResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.LessOrEqual(t, len(resp.PendingOrders), step)
+			require.Subset(t,
+				nullify.Fill(objs),
+				nullify.Fill(resp.PendingOrders),
+			)
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		args := request(nil, 0, uint64(len(objs)), true)
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdPendingOrders(), args)
+		require.NoError(t, err)
+		var resp types.QueryPendingOrdersResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, len(objs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(objs),
+			nullify.Fill(resp.PendingOrders),
+		)
+	})
+}
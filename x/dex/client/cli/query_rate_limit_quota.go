@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+func CmdRateLimitQuota() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limit-quota [port] [channel] [denom]",
+		Short: "shows the outflow quota left for a port, channel and denom",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRateLimitQuotaRequest{
+				Port:    args[0],
+				Channel: args[1],
+				Denom:   args[2],
+			}
+
+			res, err := queryClient.RateLimitQuota(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
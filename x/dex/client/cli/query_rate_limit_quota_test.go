@@ -0,0 +1,69 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"interchange/testutil/network"
+	"interchange/x/dex/client/cli"
+	"interchange/x/dex/types"
+)
+
+func TestRateLimitQuota(t *testing.T) {
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+	rateLimit := types.RateLimit{Port: "dex", Channel: "channel-0", Denom: "stake", MaxOutflow: 100, Window: time.Hour}
+	state.Params.RateLimits = []types.RateLimit{rateLimit}
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	net := network.New(t, cfg)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc    string
+		channel string
+		denom   string
+		err     error
+	}{
+		{
+			desc:    "found",
+			channel: "channel-0",
+			denom:   "stake",
+		},
+		{
+			desc:    "not found",
+			channel: "channel-0",
+			denom:   "token",
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			args := append([]string{"dex", tc.channel, tc.denom}, common...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdRateLimitQuota(), args)
+			if tc.err != nil {
+				stat, ok := status.FromError(tc.err)
+				require.True(t, ok)
+				require.ErrorIs(t, stat.Err(), tc.err)
+			} else {
+				require.NoError(t, err)
+				var resp types.QueryRateLimitQuotaResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.Equal(t, rateLimit, resp.RateLimit)
+				require.Equal(t, int64(100), resp.Remaining)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+func CmdRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "route [from-denom] [to-denom] [amount]",
+		Short: "shows the routes of one or two legs between two denoms of the sending chain over the order books of this chain, best first",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			amount, err := cast.ToInt64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRouteRequest{
+				FromDenom: args[0],
+				ToDenom:   args[1],
+				Amount:    amount,
+			}
+
+			res, err := queryClient.Route(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
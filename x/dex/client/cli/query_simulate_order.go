@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+const flagCreator = "creator"
+
+func CmdSimulateOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-order [order-type] [pair-index] [amount] [price]",
+		Short: "shows how a sell or buy order would fill against the order book of this chain, without placing it",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			amount, err := cast.ToInt32E(args[2])
+			if err != nil {
+				return err
+			}
+			price, err := cast.ToInt32E(args[3])
+			if err != nil {
+				return err
+			}
+			creator, err := cmd.Flags().GetString(flagCreator)
+			if err != nil {
+				return err
+			}
+			stp, err := getSelfTradePrevention(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySimulateOrderRequest{
+				OrderType:           args[0],
+				PairIndex:           args[1],
+				Amount:              amount,
+				Price:               price,
+				Creator:             creator,
+				SelfTradePrevention: stp,
+			}
+
+			res, err := queryClient.SimulateOrder(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagCreator, "", "Creator of the order, to apply the self-trade prevention")
+	addSelfTradePreventionFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,90 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"interchange/testutil/network"
+	"interchange/x/dex/client/cli"
+	"interchange/x/dex/types"
+)
+
+func TestSimulateOrder(t *testing.T) {
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "marscoin", "venuscoin")
+	book := types.NewBuyOrderBook("marscoin", "venuscoin")
+	book.Index = pairIndex
+	_, err := book.AppendOrder("alice", 10, 5, 0)
+	require.NoError(t, err)
+	state.BuyOrderBookList = append(state.BuyOrderBookList, book)
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	net := network.New(t, cfg)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc     string
+		args     []string
+		response types.QuerySimulateOrderResponse
+		err      error
+	}{
+		{
+			desc: "filled",
+			args: []string{types.OrderTypeSell, pairIndex, "15", "4"},
+			response: types.QuerySimulateOrderResponse{
+				Fills:           []types.Order{{Id: 0, Creator: "alice", Amount: 10, Price: 5}},
+				FilledAmount:    10,
+				Total:           50,
+				AveragePrice:    sdk.NewDec(5),
+				RemainingAmount: 5,
+			},
+		},
+		{
+			desc: "self-trade prevention",
+			args: []string{types.OrderTypeSell, pairIndex, "15", "4", "--creator=alice", "--self-trade-prevention=cancel-newest"},
+			response: types.QuerySimulateOrderResponse{
+				AveragePrice:    sdk.ZeroDec(),
+				PreventedAmount: 15,
+			},
+		},
+		{
+			desc: "not found",
+			args: []string{types.OrderTypeBuy, pairIndex, "15", "4"},
+			err:  status.Error(codes.NotFound, "not found"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			args := append(tc.args, common...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdSimulateOrder(), args)
+			if tc.err != nil {
+				stat, ok := status.FromError(tc.err)
+				require.True(t, ok)
+				require.ErrorIs(t, stat.Err(), tc.err)
+			} else {
+				require.NoError(t, err)
+				var resp types.QuerySimulateOrderResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.ElementsMatch(t, tc.response.Fills, resp.Fills)
+				require.Equal(t, tc.response.FilledAmount, resp.FilledAmount)
+				require.Equal(t, tc.response.Total, resp.Total)
+				require.True(t, tc.response.AveragePrice.Equal(resp.AveragePrice))
+				require.Equal(t, tc.response.RemainingAmount, resp.RemainingAmount)
+				require.Equal(t, tc.response.PreventedAmount, resp.PreventedAmount)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+func CmdTWAP() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [pair-index] [start-time] [end-time]",
+		Short: "shows the time weighted average price of a pair between two RFC3339 times, until the last block if the end is omitted",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			startTime, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+			var endTime time.Time
+			if len(args) > 2 {
+				endTime, err = time.Parse(time.RFC3339, args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryTWAPRequest{
+				PairIndex: args[0],
+				StartTime: startTime,
+				EndTime:   endTime,
+			}
+
+			res, err := queryClient.TWAP(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,78 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"interchange/testutil/network"
+	"interchange/x/dex/client/cli"
+	"interchange/x/dex/types"
+)
+
+func TestTWAP(t *testing.T) {
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "marscoin", "venuscoin")
+	recordTime := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	state.TwapRecordList = append(state.TwapRecordList,
+		types.TWAPRecord{PairIndex: pairIndex, Time: recordTime, Price: 10, CumulativePrice: sdk.ZeroDec()},
+		types.TWAPRecord{PairIndex: pairIndex, Time: recordTime.Add(10 * time.Minute), Price: 20, CumulativePrice: sdk.NewDec(6000)},
+	)
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	net := network.New(t, cfg)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc string
+		args []string
+		twap sdk.Dec
+		err  bool
+	}{
+		{
+			desc: "with end time",
+			args: []string{pairIndex, recordTime.Format(time.RFC3339), recordTime.Add(20 * time.Minute).Format(time.RFC3339)},
+			twap: sdk.NewDec(15),
+		},
+		{
+			desc: "until last block",
+			args: []string{pairIndex, recordTime.Add(10 * time.Minute).Format(time.RFC3339)},
+			twap: sdk.NewDec(20),
+		},
+		{
+			desc: "no trade",
+			args: []string{"other", recordTime.Format(time.RFC3339)},
+			err:  true,
+		},
+		{
+			desc: "invalid time",
+			args: []string{pairIndex, "yesterday"},
+			err:  true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			args := append(tc.args, common...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTWAP(), args)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var resp types.QueryTWAPResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.True(t, tc.twap.Equal(resp.Twap), "expected %s, got %s", tc.twap, resp.Twap)
+		})
+	}
+}
@@ -17,9 +17,43 @@ var (
 
 const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagMsgTypes               = "msg-types"
+	flagPacketTypes            = "packet-types"
+	flagOrders                 = "orders"
+	flagCancelOrderIDs         = "cancel-order-ids"
+	flagOrderType              = "order-type"
+	flagAmountDenom            = "amount-denom"
+	flagPriceDenom             = "price-denom"
+	flagSelfTradePrevention    = "self-trade-prevention"
 	listSeparator              = ","
 )
 
+// selfTradePreventions maps the self-trade prevention modes accepted by the CLI
+var selfTradePreventions = map[string]types.SelfTradePrevention{
+	"none":           types.SelfTradeAllowed,
+	"cancel-newest":  types.CancelNewest,
+	"cancel-oldest":  types.CancelOldest,
+	"decrement-both": types.DecrementBoth,
+}
+
+// addSelfTradePreventionFlag adds the flag selecting the self-trade prevention mode of the orders
+func addSelfTradePreventionFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagSelfTradePrevention, "none", "Action when the order matches an order of the same creator: none, cancel-newest, cancel-oldest or decrement-both")
+}
+
+// getSelfTradePrevention returns the self-trade prevention mode selected with the flag
+func getSelfTradePrevention(cmd *cobra.Command) (types.SelfTradePrevention, error) {
+	mode, err := cmd.Flags().GetString(flagSelfTradePrevention)
+	if err != nil {
+		return types.SelfTradeAllowed, err
+	}
+	stp, ok := selfTradePreventions[mode]
+	if !ok {
+		return types.SelfTradeAllowed, fmt.Errorf("invalid self-trade prevention %s, must be none, cancel-newest, cancel-oldest or decrement-both", mode)
+	}
+	return stp, nil
+}
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -35,6 +69,17 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdSendBuyOrder())
 	cmd.AddCommand(CmdCancelSellOrder())
 	cmd.AddCommand(CmdCancelBuyOrder())
+	cmd.AddCommand(CmdBatchOrders())
+	cmd.AddCommand(CmdAmendOrder())
+	cmd.AddCommand(CmdCancelAllOrders())
+	cmd.AddCommand(CmdPlaceTriggerOrder())
+	cmd.AddCommand(CmdCancelTriggerOrder())
+	cmd.AddCommand(CmdSetCircuitBreaker())
+	cmd.AddCommand(CmdFundIncentivePool())
+	cmd.AddCommand(CmdClaimRewards())
+	cmd.AddCommand(CmdAddLiquidity())
+	cmd.AddCommand(CmdRemoveLiquidity())
+	cmd.AddCommand(CmdRoutedOrder())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
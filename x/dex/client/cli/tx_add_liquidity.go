@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+func CmdAddLiquidity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-liquidity [port] [channel] [amount-denom] [price-denom] [amount-deposit] [price-deposit]",
+		Short: "Deposit tokens in the liquidity pool of a pair in exchange of pool shares",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPort := args[0]
+			argChannel := args[1]
+			argAmountDenom := args[2]
+			argPriceDenom := args[3]
+			argAmountDeposit, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("invalid amount deposit %s", args[4])
+			}
+			argPriceDeposit, ok := sdk.NewIntFromString(args[5])
+			if !ok {
+				return fmt.Errorf("invalid price deposit %s", args[5])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddLiquidity(
+				clientCtx.GetFromAddress().String(),
+				argPort,
+				argChannel,
+				argAmountDenom,
+				argPriceDenom,
+				argAmountDeposit,
+				argPriceDeposit,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
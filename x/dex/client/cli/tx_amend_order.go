@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdAmendOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amend-order [port] [channel] [order-type] [amount-denom] [price-denom] [order-id] [amount] [price]",
+		Short: "Change the amount and price of a sell or buy order",
+		Args:  cobra.ExactArgs(8),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPort := args[0]
+			argChannel := args[1]
+			argOrderType := args[2]
+			argAmountDenom := args[3]
+			argPriceDenom := args[4]
+			argOrderID, err := cast.ToInt32E(args[5])
+			if err != nil {
+				return err
+			}
+			argAmount, err := cast.ToInt32E(args[6])
+			if err != nil {
+				return err
+			}
+			argPrice, err := cast.ToInt32E(args[7])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAmendOrder(
+				clientCtx.GetFromAddress().String(),
+				argPort,
+				argChannel,
+				argOrderType,
+				argAmountDenom,
+				argPriceDenom,
+				argOrderID,
+				argAmount,
+				argPrice,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	channelutils "github.com/cosmos/ibc-go/v2/modules/core/04-channel/client/utils"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+func CmdBatchOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-orders [src-port] [src-channel] [order-type] [amount-denom] [price-denom]",
+		Short: "Place and cancel several sell or buy orders of a pair in one transaction",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			srcPort := args[0]
+			srcChannel := args[1]
+			argOrderType := args[2]
+			argAmountDenom := args[3]
+			argPriceDenom := args[4]
+
+			rawOrders, err := cmd.Flags().GetStringSlice(flagOrders)
+			if err != nil {
+				return err
+			}
+			orders, err := parseBatchOrders(rawOrders)
+			if err != nil {
+				return err
+			}
+			cancelOrderIDs, err := cmd.Flags().GetInt32Slice(flagCancelOrderIDs)
+			if err != nil {
+				return err
+			}
+
+			// Get the relative timeout timestamp, only orders are sent over IBC
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			if len(orders) > 0 {
+				consensusState, _, _, err := channelutils.QueryLatestConsensusState(clientCtx, srcPort, srcChannel)
+				if err != nil {
+					return err
+				}
+				if timeoutTimestamp != 0 {
+					timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp
+				}
+			}
+
+			stp, err := getSelfTradePrevention(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchOrders(creator, srcPort, srcChannel, timeoutTimestamp, argOrderType, argAmountDenom, argPriceDenom, orders, cancelOrderIDs)
+			msg.SelfTradePrevention = stp
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagOrders, []string{}, "New orders as amount:price, separated by commas")
+	cmd.Flags().Int32Slice(flagCancelOrderIDs, []int32{}, "IDs of the orders to cancel, separated by commas")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	addSelfTradePreventionFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseBatchOrders parses orders in the amount:price format
+func parseBatchOrders(rawOrders []string) ([]types.BatchOrder, error) {
+	orders := make([]types.BatchOrder, 0, len(rawOrders))
+	for _, rawOrder := range rawOrders {
+		parts := strings.Split(rawOrder, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid order %s, expected amount:price", rawOrder)
+		}
+		amount, err := cast.ToInt32E(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		price, err := cast.ToInt32E(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, types.BatchOrder{Amount: amount, Price: price})
+	}
+	return orders, nil
+}
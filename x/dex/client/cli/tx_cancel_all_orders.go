@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdCancelAllOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-all-orders [port] [channel]",
+		Short: "Cancel all the sell and buy orders of the account on a channel",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPort := args[0]
+			argChannel := args[1]
+
+			argOrderType, err := cmd.Flags().GetString(flagOrderType)
+			if err != nil {
+				return err
+			}
+			argAmountDenom, err := cmd.Flags().GetString(flagAmountDenom)
+			if err != nil {
+				return err
+			}
+			argPriceDenom, err := cmd.Flags().GetString(flagPriceDenom)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelAllOrders(
+				clientCtx.GetFromAddress().String(),
+				argPort,
+				argChannel,
+				argOrderType,
+				argAmountDenom,
+				argPriceDenom,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagOrderType, "", "Only cancel the orders of a side, sell or buy")
+	cmd.Flags().String(flagAmountDenom, "", "Only cancel the orders of the pair with this amount denom")
+	cmd.Flags().String(flagPriceDenom, "", "Only cancel the orders of the pair with this price denom")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
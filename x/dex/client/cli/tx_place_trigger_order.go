@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+var _ = strconv.Itoa(0)
+
+// triggerTypes maps the trigger types accepted by the CLI
+var triggerTypes = map[string]types.TriggerType{
+	"stop-market": types.StopMarket,
+	"stop-limit":  types.StopLimit,
+	"take-profit": types.TakeProfit,
+}
+
+func CmdPlaceTriggerOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-trigger-order [src-port] [src-channel] [order-type] [trigger-type] [amount-denom] [amount] [price-denom] [trigger-price] [price]",
+		Short: "Place a stop-market, stop-limit or take-profit order sent when the last price reaches the trigger price",
+		Args:  cobra.RangeArgs(8, 9),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			srcPort := args[0]
+			srcChannel := args[1]
+			argOrderType := args[2]
+			argTriggerType, ok := triggerTypes[args[3]]
+			if !ok {
+				return fmt.Errorf("invalid trigger type %s, must be stop-market, stop-limit or take-profit", args[3])
+			}
+			argAmountDenom := args[4]
+			argAmount, err := cast.ToInt32E(args[5])
+			if err != nil {
+				return err
+			}
+			argPriceDenom := args[6]
+			argTriggerPrice, err := cast.ToInt32E(args[7])
+			if err != nil {
+				return err
+			}
+			// stop-market sell orders have no price, stop-market buy orders need one bounding their escrow
+			var argPrice int32
+			if len(args) > 8 {
+				argPrice, err = cast.ToInt32E(args[8])
+				if err != nil {
+					return err
+				}
+			}
+
+			packetTimeout, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceTriggerOrder(creator, srcPort, srcChannel, packetTimeout, argOrderType, argTriggerType, argAmountDenom, argPriceDenom, argAmount, argTriggerPrice, argPrice)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Timeout of the packet sent when triggered in nanoseconds. Default is 10 minutes.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
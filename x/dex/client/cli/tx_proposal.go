@@ -0,0 +1,227 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+// submitProposal builds the MsgSubmitProposal of the content with the deposit given in the flags
+func submitProposal(cmd *cobra.Command, clientCtx client.Context, content govtypes.Content) error {
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+// addProposalFlags adds the title, description and deposit flags of a proposal
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
+
+// readProposalFlags returns the title and description of a proposal
+func readProposalFlags(cmd *cobra.Command) (title string, description string, err error) {
+	if title, err = cmd.Flags().GetString(govcli.FlagTitle); err != nil {
+		return
+	}
+	description, err = cmd.Flags().GetString(govcli.FlagDescription)
+	return
+}
+
+func CmdSubmitResetRateLimitQuotaProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-rate-limit-quota [port] [channel] [denom]",
+		Short: "Submit a proposal to reset the outflow quota of a port, channel and denom",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, err := readProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewResetRateLimitQuotaProposal(title, description, args[0], args[1], args[2])
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func CmdSubmitAllowPairCreationProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allow-pair-creation [port] [channel] [source-denom] [target-denom]",
+		Short: "Submit a proposal to allow the creation of a pair",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, err := readProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewAllowPairCreationProposal(title, description, args[0], args[1], args[2], args[3])
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func CmdSubmitDelistPairProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delist-pair [port] [channel] [source-denom] [target-denom]",
+		Short: "Submit a proposal to delist a pair and refund its orders",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, err := readProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewDelistPairProposal(title, description, args[0], args[1], args[2], args[3])
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func CmdSubmitPausePairProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-pair [port] [channel] [source-denom] [target-denom] [paused]",
+		Short: "Submit a proposal to pause or resume the trading of a pair",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, err := readProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			paused, err := strconv.ParseBool(args[4])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewPausePairProposal(title, description, args[0], args[1], args[2], args[3], paused)
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func CmdSubmitCircuitBreakerProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker [tripped]",
+		Short: "Submit a proposal to disable or enable message and packet types",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, err := readProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			tripped, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msgTypes, packetTypes, err := readCircuitBreakerFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCircuitBreakerProposal(title, description, msgTypes, packetTypes, tripped)
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+	addCircuitBreakerFlags(cmd)
+
+	return cmd
+}
+
+func CmdSubmitFundIncentivePoolProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-incentive-pool [pair-index] [amount]",
+		Short: "Submit a proposal to fund the incentive pool of a pair from the community pool",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, err := readProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewFundIncentivePoolProposal(title, description, args[0], amount)
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+func CmdRemoveLiquidity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-liquidity [port] [channel] [amount-denom] [price-denom] [shares]",
+		Short: "Burn pool shares and withdraw their part of the reserves of the liquidity pool of a pair",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPort := args[0]
+			argChannel := args[1]
+			argAmountDenom := args[2]
+			argPriceDenom := args[3]
+			argShares, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("invalid shares %s", args[4])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveLiquidity(
+				clientCtx.GetFromAddress().String(),
+				argPort,
+				argChannel,
+				argAmountDenom,
+				argPriceDenom,
+				argShares,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
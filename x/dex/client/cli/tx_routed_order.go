@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+func CmdRoutedOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "routed-order [amount] [legs]...",
+		Short: "Send the orders of a route one leg after the other, each leg as port:channel:order-type:amount-denom:price-denom:price",
+		Args:  cobra.RangeArgs(2, 1+types.MaxRouteLegs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			argAmount, err := cast.ToInt64E(args[0])
+			if err != nil {
+				return err
+			}
+			legs, err := parseRouteLegs(args[1:])
+			if err != nil {
+				return err
+			}
+
+			packetTimeout, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRoutedOrder(creator, argAmount, legs, packetTimeout)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Timeout of the packet of each leg in nanoseconds. Default is 10 minutes.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseRouteLegs parses legs in the port:channel:order-type:amount-denom:price-denom:price format
+func parseRouteLegs(rawLegs []string) ([]types.RouteLeg, error) {
+	legs := make([]types.RouteLeg, 0, len(rawLegs))
+	for _, rawLeg := range rawLegs {
+		parts := strings.Split(rawLeg, ":")
+		if len(parts) != 6 {
+			return nil, fmt.Errorf("invalid leg %s, expected port:channel:order-type:amount-denom:price-denom:price", rawLeg)
+		}
+		price, err := cast.ToInt32E(parts[5])
+		if err != nil {
+			return nil, err
+		}
+		legs = append(legs, types.RouteLeg{
+			Port:        parts[0],
+			Channel:     parts[1],
+			OrderType:   parts[2],
+			AmountDenom: parts[3],
+			PriceDenom:  parts[4],
+			Price:       price,
+		})
+	}
+	return legs, nil
+}
@@ -53,7 +53,13 @@ func CmdSendSellOrder() *cobra.Command {
 				timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp
 			}
 
+			stp, err := getSelfTradePrevention(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSendSellOrder(creator, srcPort, srcChannel, timeoutTimestamp, argAmountDenom, argAmount, argPriceDenom, argPrice)
+			msg.SelfTradePrevention = stp
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -62,6 +68,7 @@ func CmdSendSellOrder() *cobra.Command {
 	}
 
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	addSelfTradePreventionFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
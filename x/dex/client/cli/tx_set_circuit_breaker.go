@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"interchange/x/dex/types"
+)
+
+// addCircuitBreakerFlags adds the flags listing the message and packet types of the circuit breaker
+func addCircuitBreakerFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(flagMsgTypes, nil, "message type URLs, e.g. /interchange.dex.MsgSendSellOrder")
+	cmd.Flags().StringSlice(flagPacketTypes, nil, "packet types, e.g. sellOrder_packet")
+}
+
+// readCircuitBreakerFlags returns the message and packet types of the circuit breaker
+func readCircuitBreakerFlags(cmd *cobra.Command) (msgTypes []string, packetTypes []string, err error) {
+	if msgTypes, err = cmd.Flags().GetStringSlice(flagMsgTypes); err != nil {
+		return
+	}
+	packetTypes, err = cmd.Flags().GetStringSlice(flagPacketTypes)
+	return
+}
+
+func CmdSetCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-circuit-breaker [tripped]",
+		Short: "Disable or enable message and packet types as the circuit breaker authority",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argTripped, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msgTypes, packetTypes, err := readCircuitBreakerFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCircuitBreaker(
+				clientCtx.GetFromAddress().String(),
+				msgTypes,
+				packetTypes,
+				argTripped,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addCircuitBreakerFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
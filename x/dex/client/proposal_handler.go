@@ -0,0 +1,29 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	"interchange/x/dex/client/cli"
+)
+
+var (
+	ResetRateLimitQuotaProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitResetRateLimitQuotaProposal, emptyRestHandler)
+	AllowPairCreationProposalHandler   = govclient.NewProposalHandler(cli.CmdSubmitAllowPairCreationProposal, emptyRestHandler)
+	PausePairProposalHandler           = govclient.NewProposalHandler(cli.CmdSubmitPausePairProposal, emptyRestHandler)
+	DelistPairProposalHandler          = govclient.NewProposalHandler(cli.CmdSubmitDelistPairProposal, emptyRestHandler)
+	CircuitBreakerProposalHandler      = govclient.NewProposalHandler(cli.CmdSubmitCircuitBreakerProposal, emptyRestHandler)
+	FundIncentivePoolProposalHandler   = govclient.NewProposalHandler(cli.CmdSubmitFundIncentivePoolProposal, emptyRestHandler)
+)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-dex",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for dex proposals")
+		},
+	}
+}
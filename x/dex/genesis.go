@@ -21,6 +21,71 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.DenomTraceList {
 		k.SetDenomTrace(ctx, elem)
 	}
+	// Set all the pendingOrder
+	for _, elem := range genState.PendingOrderList {
+		k.SetPendingOrder(ctx, elem)
+	}
+	// Set all the rateLimitQuota
+	for _, elem := range genState.RateLimitQuotaList {
+		k.SetRateLimitQuota(ctx, elem)
+	}
+	// Set all the pairStatus
+	for _, elem := range genState.PairStatusList {
+		k.SetPairStatus(ctx, elem)
+	}
+	// Set all the tripped circuit breakers
+	for _, elem := range genState.TrippedMsgTypes {
+		k.SetMsgTripped(ctx, elem, true)
+	}
+	for _, elem := range genState.TrippedPacketTypes {
+		k.SetPacketTripped(ctx, elem, true)
+	}
+	// Set all the triggerOrder
+	for _, elem := range genState.TriggerOrderList {
+		k.SetTriggerOrder(ctx, elem)
+	}
+	// Set triggerOrder count
+	k.SetTriggerOrderCount(ctx, genState.TriggerOrderCount)
+	// Set all the lastPrice
+	for _, elem := range genState.LastPriceList {
+		k.SetLastPrice(ctx, elem)
+	}
+	// Set all the orderDeposit
+	for _, elem := range genState.OrderDepositList {
+		k.SetOrderDeposit(ctx, elem)
+	}
+	// Set all the candle
+	for _, elem := range genState.CandleList {
+		k.SetCandle(ctx, elem)
+	}
+	// Set all the tickerBucket
+	for _, elem := range genState.TickerBucketList {
+		k.SetTickerBucket(ctx, elem)
+	}
+	// Set all the twapRecord
+	for _, elem := range genState.TwapRecordList {
+		k.SetTWAPRecord(ctx, elem)
+	}
+	// Set all the incentivePool
+	for _, elem := range genState.IncentivePoolList {
+		k.SetIncentivePool(ctx, elem)
+	}
+	// Set all the makerReward
+	for _, elem := range genState.MakerRewardList {
+		k.SetMakerReward(ctx, elem)
+	}
+	// Set all the rewardStake
+	for _, elem := range genState.RewardStakeList {
+		k.SetRewardStake(ctx, elem)
+	}
+	// Set all the liquidityPool
+	for _, elem := range genState.LiquidityPoolList {
+		k.SetLiquidityPool(ctx, elem)
+	}
+	// Set all the routedOrder
+	for _, elem := range genState.RoutedOrderList {
+		k.SetRoutedOrder(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetPort(ctx, genState.PortId)
 	// Only try to bind to port if it is not already bound, since we may already own
@@ -45,6 +110,23 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.SellOrderBookList = k.GetAllSellOrderBook(ctx)
 	genesis.BuyOrderBookList = k.GetAllBuyOrderBook(ctx)
 	genesis.DenomTraceList = k.GetAllDenomTrace(ctx)
+	genesis.PendingOrderList = k.GetAllPendingOrder(ctx)
+	genesis.RateLimitQuotaList = k.GetAllRateLimitQuota(ctx)
+	genesis.PairStatusList = k.GetAllPairStatus(ctx)
+	genesis.TrippedMsgTypes = k.GetAllTrippedMsgTypes(ctx)
+	genesis.TrippedPacketTypes = k.GetAllTrippedPacketTypes(ctx)
+	genesis.TriggerOrderList = k.GetAllTriggerOrder(ctx)
+	genesis.TriggerOrderCount = k.GetTriggerOrderCount(ctx)
+	genesis.LastPriceList = k.GetAllLastPrice(ctx)
+	genesis.OrderDepositList = k.GetAllOrderDeposit(ctx)
+	genesis.CandleList = k.GetAllCandle(ctx)
+	genesis.TickerBucketList = k.GetAllTickerBucket(ctx)
+	genesis.TwapRecordList = k.GetAllTWAPRecord(ctx)
+	genesis.IncentivePoolList = k.GetAllIncentivePool(ctx)
+	genesis.MakerRewardList = k.GetAllMakerReward(ctx)
+	genesis.RewardStakeList = k.GetAllRewardStake(ctx)
+	genesis.LiquidityPoolList = k.GetAllLiquidityPool(ctx)
+	genesis.RoutedOrderList = k.GetAllRoutedOrder(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -2,10 +2,13 @@ package dex_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	keepertest "interchange/testutil/keeper"
 	"interchange/testutil/nullify"
+	"interchange/testutil/sample"
 	"interchange/x/dex"
 	"interchange/x/dex/types"
 )
@@ -38,6 +41,173 @@ func TestGenesis(t *testing.T) {
 				Index: "1",
 			},
 		},
+		PendingOrderList: []types.PendingOrder{
+			{
+				Port:     "dex",
+				Channel:  "channel-0",
+				Sequence: 0,
+			},
+			{
+				Port:     "dex",
+				Channel:  "channel-0",
+				Sequence: 1,
+			},
+		},
+		RateLimitQuotaList: []types.RateLimitQuota{
+			{
+				Channel: "channel-0",
+				Denom:   "stake",
+			},
+			{
+				Channel: "channel-1",
+				Denom:   "stake",
+			},
+		},
+		PairStatusList: []types.PairStatus{
+			{
+				Index: "0",
+			},
+			{
+				Index: "1",
+			},
+		},
+		TrippedMsgTypes:    []string{"/interchange.dex.MsgSendSellOrder"},
+		TrippedPacketTypes: []string{types.EventTypeBuyOrderPacket},
+		TriggerOrderList: []types.TriggerOrder{
+			{
+				Id:        0,
+				PairIndex: "0",
+			},
+			{
+				Id:        1,
+				PairIndex: "1",
+			},
+		},
+		TriggerOrderCount: 2,
+		LastPriceList: []types.LastPrice{
+			{
+				Index: "0",
+			},
+			{
+				Index: "1",
+			},
+		},
+		OrderDepositList: []types.OrderDeposit{
+			{
+				OrderType:  types.OrderTypeSell,
+				Index:      "0",
+				OrderID:    0,
+				Deposit:    sdk.NewInt64Coin("stake", 10),
+				Expiration: time.Unix(1000, 0).UTC(),
+			},
+			{
+				OrderType: types.OrderTypeBuy,
+				Index:     "1",
+				OrderID:   1,
+				Deposit:   sdk.NewInt64Coin("stake", 10),
+			},
+		},
+		CandleList: []types.Candle{
+			{
+				PairIndex: "0",
+				Interval:  time.Minute,
+				OpenTime:  time.Unix(60, 0).UTC(),
+			},
+			{
+				PairIndex: "0",
+				Interval:  time.Hour,
+				OpenTime:  time.Unix(0, 0).UTC(),
+			},
+		},
+		TickerBucketList: []types.Candle{
+			{
+				PairIndex: "0",
+				Interval:  types.TickerBucketInterval,
+				OpenTime:  time.Unix(3600, 0).UTC(),
+			},
+			{
+				PairIndex: "1",
+				Interval:  types.TickerBucketInterval,
+				OpenTime:  time.Unix(3600, 0).UTC(),
+			},
+		},
+		TwapRecordList: []types.TWAPRecord{
+			{
+				PairIndex:       "0",
+				Time:            time.Unix(60, 0).UTC(),
+				CumulativePrice: sdk.ZeroDec(),
+			},
+			{
+				PairIndex:       "1",
+				Time:            time.Unix(60, 0).UTC(),
+				CumulativePrice: sdk.NewDec(10),
+			},
+		},
+		IncentivePoolList: []types.IncentivePool{
+			{
+				PairIndex: "0",
+				Balance:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			},
+			{
+				PairIndex: "1",
+				Balance:   sdk.NewCoins(sdk.NewInt64Coin("token", 10)),
+			},
+		},
+		MakerRewardList: []types.MakerReward{
+			{
+				Address: sample.AccAddress(),
+				Rewards: sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+			},
+			{
+				Address: sample.AccAddress(),
+				Rewards: sdk.NewCoins(sdk.NewInt64Coin("token", 1)),
+			},
+		},
+		RewardStakeList: []types.RewardStake{
+			{
+				Address:   sample.AccAddress(),
+				PairIndex: "0",
+				OrderType: types.OrderTypeSell,
+				Weight:    10,
+			},
+			{
+				Address:       sample.AccAddress(),
+				PairIndex:     "1",
+				OrderType:     types.OrderTypeBuy,
+				Weight:        5,
+				RewardPerUnit: sdk.NewDecCoins(sdk.NewDecCoinFromDec("token", sdk.NewDecWithPrec(5, 1))),
+			},
+		},
+		LiquidityPoolList: []types.LiquidityPool{
+			{
+				PairIndex:     "0",
+				AmountDenom:   "stake",
+				PriceDenom:    "token",
+				AmountReserve: sdk.NewInt(100),
+				PriceReserve:  sdk.NewInt(1000),
+				TotalShares:   sdk.NewInt(316),
+			},
+			{
+				PairIndex:     "1",
+				AmountDenom:   "token",
+				PriceDenom:    "stake",
+				AmountReserve: sdk.NewInt(10),
+				PriceReserve:  sdk.NewInt(10),
+				TotalShares:   sdk.NewInt(10),
+			},
+		},
+		RoutedOrderList: []types.RoutedOrder{
+			{
+				Port:     "dex",
+				Channel:  "channel-0",
+				Sequence: 1,
+				Creator:  sample.AccAddress(),
+				Legs: []types.RouteLeg{
+					{Port: "dex", Channel: "channel-0", OrderType: types.OrderTypeSell, AmountDenom: "stake", PriceDenom: "token", Price: 2},
+				},
+				PacketTimeout: 100,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -54,5 +224,22 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.SellOrderBookList, got.SellOrderBookList)
 	require.ElementsMatch(t, genesisState.BuyOrderBookList, got.BuyOrderBookList)
 	require.ElementsMatch(t, genesisState.DenomTraceList, got.DenomTraceList)
+	require.ElementsMatch(t, genesisState.PendingOrderList, got.PendingOrderList)
+	require.ElementsMatch(t, genesisState.RateLimitQuotaList, got.RateLimitQuotaList)
+	require.ElementsMatch(t, genesisState.PairStatusList, got.PairStatusList)
+	require.ElementsMatch(t, genesisState.TrippedMsgTypes, got.TrippedMsgTypes)
+	require.ElementsMatch(t, genesisState.TrippedPacketTypes, got.TrippedPacketTypes)
+	require.ElementsMatch(t, genesisState.TriggerOrderList, got.TriggerOrderList)
+	require.Equal(t, genesisState.TriggerOrderCount, got.TriggerOrderCount)
+	require.ElementsMatch(t, genesisState.LastPriceList, got.LastPriceList)
+	require.ElementsMatch(t, genesisState.OrderDepositList, got.OrderDepositList)
+	require.ElementsMatch(t, genesisState.CandleList, got.CandleList)
+	require.ElementsMatch(t, genesisState.TickerBucketList, got.TickerBucketList)
+	require.ElementsMatch(t, genesisState.TwapRecordList, got.TwapRecordList)
+	require.ElementsMatch(t, genesisState.IncentivePoolList, got.IncentivePoolList)
+	require.ElementsMatch(t, genesisState.MakerRewardList, got.MakerRewardList)
+	require.ElementsMatch(t, genesisState.RewardStakeList, got.RewardStakeList)
+	require.ElementsMatch(t, genesisState.LiquidityPoolList, got.LiquidityPoolList)
+	require.ElementsMatch(t, genesisState.RoutedOrderList, got.RoutedOrderList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -32,6 +32,39 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgCancelBuyOrder:
 			res, err := msgServer.CancelBuyOrder(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgBatchOrders:
+			res, err := msgServer.BatchOrders(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAmendOrder:
+			res, err := msgServer.AmendOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelAllOrders:
+			res, err := msgServer.CancelAllOrders(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPlaceTriggerOrder:
+			res, err := msgServer.PlaceTriggerOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelTriggerOrder:
+			res, err := msgServer.CancelTriggerOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetCircuitBreaker:
+			res, err := msgServer.SetCircuitBreaker(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgFundIncentivePool:
+			res, err := msgServer.FundIncentivePool(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgClaimRewards:
+			res, err := msgServer.ClaimRewards(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAddLiquidity:
+			res, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRemoveLiquidity:
+			res, err := msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRoutedOrder:
+			res, err := msgServer.RoutedOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
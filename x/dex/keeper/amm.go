@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"interchange/x/dex/types"
+)
+
+// SetLiquidityPool set a specific liquidityPool in the store from its index
+func (k Keeper) SetLiquidityPool(ctx sdk.Context, liquidityPool types.LiquidityPool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LiquidityPoolKeyPrefix))
+	b := k.cdc.MustMarshal(&liquidityPool)
+	store.Set(types.LiquidityPoolKey(
+		liquidityPool.PairIndex,
+	), b)
+}
+
+// GetLiquidityPool returns a liquidityPool from its index
+func (k Keeper) GetLiquidityPool(
+	ctx sdk.Context,
+	pairIndex string,
+
+) (val types.LiquidityPool, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LiquidityPoolKeyPrefix))
+
+	b := store.Get(types.LiquidityPoolKey(
+		pairIndex,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveLiquidityPool removes a liquidityPool from the store
+func (k Keeper) RemoveLiquidityPool(
+	ctx sdk.Context,
+	pairIndex string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LiquidityPoolKeyPrefix))
+	store.Delete(types.LiquidityPoolKey(
+		pairIndex,
+	))
+}
+
+// GetAllLiquidityPool returns all liquidityPool
+func (k Keeper) GetAllLiquidityPool(ctx sdk.Context) (list []types.LiquidityPool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LiquidityPoolKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.LiquidityPool
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AddLiquidity deposits the tokens of a provider in the liquidity pool of a pair and mints its share tokens,
+// the pool is created by the first deposit
+func (k Keeper) AddLiquidity(ctx sdk.Context, provider sdk.AccAddress, port string, channel string, pairIndex string, amountDeposit sdk.Int, priceDeposit sdk.Int) (sdk.Coin, error) {
+	//ガバナンスで一時停止されている場合
+	if k.IsPairPaused(ctx, pairIndex) {
+		return sdk.Coin{}, errors.New("the pair is paused")
+	}
+
+	//上場廃止されたペアのプールには預け入れできない
+	if err := k.checkLocalPair(ctx, pairIndex); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	pool, found := k.GetLiquidityPool(ctx, pairIndex)
+	if !found {
+		amountDenom, priceDenom, err := k.poolDenoms(ctx, port, channel, pairIndex)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		pool = types.NewLiquidityPool(pairIndex, amountDenom, priceDenom)
+	}
+
+	shares, amountIn, priceIn := pool.Deposit(amountDeposit, priceDeposit)
+	if !shares.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "deposit too small to mint pool shares")
+	}
+
+	//預け入れたトークンをプールのアドレスに移す
+	deposit := sdk.NewCoins(sdk.NewCoin(pool.AmountDenom, amountIn), sdk.NewCoin(pool.PriceDenom, priceIn))
+	if err := k.bankKeeper.SendCoins(ctx, provider, types.LiquidityPoolAddress(), deposit); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	//シェアトークンをミントして提供者に送る
+	shareCoin := sdk.NewCoin(types.PoolShareDenom(pairIndex), shares)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, provider, sdk.NewCoins(shareCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	pool.AmountReserve = pool.AmountReserve.Add(amountIn)
+	pool.PriceReserve = pool.PriceReserve.Add(priceIn)
+	pool.TotalShares = pool.TotalShares.Add(shares)
+	k.SetLiquidityPool(ctx, pool)
+	return shareCoin, nil
+}
+
+// RemoveLiquidity burns share tokens of a provider and pays their part of the reserves of the liquidity pool,
+// the providers of a delisted pair withdraw their reserves with it
+func (k Keeper) RemoveLiquidity(ctx sdk.Context, provider sdk.AccAddress, pairIndex string, shares sdk.Int) (sdk.Coins, error) {
+	pool, found := k.GetLiquidityPool(ctx, pairIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "liquidity pool %s", pairIndex)
+	}
+	if shares.GT(pool.TotalShares) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s shares of %s", shares, pool.TotalShares)
+	}
+
+	//シェアトークンを燃焼する
+	shareCoins := sdk.NewCoins(sdk.NewCoin(types.PoolShareDenom(pairIndex), shares))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, provider, types.ModuleName, shareCoins); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, shareCoins); err != nil {
+		return nil, err
+	}
+
+	//シェアに応じた準備金を提供者に支払う
+	amountOut, priceOut := pool.Withdraw(shares)
+	withdrawn := sdk.NewCoins(sdk.NewCoin(pool.AmountDenom, amountOut), sdk.NewCoin(pool.PriceDenom, priceOut))
+	if err := k.bankKeeper.SendCoins(ctx, types.LiquidityPoolAddress(), provider, withdrawn); err != nil {
+		return nil, err
+	}
+
+	pool.AmountReserve = pool.AmountReserve.Sub(amountOut)
+	pool.PriceReserve = pool.PriceReserve.Sub(priceOut)
+	pool.TotalShares = pool.TotalShares.Sub(shares)
+	if pool.TotalShares.IsZero() {
+		//最後のシェアが燃焼された場合、プールを削除する
+		k.RemoveLiquidityPool(ctx, pairIndex)
+	} else {
+		k.SetLiquidityPool(ctx, pool)
+	}
+	return withdrawn, nil
+}
+
+// poolDenoms returns the local denoms of a pair, resolved as the tokens of the orders received for the order book
+// of the pair resting on this chain
+func (k Keeper) poolDenoms(ctx sdk.Context, port string, channel string, pairIndex string) (amountDenom string, priceDenom string, err error) {
+	//売りオーダーブックの場合、買い注文の代金はこのチェーンのトークンかバウチャーで受け取る
+	if book, found := k.GetSellOrderBook(ctx, pairIndex); found {
+		priceDenom, saved := k.OriginalDenom(ctx, port, channel, LocalDenom(book.PriceDenom))
+		if !saved {
+			priceDenom = k.MintVoucherDenom(ctx, port, channel, book.PriceDenom)
+		}
+		return LocalDenom(book.AmountDenom), priceDenom, nil
+	}
+	//買いオーダーブックの場合、売り注文の数量はこのチェーンのトークンかバウチャーで受け取る
+	if book, found := k.GetBuyOrderBook(ctx, pairIndex); found {
+		amountDenom, saved := k.OriginalDenom(ctx, port, channel, LocalDenom(book.AmountDenom))
+		if !saved {
+			amountDenom = k.MintVoucherDenom(ctx, port, channel, book.AmountDenom)
+		}
+		return amountDenom, LocalDenom(book.PriceDenom), nil
+	}
+	return "", "", sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %s", pairIndex)
+}
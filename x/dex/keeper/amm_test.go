@@ -0,0 +1,240 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/x/dex/types"
+)
+
+// setupLiquidityPool sets the pool fee to zero and deposits 1000 of the amount denom
+// and 10000 of the price denom in the liquidity pool of the pair
+func setupLiquidityPool(t *testing.T, f *keepertest.BankFixture, pairIndex string, amountDenom string, priceDenom string) sdk.AccAddress {
+	params := types.DefaultParams()
+	params.PoolSwapFee = sdk.ZeroDec()
+	f.Keeper.SetParams(f.Ctx, params)
+
+	provider := sampleAccAddress(t)
+	f.Fund(provider, sdk.NewCoins(sdk.NewInt64Coin(amountDenom, 1000), sdk.NewInt64Coin(priceDenom, 10000))...)
+	shares, err := f.Keeper.AddLiquidity(f.Ctx, provider, "dex", "channel-0", pairIndex, sdk.NewInt(1000), sdk.NewInt(10000))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(types.PoolShareDenom(pairIndex), 3162), shares)
+	return provider
+}
+
+func TestAddRemoveLiquidity(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	shareDenom := types.PoolShareDenom(pairIndex)
+	voucher := ibctransfertypes.ParseDenomTrace("dex/channel-0/token").IBCDenom()
+
+	// The pair must have an order book on this chain
+	provider := sampleAccAddress(t)
+	f.Fund(provider, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin(voucher, 10000))...)
+	_, err := f.Keeper.AddLiquidity(f.Ctx, provider, "dex", "channel-0", pairIndex, sdk.NewInt(1000), sdk.NewInt(10000))
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// The tokens of the sell order book are the local amount denom and the voucher of the price denom
+	book := types.NewSellOrderBook("stake", "token")
+	book.Index = pairIndex
+	f.Keeper.SetSellOrderBook(f.Ctx, book)
+	first := setupLiquidityPool(t, f, pairIndex, "stake", voucher)
+	f.RequireBalance(types.LiquidityPoolAddress(), "stake", 1000)
+	f.RequireBalance(types.LiquidityPoolAddress(), voucher, 10000)
+
+	// The next deposits are taken at the ratio of the reserves
+	second := sampleAccAddress(t)
+	f.Fund(second, sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin(voucher, 2000))...)
+	shares, err := f.Keeper.AddLiquidity(f.Ctx, second, "dex", "channel-0", pairIndex, sdk.NewInt(100), sdk.NewInt(2000))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(shareDenom, 316), shares)
+	f.RequireBalance(second, "stake", 0)
+	f.RequireBalance(second, voucher, 1000)
+	f.RequireSupply(shareDenom, 3478)
+
+	pool, found := f.Keeper.GetLiquidityPool(f.Ctx, pairIndex)
+	require.True(t, found)
+	require.Equal(t, types.LiquidityPool{
+		PairIndex:     pairIndex,
+		AmountDenom:   "stake",
+		PriceDenom:    voucher,
+		AmountReserve: sdk.NewInt(1100),
+		PriceReserve:  sdk.NewInt(11000),
+		TotalShares:   sdk.NewInt(3478),
+	}, pool)
+
+	// Deposits too small to mint a share are rejected
+	_, err = f.Keeper.AddLiquidity(f.Ctx, second, "dex", "channel-0", pairIndex, sdk.NewInt(1), sdk.NewInt(1))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// No liquidity is added to a paused pair
+	f.Keeper.SetPairPaused(f.Ctx, pairIndex, true)
+	_, err = f.Keeper.AddLiquidity(f.Ctx, second, "dex", "channel-0", pairIndex, sdk.NewInt(10), sdk.NewInt(100))
+	require.EqualError(t, err, "the pair is paused")
+
+	// The withdrawals are rounded down, and allowed on a paused pair
+	_, err = f.Keeper.RemoveLiquidity(f.Ctx, second, pairIndex, sdk.NewInt(3479))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	withdrawn, err := f.Keeper.RemoveLiquidity(f.Ctx, second, pairIndex, sdk.NewInt(316))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 99), sdk.NewInt64Coin(voucher, 999)), withdrawn)
+	f.RequireBalance(second, shareDenom, 0)
+
+	// The pool is removed with its last shares
+	_, err = f.Keeper.RemoveLiquidity(f.Ctx, second, pairIndex, sdk.NewInt(1))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	withdrawn, err = f.Keeper.RemoveLiquidity(f.Ctx, first, pairIndex, sdk.NewInt(3162))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1001), sdk.NewInt64Coin(voucher, 10001)), withdrawn)
+	_, found = f.Keeper.GetLiquidityPool(f.Ctx, pairIndex)
+	require.False(t, found)
+	f.RequireSupply(shareDenom, 0)
+	f.RequireBalance(types.LiquidityPoolAddress(), "stake", 0)
+	f.RequireBalance(types.LiquidityPoolAddress(), voucher, 0)
+}
+
+func TestDelistPairLiquidityPool(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	shareDenom := types.PoolShareDenom(pairIndex)
+	voucher := ibctransfertypes.ParseDenomTrace("dex/channel-0/token").IBCDenom()
+	book := types.NewSellOrderBook("stake", "token")
+	book.Index = pairIndex
+	f.Keeper.SetSellOrderBook(f.Ctx, book)
+	provider := setupLiquidityPool(t, f, pairIndex, "stake", voucher)
+
+	require.NoError(t, f.Keeper.DelistPair(f.Ctx, "dex", "channel-0", "stake", "token"))
+
+	// No liquidity is added to the pool of a delisted pair
+	f.Fund(provider, sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin(voucher, 1000))...)
+	_, err := f.Keeper.AddLiquidity(f.Ctx, provider, "dex", "channel-0", pairIndex, sdk.NewInt(100), sdk.NewInt(1000))
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// The providers withdraw their reserves
+	withdrawn, err := f.Keeper.RemoveLiquidity(f.Ctx, provider, pairIndex, sdk.NewInt(3162))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin(voucher, 10000)), withdrawn)
+	f.RequireBalance(provider, "stake", 1100)
+	f.RequireBalance(provider, voucher, 11000)
+	f.RequireSupply(shareDenom, 0)
+	_, found := f.Keeper.GetLiquidityPool(f.Ctx, pairIndex)
+	require.False(t, found)
+}
+
+func TestOnRecvSellOrderPacketLiquidityPool(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	voucher := ibctransfertypes.ParseDenomTrace("dex/channel-0/stake").IBCDenom()
+	bob := sampleAccAddress(t)
+
+	book := types.NewBuyOrderBook("stake", "token")
+	book.Index = pairIndex
+	_, err := book.AppendOrder(bob.String(), 10, 9, 0)
+	require.NoError(t, err)
+	f.Keeper.SetBuyOrderBook(f.Ctx, book)
+	setupLiquidityPool(t, f, pairIndex, voucher, "token")
+	params := f.Keeper.GetParams(f.Ctx)
+	params.RateLimits = []types.RateLimit{
+		{Port: "dex", Channel: "channel-0", Denom: voucher, MaxOutflow: 1000, Window: time.Hour},
+	}
+	f.Keeper.SetParams(f.Ctx, params)
+
+	// The pool buys until its price falls to the highest bid, then the bid is filled
+	// and the pool no longer pays the price of the order
+	ack, err := f.Keeper.OnRecvSellOrderPacket(f.Ctx, channeltypes.Packet{
+		SourcePort:         "dex",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "dex",
+		DestinationChannel: "channel-0",
+	}, types.SellOrderPacketData{
+		AmountDenom: "stake",
+		Amount:      100,
+		PriceDenom:  "token",
+		Price:       9,
+		Seller:      sampleAccAddress(t).String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.SellOrderPacketAck{RemainingAmount: 36, Gain: 512 + 90}, ack)
+
+	pool, found := f.Keeper.GetLiquidityPool(f.Ctx, pairIndex)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(1054), pool.AmountReserve)
+	require.Equal(t, sdk.NewInt(9488), pool.PriceReserve)
+	f.RequireBalance(types.LiquidityPoolAddress(), voucher, 1054)
+	f.RequireBalance(types.LiquidityPoolAddress(), "token", 9488)
+	f.RequireEscrow("dex", "channel-0", "token", 512)
+	f.RequireBalance(bob, voucher, 10)
+
+	// The vouchers minted to the pool count against the outflow quota as the ones minted to the bid
+	quota, found := f.Keeper.GetRateLimitQuota(f.Ctx, "dex", "channel-0", voucher)
+	require.True(t, found)
+	require.Equal(t, int64(54+10), quota.Outflow)
+
+	lastPrice, found := f.Keeper.GetLastPrice(f.Ctx, pairIndex)
+	require.True(t, found)
+	require.Equal(t, int32(9), lastPrice.Price)
+}
+
+func TestOnRecvBuyOrderPacketLiquidityPool(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	voucher := ibctransfertypes.ParseDenomTrace("dex/channel-0/token").IBCDenom()
+	alice := sampleAccAddress(t)
+
+	book := types.NewSellOrderBook("stake", "token")
+	book.Index = pairIndex
+	_, err := book.AppendOrder(alice.String(), 10, 11, 0)
+	require.NoError(t, err)
+	f.Keeper.SetSellOrderBook(f.Ctx, book)
+	setupLiquidityPool(t, f, pairIndex, "stake", voucher)
+
+	// The pool sells until its price rises to the lowest ask, then the ask is filled,
+	// and the price not spent in the pool is refunded
+	packet := channeltypes.Packet{
+		SourcePort:         "dex",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "dex",
+		DestinationChannel: "channel-0",
+	}
+	ack, err := f.Keeper.OnRecvBuyOrderPacket(f.Ctx, packet, types.BuyOrderPacketData{
+		AmountDenom: "stake",
+		Amount:      100,
+		PriceDenom:  "token",
+		Price:       11,
+		Buyer:       sampleAccAddress(t).String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.BuyOrderPacketAck{RemainingAmount: 44, Purchase: 46 + 10, Refund: 46*11 - 483}, ack)
+
+	pool, found := f.Keeper.GetLiquidityPool(f.Ctx, pairIndex)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(954), pool.AmountReserve)
+	require.Equal(t, sdk.NewInt(10483), pool.PriceReserve)
+	f.RequireBalance(types.LiquidityPoolAddress(), "stake", 954)
+	f.RequireBalance(types.LiquidityPoolAddress(), voucher, 10483)
+	f.RequireEscrow("dex", "channel-0", "stake", 46)
+	f.RequireBalance(alice, voucher, 110)
+
+	// The buyer receives the refund with the purchase
+	buyer := sampleAccAddress(t)
+	f.FundEscrow("dex", "channel-0", sdk.NewInt64Coin("token", 1000))
+	require.NoError(t, f.Keeper.OnAcknowledgementBuyOrderPacket(f.Ctx, packet, types.BuyOrderPacketData{
+		AmountDenom: "stake",
+		Amount:      56,
+		PriceDenom:  "token",
+		Price:       11,
+		Buyer:       buyer.String(),
+	}, channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(&types.BuyOrderPacketAck{
+		Purchase: 56,
+		Refund:   23,
+	}))))
+	f.RequireBalance(buyer, "token", 23)
+	f.RequireEscrow("dex", "channel-0", "token", 977)
+	f.RequireBalance(buyer, ibctransfertypes.ParseDenomTrace("dex/channel-0/stake").IBCDenom(), 56)
+}
@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"errors"
+
+	"interchange/x/dex/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// batchOrderPacketHandler routes BatchOrderPacketData to the keeper callbacks
+var batchOrderPacketHandler = PacketHandler{
+	OnTransmit: func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData) error {
+		return k.OnTransmitBatchOrderPacket(ctx, packet, *data.(*types.BatchOrderPacketData))
+	},
+	OnRecv: func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData) (proto.Message, error) {
+		packetAck, err := k.OnRecvBatchOrderPacket(ctx, packet, *data.(*types.BatchOrderPacketData))
+		return &packetAck, err
+	},
+	OnAcknowledgement: func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData, ack channeltypes.Acknowledgement) error {
+		return k.OnAcknowledgementBatchOrderPacket(ctx, packet, *data.(*types.BatchOrderPacketData), ack)
+	},
+	OnTimeout: func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData) error {
+		return k.OnTimeoutBatchOrderPacket(ctx, packet, *data.(*types.BatchOrderPacketData))
+	},
+}
+
+// OnTransmitBatchOrderPacket records the orders of the batch as pending until the packet is acknowledged or times out
+func (k Keeper) OnTransmitBatchOrderPacket(ctx sdk.Context, packet channeltypes.Packet, data types.BatchOrderPacketData) error {
+	//注文ごとのデポジットをエスクローする
+	deposit, err := k.escrowOrderDeposit(ctx, data.Creator, len(data.Orders))
+	if err != nil {
+		return err
+	}
+
+	k.SetPendingOrder(ctx, types.PendingOrder{
+		Port:        packet.SourcePort,
+		Channel:     packet.SourceChannel,
+		Sequence:    packet.Sequence,
+		Owner:       data.Creator,
+		OrderType:   data.OrderType,
+		AmountDenom: data.AmountDenom,
+		PriceDenom:  data.PriceDenom,
+		BatchOrders: data.Orders,
+		Deposit:     deposit,
+	})
+	return nil
+}
+
+// ターゲットチェーンで "batch order" パケットを受信した場合に行う処理
+// いずれかの注文が失敗した場合、エラー確認応答によりバッチ全体の状態変更が破棄される
+func (k Keeper) OnRecvBatchOrderPacket(ctx sdk.Context, packet channeltypes.Packet, data types.BatchOrderPacketData) (packetAck types.BatchOrderPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	pairIndex := types.OrderBookIndex(packet.SourcePort, packet.SourceChannel, data.AmountDenom, data.PriceDenom)
+	//ガバナンスで一時停止されている場合
+	if k.IsPairPaused(ctx, pairIndex) {
+		return packetAck, errors.New("the pair is paused")
+	}
+
+	results := make([]types.BatchOrderResult, len(data.Orders))
+	switch data.OrderType {
+	case types.OrderTypeSell:
+		//売り注文は買いオーダーブックで約定する
+		book, found := k.GetBuyOrderBook(ctx, pairIndex)
+		if !found {
+			return packetAck, errors.New("the pair doesn't exist")
+		}
+		for i, order := range data.Orders {
+			results[i].RemainingAmount, results[i].Gain, results[i].PreventedAmount, err = k.fillSellOrder(ctx, packet, &book, batchSellOrder(data, order))
+			if err != nil {
+				return packetAck, err
+			}
+		}
+		k.SetBuyOrderBook(ctx, book)
+	case types.OrderTypeBuy:
+		//買い注文は売りオーダーブックで約定する
+		book, found := k.GetSellOrderBook(ctx, pairIndex)
+		if !found {
+			return packetAck, errors.New("the pair doesn't exist")
+		}
+		for i, order := range data.Orders {
+			results[i].RemainingAmount, results[i].Purchase, results[i].PreventedAmount, results[i].Refund, err = k.fillBuyOrder(ctx, packet, &book, batchBuyOrder(data, order))
+			if err != nil {
+				return packetAck, err
+			}
+		}
+		k.SetSellOrderBook(ctx, book)
+	}
+
+	packetAck.Results = results
+	return packetAck, nil
+}
+
+// IBCパケットがターゲットチェーンで処理された後、
+// 確認応答がソースチェーンに返された後に行う処理
+func (k Keeper) OnAcknowledgementBatchOrderPacket(ctx sdk.Context, packet channeltypes.Packet, data types.BatchOrderPacketData, ack channeltypes.Acknowledgement) error {
+	//注文は処理済みのため、保留中の注文を削除
+	deposit := k.pendingOrderDeposit(ctx, packet)
+	k.RemovePendingOrder(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		//エラーが発生した場合、バッチのすべての注文を返金する
+		return k.refundBatchOrder(ctx, packet, data, deposit)
+	case *channeltypes.Acknowledgement_Result:
+		//パケット確認応答をデコードする
+		var packetAck types.BatchOrderPacketAck
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+		if len(packetAck.Results) != len(data.Orders) {
+			return errors.New("invalid number of results in the acknowledgment")
+		}
+
+		//注文ごとに残高と約定結果を精算する
+		for i, order := range data.Orders {
+			result := packetAck.Results[i]
+			if data.OrderType == types.OrderTypeSell {
+				sellOrder := batchSellOrder(data, order)
+				sellAck := types.SellOrderPacketAck{
+					RemainingAmount: result.RemainingAmount,
+					Gain:            result.Gain,
+					PreventedAmount: result.PreventedAmount,
+				}
+				//相手チェーンの確認応答が注文を超えて返金させないことを確認する
+				if err := sellAck.ValidateSettlement(sellOrder); err != nil {
+					return err
+				}
+				//相手チェーンで約定した数量を平均価格で記録する
+				k.recordSellSettlement(ctx, packet, sellOrder, sellAck)
+				if err := k.settleSellOrder(ctx, packet, sellOrder, sellAck.RemainingAmount, sellAck.Gain, sellAck.PreventedAmount, deposit); err != nil {
+					return err
+				}
+				continue
+			}
+			buyOrder := batchBuyOrder(data, order)
+			buyAck := types.BuyOrderPacketAck{
+				RemainingAmount: result.RemainingAmount,
+				Purchase:        result.Purchase,
+				PreventedAmount: result.PreventedAmount,
+				Refund:          result.Refund,
+			}
+			if err := buyAck.ValidateSettlement(buyOrder); err != nil {
+				return err
+			}
+			k.recordBuySettlement(ctx, packet, buyOrder, buyAck)
+			if err := k.settleBuyOrder(ctx, packet, buyOrder, buyAck.RemainingAmount, buyAck.Purchase, buyAck.PreventedAmount, buyAck.Refund, deposit); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		// 相手方モジュールが正しい確認応答形式を実装していません
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutBatchOrderPacket responds to the case where a packet has not been transmitted because of a timeout
+func (k Keeper) OnTimeoutBatchOrderPacket(ctx sdk.Context, packet channeltypes.Packet, data types.BatchOrderPacketData) error {
+	//注文は処理されなかったため、保留中の注文を削除してトークンを元に戻す
+	deposit := k.pendingOrderDeposit(ctx, packet)
+	k.RemovePendingOrder(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	return k.refundBatchOrder(ctx, packet, data, deposit)
+}
+
+// refundBatchOrder returns the tokens and the deposit escrowed for every order of the batch
+func (k Keeper) refundBatchOrder(ctx sdk.Context, packet channeltypes.Packet, data types.BatchOrderPacketData, deposit *sdk.Coin) error {
+	for _, order := range data.Orders {
+		var err error
+		if data.OrderType == types.OrderTypeSell {
+			err = k.refundSellOrder(ctx, packet, batchSellOrder(data, order))
+		} else {
+			err = k.refundBuyOrder(ctx, packet, batchBuyOrder(data, order))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return k.refundOrderDeposit(ctx, data.Creator, deposit, len(data.Orders))
+}
+
+// batchSellOrder returns the sell order packet data of an order of a batch
+func batchSellOrder(data types.BatchOrderPacketData, order types.BatchOrder) types.SellOrderPacketData {
+	return types.SellOrderPacketData{
+		AmountDenom:         data.AmountDenom,
+		Amount:              order.Amount,
+		PriceDenom:          data.PriceDenom,
+		Price:               order.Price,
+		Seller:              data.Creator,
+		SelfTradePrevention: data.SelfTradePrevention,
+	}
+}
+
+// batchBuyOrder returns the buy order packet data of an order of a batch
+func batchBuyOrder(data types.BatchOrderPacketData, order types.BatchOrder) types.BuyOrderPacketData {
+	return types.BuyOrderPacketData{
+		AmountDenom:         data.AmountDenom,
+		Amount:              order.Amount,
+		PriceDenom:          data.PriceDenom,
+		Price:               order.Price,
+		Buyer:               data.Creator,
+		SelfTradePrevention: data.SelfTradePrevention,
+	}
+}
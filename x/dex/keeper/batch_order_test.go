@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/sample"
+	"interchange/x/dex/types"
+)
+
+func TestOnRecvBatchOrderPacket(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	packet := channeltypes.Packet{SourcePort: "dex", SourceChannel: "channel-0"}
+	data := types.BatchOrderPacketData{
+		OrderType:   types.OrderTypeSell,
+		AmountDenom: "stake",
+		PriceDenom:  "token",
+		Creator:     sample.AccAddress(),
+		Orders:      []types.BatchOrder{{Amount: 10, Price: 15}, {Amount: 20, Price: 16}},
+	}
+
+	_, err := k.OnRecvBatchOrderPacket(ctx, packet, data)
+	require.EqualError(t, err, "the pair doesn't exist")
+
+	// Sell orders are filled against the buy order book
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	book := types.NewBuyOrderBook("stake", "token")
+	book.Index = pairIndex
+	k.SetBuyOrderBook(ctx, book)
+
+	packetAck, err := k.OnRecvBatchOrderPacket(ctx, packet, data)
+	require.NoError(t, err)
+	require.Equal(t, []types.BatchOrderResult{
+		{RemainingAmount: 10},
+		{RemainingAmount: 20},
+	}, packetAck.Results)
+
+	k.SetPairPaused(ctx, pairIndex, true)
+	_, err = k.OnRecvBatchOrderPacket(ctx, packet, data)
+	require.EqualError(t, err, "the pair is paused")
+
+	// Buy orders are filled against the sell order book
+	data.OrderType = types.OrderTypeBuy
+	k.SetPairPaused(ctx, pairIndex, false)
+	_, err = k.OnRecvBatchOrderPacket(ctx, packet, data)
+	require.EqualError(t, err, "the pair doesn't exist")
+
+	// Invalid batches are rejected
+	data.Orders = nil
+	_, err = k.OnRecvBatchOrderPacket(ctx, packet, data)
+	require.Error(t, err)
+}
+
+func TestOnTransmitBatchOrderPacket(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	packet := channeltypes.Packet{SourcePort: "dex", SourceChannel: "channel-0", Sequence: 3}
+	data := types.BatchOrderPacketData{
+		OrderType:   types.OrderTypeBuy,
+		AmountDenom: "stake",
+		PriceDenom:  "token",
+		Creator:     sample.AccAddress(),
+		Orders:      []types.BatchOrder{{Amount: 10, Price: 15}, {Amount: 20, Price: 16}},
+	}
+	require.NoError(t, k.OnTransmitBatchOrderPacket(ctx, packet, data))
+
+	pendingOrder, found := k.GetPendingOrder(ctx, "dex", "channel-0", 3)
+	require.True(t, found)
+	require.Equal(t, data.Creator, pendingOrder.Owner)
+	require.Equal(t, types.OrderTypeBuy, pendingOrder.OrderType)
+	require.Equal(t, data.Orders, pendingOrder.BatchOrders)
+}
@@ -6,64 +6,89 @@ import (
 	"interchange/x/dex/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
-	host "github.com/cosmos/ibc-go/v2/modules/core/24-host"
+	"github.com/gogo/protobuf/proto"
 )
 
-// TransmitBuyOrderPacket transmits the packet over IBC with the specified source port and source channel
-func (k Keeper) TransmitBuyOrderPacket(
-	ctx sdk.Context,
-	packetData types.BuyOrderPacketData,
-	sourcePort,
-	sourceChannel string,
-	timeoutHeight clienttypes.Height,
-	timeoutTimestamp uint64,
-) error {
-
-	sourceChannelEnd, found := k.ChannelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
-	if !found {
-		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
-	}
+// buyOrderPacketHandler routes BuyOrderPacketData to the keeper callbacks
+var buyOrderPacketHandler = PacketHandler{
+	OnTransmit: func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData) error {
+		return k.OnTransmitBuyOrderPacket(ctx, packet, *data.(*types.BuyOrderPacketData))
+	},
+	OnRecv: func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData) (proto.Message, error) {
+		packetAck, err := k.OnRecvBuyOrderPacket(ctx, packet, *data.(*types.BuyOrderPacketData))
+		return &packetAck, err
+	},
+	OnAcknowledgement: func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData, ack channeltypes.Acknowledgement) error {
+		return k.OnAcknowledgementBuyOrderPacket(ctx, packet, *data.(*types.BuyOrderPacketData), ack)
+	},
+	OnTimeout: func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData) error {
+		return k.OnTimeoutBuyOrderPacket(ctx, packet, *data.(*types.BuyOrderPacketData))
+	},
+}
 
-	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
-	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+// sendBuyOrder escrows the price of a buy order and sends its packet to the chain of the sell order book,
+// the denoms of the packet are the full paths of the denoms of the pair
+func (k Keeper) sendBuyOrder(ctx sdk.Context, port string, channel string, packet types.BuyOrderPacketData, timeoutTimestamp uint64) (uint64, error) {
+	//ペアがオーダーブックに存在するかどうかを確認します
+	pairIndex := types.OrderBookIndex(port, channel, packet.AmountDenom, packet.PriceDenom)
+	if _, found := k.GetBuyOrderBook(ctx, pairIndex); !found {
+		return 0, errors.New("the pair doesn't exist")
+	}
+	//ガバナンスで一時停止されている場合
+	if k.IsPairPaused(ctx, pairIndex) {
+		return 0, errors.New("the pair is paused")
+	}
 
-	// get the next sequence
-	sequence, found := k.ChannelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
-	if !found {
-		return sdkerrors.Wrapf(
-			channeltypes.ErrSequenceSendNotFound,
-			"source port: %s, source channel: %s", sourcePort, sourceChannel,
-		)
+	//送信者のアドレスを取得する
+	sender, err := sdk.AccAddressFromBech32(packet.Buyer)
+	if err != nil {
+		return 0, err
 	}
 
-	channelCap, ok := k.ScopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
-	if !ok {
-		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	//トークンがIBCトークンの場合、トークンを焼却
+	//トークンがネイティブトークンの場合、トークンをロック
+	if err := k.SafeBurn(ctx, port, channel, sender, LocalDenom(packet.PriceDenom), int64(packet.Amount)*int64(packet.Price)); err != nil {
+		return 0, err
 	}
 
-	packetBytes, err := packetData.GetBytes()
-	if err != nil {
-		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
+	//ターゲットチェーンで受け取ったバウチャーを保存(後で元に戻すことができるようにする)
+	if err := k.SaveVoucherDenom(ctx, port, channel, packet.PriceDenom); err != nil {
+		return 0, err
 	}
 
-	packet := channeltypes.NewPacket(
-		packetBytes,
-		sequence,
-		sourcePort,
-		sourceChannel,
-		destinationPort,
-		destinationChannel,
-		timeoutHeight,
+	//IBCパケットをターゲットチェーンに送信
+	return k.TransmitPacket(
+		ctx,
+		&packet,
+		port,
+		channel,
+		clienttypes.ZeroHeight(),
 		timeoutTimestamp,
 	)
+}
 
-	if err := k.ChannelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+// OnTransmitBuyOrderPacket records the order as pending until the packet is acknowledged or times out
+func (k Keeper) OnTransmitBuyOrderPacket(ctx sdk.Context, packet channeltypes.Packet, data types.BuyOrderPacketData) error {
+	//注文ごとのデポジットをエスクローする
+	deposit, err := k.escrowOrderDeposit(ctx, data.Buyer, 1)
+	if err != nil {
 		return err
 	}
 
+	k.SetPendingOrder(ctx, types.PendingOrder{
+		Port:        packet.SourcePort,
+		Channel:     packet.SourceChannel,
+		Sequence:    packet.Sequence,
+		Owner:       data.Buyer,
+		OrderType:   types.OrderTypeBuy,
+		AmountDenom: data.AmountDenom,
+		Amount:      data.Amount,
+		PriceDenom:  data.PriceDenom,
+		Price:       data.Price,
+		Deposit:     deposit,
+	})
 	return nil
 }
 
@@ -80,23 +105,59 @@ func (k Keeper) OnRecvBuyOrderPacket(ctx sdk.Context, packet channeltypes.Packet
 	if !found {
 		return packetAck, errors.New("the pair doesn't exist")
 	}
+	//ガバナンスで一時停止されている場合
+	if k.IsPairPaused(ctx, pairIndex) {
+		return packetAck, errors.New("the pair is paused")
+	}
 
-	//買い注文約定(買いオーダーブックを更新する)
-	remaining, liquidated, purchase, _ := book.FillBuyOrder(types.Order{
-		Amount: data.Amount,
-		Price:  data.Price,
-	})
+	//買い注文を約定し、残高と購入を返す
+	packetAck.RemainingAmount, packetAck.Purchase, packetAck.PreventedAmount, packetAck.Refund, err = k.fillBuyOrder(ctx, packet, &book, data)
+	if err != nil {
+		return packetAck, err
+	}
 
-	//残高と購入を返す
-	packetAck.RemainingAmount = remaining.Amount
-	packetAck.Purchase = purchase
+	//新しい売りオーダーブックを保存する
+	k.SetSellOrderBook(ctx, book)
+
+	return packetAck, nil
+}
+
+// fillBuyOrder matches a received buy order against the sell order book and the liquidity pool and sends the payment
+// to the sellers, the amount cancelled by the self-trade prevention and the price not spent in the fills below the price of the order are returned
+// to be refunded to the buyer
+func (k Keeper) fillBuyOrder(ctx sdk.Context, packet channeltypes.Packet, book *types.SellOrderBook, data types.BuyOrderPacketData) (remainingAmount int32, purchase int32, prevented int32, refund int64, err error) {
+	//買い注文約定(売りオーダーブックと流動性プールを更新する)
+	pool, hasPool := k.GetLiquidityPool(ctx, book.Index)
+	var poolRef *types.LiquidityPool
+	if hasPool {
+		poolRef = &pool
+	}
+	remaining, liquidated, purchase, selfTrade, swap := book.RouteBuyOrder(types.Order{
+		Creator: data.Buyer,
+		Amount:  data.Amount,
+		Price:   data.Price,
+	}, data.SelfTradePrevention, poolRef, k.PoolSwapFee(ctx))
+
+	//自己取引防止で取り消された売り注文を返金する
+	if err := k.refundSelfTradeOrders(ctx, packet, selfTrade.Cancelled, LocalDenom(book.AmountDenom), func(order types.Order) int64 {
+		return int64(order.Amount)
+	}); err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	//板から削除された売り注文のデポジットを返金する
+	for _, orders := range [][]types.Order{liquidated, selfTrade.Cancelled} {
+		if err := k.releaseClosedOrderDeposits(ctx, types.OrderTypeSell, book.Index, book.Book, orders); err != nil {
+			return 0, 0, 0, 0, err
+		}
+	}
 
 	//売上を分配する前に、デノムを解決します
 	//まず、受け取ったデノムが元々このチェーンからのものかどうかを確認
-	finalPriceDenom, saved := k.OriginalDenom(ctx, packet.SourcePort, packet.SourceChannel, data.AmountDenom)
+	finalPriceDenom, saved := k.OriginalDenom(ctx, packet.SourcePort, packet.SourceChannel, LocalDenom(data.PriceDenom))
 	if !saved {
 		//このチェーンからのものではない場合、バウチャーをデノムとして使用
-		finalPriceDenom = VoucherDenom(packet.SourcePort, packet.SourceChannel, data.AmountDenom)
+		finalPriceDenom = k.MintVoucherDenom(ctx, packet.DestinationPort, packet.DestinationChannel, data.PriceDenom)
 	}
 	//販売したトークンを購入者に配布
 	//約定試行後にチェーンAに売り注文を送信する
@@ -104,7 +165,7 @@ func (k Keeper) OnRecvBuyOrderPacket(ctx sdk.Context, packet channeltypes.Packet
 		liquidation := liquidation
 		addr, err := sdk.AccAddressFromBech32(liquidation.Creator)
 		if err != nil {
-			return packetAck, err
+			return 0, 0, 0, 0, err
 		}
 
 		if err := k.SafeMint(
@@ -113,38 +174,58 @@ func (k Keeper) OnRecvBuyOrderPacket(ctx sdk.Context, packet channeltypes.Packet
 			packet.DestinationChannel,
 			addr,
 			finalPriceDenom,
-			liquidation.Amount*liquidation.Price,
+			int64(liquidation.Amount)*int64(liquidation.Price),
 		); err != nil {
-			return packetAck, err
+			return 0, 0, 0, 0, err
 		}
+		//購入者は注文の価格でエスクローしているため、売り注文の価格との差額を返金する
+		refund += int64(data.Price-liquidation.Price) * int64(liquidation.Amount)
 	}
 
-	//新しい売りオーダーブックを保存する
-	k.SetSellOrderBook(ctx, book)
+	//プールが受け取る代金をプールに送り、プールが売却した数量をエスクローする
+	trades := liquidated
+	if swap.Amount > 0 {
+		if err := k.SafeMint(ctx, packet.DestinationPort, packet.DestinationChannel, types.LiquidityPoolAddress(), pool.PriceDenom, swap.Total); err != nil {
+			return 0, 0, 0, 0, err
+		}
+		if err := k.SafeBurn(ctx, packet.DestinationPort, packet.DestinationChannel, types.LiquidityPoolAddress(), pool.AmountDenom, int64(swap.Amount)); err != nil {
+			return 0, 0, 0, 0, err
+		}
+		k.SetLiquidityPool(ctx, pool)
+		trades = append([]types.Order{swap.Trade()}, liquidated...)
+		//注文の価格より安く購入した代金は購入者に返金する
+		refund += int64(swap.Amount)*int64(data.Price) - swap.Total
+	}
 
-	return packetAck, nil
+	//約定価格を記録する
+	k.recordTrades(ctx, book.Index, trades)
+
+	for _, liquidation := range liquidated {
+		k.AfterOrderFilled(ctx, types.OrderTypeSell, book.Index, liquidation, data.Buyer)
+	}
+	for _, order := range selfTrade.Cancelled {
+		k.AfterOrderCancelled(ctx, types.OrderTypeSell, book.Index, order)
+	}
+
+	return remaining.Amount, purchase, selfTrade.Prevented, refund, nil
 }
 
 // IBCパケットがターゲットチェーンで処理された後、
 // 確認応答がソースチェーンに返された後に行う処理
 func (k Keeper) OnAcknowledgementBuyOrderPacket(ctx sdk.Context, packet channeltypes.Packet, data types.BuyOrderPacketData, ack channeltypes.Acknowledgement) error {
+	//注文は処理済みのため、保留中の注文を削除
+	deposit := k.pendingOrderDeposit(ctx, packet)
+	k.RemovePendingOrder(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	//ルーティング注文の区間の場合、確認応答で次の区間に進む
+	routedOrder, routed := k.takeRoutedOrder(ctx, packet)
+
 	switch dispatchedAck := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		//エラーが発生した場合、ネイティブトークンを元に戻す
-		receiver, err := sdk.AccAddressFromBech32(data.Buyer)
-		if err != nil {
-			return err
-		}
-		if err := k.SafeMint(
-			ctx, packet.SourcePort,
-			packet.SourceChannel,
-			receiver,
-			data.AmountDenom,
-			data.Amount*data.Price,
-		); err != nil {
+		//エラーが発生した場合、ネイティブトークンとデポジットを元に戻す
+		if err := k.refundBuyOrder(ctx, packet, data); err != nil {
 			return err
 		}
-		return nil
+		return k.refundOrderDeposit(ctx, data.Buyer, deposit, 1)
 	case *channeltypes.Acknowledgement_Result:
 		//パケット確認応答をデコードする
 		var packetAck types.BuyOrderPacketAck
@@ -152,17 +233,133 @@ func (k Keeper) OnAcknowledgementBuyOrderPacket(ctx sdk.Context, packet channelt
 			// The counter-party module doesn't implement the correct acknowledgment format
 			return errors.New("cannot unmarshal acknowledgment")
 		}
-		return nil
+		//相手チェーンの確認応答が注文を超えて返金させないことを確認する
+		if err := packetAck.ValidateSettlement(data); err != nil {
+			return err
+		}
+		//相手チェーンで約定した数量を平均価格で記録する
+		k.recordBuySettlement(ctx, packet, data, packetAck)
+
+		if routed {
+			return k.settleRoutedBuyLeg(ctx, packet, data, packetAck, deposit, routedOrder)
+		}
+		return k.settleBuyOrder(ctx, packet, data, packetAck.RemainingAmount, packetAck.Purchase, packetAck.PreventedAmount, packetAck.Refund, deposit)
 	default:
 		// 相手方モジュールが正しい確認応答形式を実装していません
 		return errors.New("invalid acknowledgment format")
 	}
 }
 
+// settleBuyOrder stores the remaining amount of an acknowledged buy order in the buy order book
+// and sends the purchased tokens, the payment of the amount cancelled by the self-trade prevention
+// and the price not spent in the fills below the price of the order to the buyer
+func (k Keeper) settleBuyOrder(ctx sdk.Context, packet channeltypes.Packet, data types.BuyOrderPacketData, remainingAmount int32, purchase int32, prevented int32, refund int64, deposit *sdk.Coin) error {
+	// 注文の残りの金額を追加する
+	if remainingAmount > 0 {
+		//残りの買い注文を、買い注文帳に保管
+		pairIndex := types.OrderBookIndex(packet.SourcePort, packet.SourceChannel, data.AmountDenom, data.PriceDenom)
+		rests := false
+		book, found := k.GetBuyOrderBook(ctx, pairIndex)
+		if found {
+			orderID, err := book.AppendOrder(data.Buyer, remainingAmount, data.Price, k.MaxOpenOrders(ctx))
+			switch {
+			case err == nil:
+				// 新しいオーダーブックを保存する
+				k.SetBuyOrderBook(ctx, book)
+				//デポジットを板に残る注文に紐付ける
+				k.lockOrderDeposit(ctx, packet, types.OrderTypeBuy, pairIndex, orderID, data.Buyer, deposit)
+				deposit = nil
+				rests = true
+				k.AfterOrderPlaced(ctx, types.OrderTypeBuy, pairIndex, types.Order{
+					Id:      orderID,
+					Creator: data.Buyer,
+					Amount:  remainingAmount,
+					Price:   data.Price,
+				})
+			case !errors.Is(err, types.ErrMaxOpenOrders):
+				return err
+			}
+		}
+		if !rests {
+			//パケットの送信中にペアが上場廃止された場合、または注文数の上限に達した場合、残りの金額を返金する
+			remaining := data
+			remaining.Amount = remainingAmount
+			if err := k.refundBuyOrder(ctx, packet, remaining); err != nil {
+				return err
+			}
+		}
+	}
+
+	//板に残らない注文のデポジットを返金する
+	if err := k.refundOrderDeposit(ctx, data.Buyer, deposit, 1); err != nil {
+		return err
+	}
+
+	//自己取引防止で取り消された数量の代金を返金する
+	if prevented > 0 {
+		cancelled := data
+		cancelled.Amount = prevented
+		if err := k.refundBuyOrder(ctx, packet, cancelled); err != nil {
+			return err
+		}
+	}
+
+	//注文の価格より安く約定して使われなかった代金を返金する
+	if refund > 0 {
+		receiver, err := sdk.AccAddressFromBech32(data.Buyer)
+		if err != nil {
+			return err
+		}
+		if err := k.SafeMint(ctx, packet.SourcePort, packet.SourceChannel, receiver, LocalDenom(data.PriceDenom), refund); err != nil {
+			return err
+		}
+	}
+
+	//購入したトークンを購入者に配布
+	if purchase > 0 {
+		receiver, err := sdk.AccAddressFromBech32(data.Buyer)
+		if err != nil {
+			return err
+		}
+		finalAmountDenom, saved := k.OriginalDenom(ctx, packet.DestinationPort, packet.DestinationChannel, LocalDenom(data.AmountDenom))
+		if !saved {
+			// このチェーンからのものではない場合、バウチャーをデノムとして使用します
+			finalAmountDenom = k.MintVoucherDenom(ctx, packet.SourcePort, packet.SourceChannel, data.AmountDenom)
+		}
+
+		if err := k.SafeMint(ctx, packet.SourcePort, packet.SourceChannel, receiver, finalAmountDenom, int64(purchase)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // OnTimeoutBuyOrderPacket responds to the case where a packet has not been transmitted because of a timeout
 func (k Keeper) OnTimeoutBuyOrderPacket(ctx sdk.Context, packet channeltypes.Packet, data types.BuyOrderPacketData) error {
+	//注文は処理されなかったため、保留中の注文を削除してトークンを元に戻す
+	deposit := k.pendingOrderDeposit(ctx, packet)
+	k.RemovePendingOrder(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	//ルーティング注文の区間の場合、ルートはここで終わる
+	k.takeRoutedOrder(ctx, packet)
 
-	// TODO: packet timeout logic
+	if err := k.refundBuyOrder(ctx, packet, data); err != nil {
+		return err
+	}
+	return k.refundOrderDeposit(ctx, data.Buyer, deposit, 1)
+}
 
-	return nil
+// refundBuyOrder returns the tokens escrowed when the order was sent
+func (k Keeper) refundBuyOrder(ctx sdk.Context, packet channeltypes.Packet, data types.BuyOrderPacketData) error {
+	receiver, err := sdk.AccAddressFromBech32(data.Buyer)
+	if err != nil {
+		return err
+	}
+	return k.SafeMint(
+		ctx, packet.SourcePort,
+		packet.SourceChannel,
+		receiver,
+		LocalDenom(data.PriceDenom),
+		int64(data.Amount)*int64(data.Price),
+	)
 }
@@ -8,11 +8,20 @@ import (
 
 // SetBuyOrderBook set a specific buyOrderBook in the store from its index
 func (k Keeper) SetBuyOrderBook(ctx sdk.Context, buyOrderBook types.BuyOrderBook) {
+	previous, _ := k.GetBuyOrderBook(ctx, buyOrderBook.Index)
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BuyOrderBookKeyPrefix))
-	b := k.cdc.MustMarshal(&buyOrderBook)
-	store.Set(types.BuyOrderBookKey(
+	key := types.BuyOrderBookKey(
 		buyOrderBook.Index,
-	), b)
+	)
+	b := k.cdc.MustMarshal(&buyOrderBook)
+	store.Set(key, b)
+
+	// index the book by the owners of its orders
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BuyOrderBookOwnerKeyPrefix))
+	updateOwnerIndex(ownerStore, types.BuyOrderBookOwnerKey, key, previous.Book, buyOrderBook.Book)
+
+	k.afterOrderBookChanged(ctx, types.OrderTypeBuy, buyOrderBook.Index, previous.Book, buyOrderBook.Book)
 }
 
 // GetBuyOrderBook returns a buyOrderBook from its index
@@ -40,10 +49,23 @@ func (k Keeper) RemoveBuyOrderBook(
 	index string,
 
 ) {
+	buyOrderBook, found := k.GetBuyOrderBook(ctx, index)
+	if !found {
+		return
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BuyOrderBookKeyPrefix))
-	store.Delete(types.BuyOrderBookKey(
+	key := types.BuyOrderBookKey(
 		index,
-	))
+	)
+	store.Delete(key)
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BuyOrderBookOwnerKeyPrefix))
+	for _, owner := range buyOrderBook.Book.Owners() {
+		ownerStore.Delete(append(types.BuyOrderBookOwnerKey(owner), key...))
+	}
+
+	k.afterOrderBookChanged(ctx, types.OrderTypeBuy, index, buyOrderBook.Book, nil)
 }
 
 // GetAllBuyOrderBook returns all buyOrderBook
@@ -61,3 +83,20 @@ func (k Keeper) GetAllBuyOrderBook(ctx sdk.Context) (list []types.BuyOrderBook)
 
 	return
 }
+
+// GetBuyOrderBooksByOwner returns all buyOrderBook with orders of an owner
+func (k Keeper) GetBuyOrderBooksByOwner(ctx sdk.Context, owner string) (list []types.BuyOrderBook) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BuyOrderBookKeyPrefix))
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BuyOrderBookOwnerKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(ownerStore, types.BuyOrderBookOwnerKey(owner))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BuyOrderBook
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &val)
+		list = append(list, val)
+	}
+
+	return
+}
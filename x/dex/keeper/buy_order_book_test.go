@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	keepertest "interchange/testutil/keeper"
 	"interchange/testutil/nullify"
+	"interchange/testutil/sample"
 	"interchange/x/dex/keeper"
 	"interchange/x/dex/types"
 )
@@ -61,3 +62,65 @@ func TestBuyOrderBookGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllBuyOrderBook(ctx)),
 	)
 }
+
+func TestBuyOrderBookGetByOwner(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	owner := sample.AccAddress()
+	books := make([]types.BuyOrderBook, 3)
+	for i := range books {
+		books[i] = types.NewBuyOrderBook("stake", "token")
+		books[i].Index = strconv.Itoa(i)
+		_, err := books[i].AppendOrder(sample.AccAddress(), 10, 10, 0)
+		require.NoError(t, err)
+	}
+	for _, book := range books[:2] {
+		_, err := book.AppendOrder(owner, 10, 10, 0)
+		require.NoError(t, err)
+		_, err = book.AppendOrder(owner, 20, 20, 0)
+		require.NoError(t, err)
+	}
+	for _, book := range books {
+		keeper.SetBuyOrderBook(ctx, book)
+	}
+	require.ElementsMatch(t,
+		nullify.Fill(books[:2]),
+		nullify.Fill(keeper.GetBuyOrderBooksByOwner(ctx, owner)),
+	)
+
+	// A book keeps its index while the owner has orders left and the first order of the owner indexes it
+	for i := range books {
+		books[i], _ = keeper.GetBuyOrderBook(ctx, books[i].Index)
+	}
+	for _, order := range books[1].Book.Orders {
+		if order.Creator == owner {
+			require.NoError(t, books[1].Book.RemoveOrderFromID(order.Id))
+			break
+		}
+	}
+	keeper.SetBuyOrderBook(ctx, books[1])
+	_, err := books[2].AppendOrder(owner, 30, 30, 0)
+	require.NoError(t, err)
+	keeper.SetBuyOrderBook(ctx, books[2])
+	require.ElementsMatch(t,
+		nullify.Fill(books),
+		nullify.Fill(keeper.GetBuyOrderBooksByOwner(ctx, owner)),
+	)
+
+	// Replacing a book without the orders of the owner drops it from the index
+	for _, order := range append([]*types.Order(nil), books[0].Book.Orders...) {
+		if order.Creator == owner {
+			require.NoError(t, books[0].Book.RemoveOrderFromID(order.Id))
+		}
+	}
+	keeper.SetBuyOrderBook(ctx, books[0])
+	require.ElementsMatch(t,
+		nullify.Fill(books[1:]),
+		nullify.Fill(keeper.GetBuyOrderBooksByOwner(ctx, owner)),
+	)
+
+	keeper.RemoveBuyOrderBook(ctx, books[1].Index)
+	require.ElementsMatch(t,
+		nullify.Fill(books[2:]),
+		nullify.Fill(keeper.GetBuyOrderBooksByOwner(ctx, owner)),
+	)
+}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"math"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"interchange/x/dex/types"
+)
+
+// SetCandle set a specific candle in the store from its index
+func (k Keeper) SetCandle(ctx sdk.Context, candle types.Candle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandleKeyPrefix))
+	b := k.cdc.MustMarshal(&candle)
+	store.Set(types.CandleKey(
+		candle.PairIndex,
+		candle.Interval,
+		candle.OpenTime,
+	), b)
+}
+
+// GetCandle returns a candle from its index
+func (k Keeper) GetCandle(
+	ctx sdk.Context,
+	pairIndex string,
+	interval time.Duration,
+	openTime time.Time,
+
+) (val types.Candle, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandleKeyPrefix))
+
+	b := store.Get(types.CandleKey(
+		pairIndex,
+		interval,
+		openTime,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllCandle returns all candle
+func (k Keeper) GetAllCandle(ctx sdk.Context) (list []types.Candle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandleKeyPrefix))
+	return k.getAllCandle(store)
+}
+
+// SetTickerBucket set a specific hourly candle of the tickers in the store
+func (k Keeper) SetTickerBucket(ctx sdk.Context, candle types.Candle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TickerBucketKeyPrefix))
+	b := k.cdc.MustMarshal(&candle)
+	store.Set(types.CandleKey(
+		candle.PairIndex,
+		candle.Interval,
+		candle.OpenTime,
+	), b)
+}
+
+// GetAllTickerBucket returns all the hourly candles of the tickers
+func (k Keeper) GetAllTickerBucket(ctx sdk.Context) (list []types.Candle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TickerBucketKeyPrefix))
+	return k.getAllCandle(store)
+}
+
+// GetTicker returns the market stats of a pair over the last 24 hours,
+// it is not found if no trade was ever matched in the order book of the pair
+func (k Keeper) GetTicker(ctx sdk.Context, pairIndex string) (val types.Ticker, found bool) {
+	lastPrice, found := k.GetLastPrice(ctx, pairIndex)
+	if !found {
+		return val, false
+	}
+
+	//ウィンドウと重なるバケットから統計を計算する
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TickerBucketKeyPrefix))
+	start := ctx.BlockTime().Add(-types.TickerWindow).Truncate(types.TickerBucketInterval)
+	iterator := prefix.NewStore(store, types.CandleIntervalKey(pairIndex, types.TickerBucketInterval)).
+		Iterator(timeKey(start), nil)
+
+	defer iterator.Close()
+
+	var candles []types.Candle
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Candle
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		candles = append(candles, val)
+	}
+
+	return types.NewTicker(pairIndex, lastPrice.Price, candles), true
+}
+
+// recordCandles aggregates the orders of a book liquidated by the matching engine
+// in the candles and the ticker buckets of the pair
+func (k Keeper) recordCandles(ctx sdk.Context, index string, liquidated []types.Order) {
+	now := ctx.BlockTime()
+
+	if k.CandleRetention(ctx) > 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandleKeyPrefix))
+		for _, interval := range k.CandleIntervals(ctx) {
+			k.aggregateCandle(store, index, interval, now, liquidated)
+		}
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TickerBucketKeyPrefix))
+	k.aggregateCandle(store, index, types.TickerBucketInterval, now, liquidated)
+}
+
+// PruneCandles removes the candles past their retention and the ticker buckets out of the window,
+// the candles of the intervals no longer in the params or all of them when the candles are disabled
+func (k Keeper) PruneCandles(ctx sdk.Context) {
+	now := ctx.BlockTime()
+	retention := k.CandleRetention(ctx)
+	intervals := make(map[time.Duration]struct{})
+	if retention > 0 {
+		for _, interval := range k.CandleIntervals(ctx) {
+			intervals[interval] = struct{}{}
+		}
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandleKeyPrefix))
+	k.walkCandleSeries(store, func(index string, interval time.Duration) {
+		if _, ok := intervals[interval]; !ok {
+			//無効なローソク足はすべて削除する
+			k.pruneCandles(store, index, interval, nil)
+			return
+		}
+		//保持期間を過ぎたローソク足を削除する
+		if int64(retention-1) <= math.MaxInt64/int64(interval) {
+			openTime := now.UTC().Truncate(interval)
+			k.pruneCandles(store, index, interval, timeKey(openTime.Add(-time.Duration(retention-1)*interval)))
+		}
+	})
+
+	//ティッカーのバケットはウィンドウを過ぎたら削除する
+	store = prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TickerBucketKeyPrefix))
+	before := timeKey(now.UTC().Truncate(types.TickerBucketInterval).Add(-types.TickerWindow))
+	k.walkCandleSeries(store, func(index string, interval time.Duration) {
+		k.pruneCandles(store, index, interval, before)
+	})
+}
+
+// walkCandleSeries calls the function once for every pair and interval with candles in the store
+func (k Keeper) walkCandleSeries(store prefix.Store, fn func(index string, interval time.Duration)) {
+	var start []byte
+	for {
+		iterator := store.Iterator(start, nil)
+		if !iterator.Valid() {
+			iterator.Close()
+			return
+		}
+		var candle types.Candle
+		k.cdc.MustUnmarshal(iterator.Value(), &candle)
+		iterator.Close()
+
+		fn(candle.PairIndex, candle.Interval)
+		//次のペアと期間の系列に進む
+		start = sdk.PrefixEndBytes(types.CandleIntervalKey(candle.PairIndex, candle.Interval))
+		if start == nil {
+			return
+		}
+	}
+}
+
+// aggregateCandle adds the liquidated orders to the candle of the interval containing the time
+func (k Keeper) aggregateCandle(store prefix.Store, index string, interval time.Duration, t time.Time, liquidated []types.Order) {
+	candle := types.NewCandle(index, interval, t, liquidated[0].Price)
+	key := types.CandleKey(index, interval, candle.OpenTime)
+	if b := store.Get(key); b != nil {
+		k.cdc.MustUnmarshal(b, &candle)
+	}
+
+	for _, order := range liquidated {
+		candle.AddTrade(order.Amount, order.Price)
+	}
+	store.Set(key, k.cdc.MustMarshal(&candle))
+}
+
+// pruneCandles removes the candles of a pair for an interval with a key before the end, all of them if it is nil
+func (k Keeper) pruneCandles(store prefix.Store, index string, interval time.Duration, end []byte) {
+	intervalStore := prefix.NewStore(store, types.CandleIntervalKey(index, interval))
+	iterator := intervalStore.Iterator(nil, end)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		intervalStore.Delete(key)
+	}
+}
+
+func (k Keeper) getAllCandle(store prefix.Store) (list []types.Candle) {
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Candle
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// timeKey returns the key of the candles opened at the time within the store of a pair and interval
+func timeKey(t time.Time) []byte {
+	return append(sdk.FormatTimeBytes(t), []byte("/")...)
+}
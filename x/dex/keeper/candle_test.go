@@ -0,0 +1,210 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/sample"
+	"interchange/x/dex/types"
+)
+
+var candleBaseTime = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// matchTrades rests buy orders at the prices of the trades and fills them with a single sell order
+func matchTrades(t *testing.T, f *keepertest.BankFixture, pairIndex string, blockTime time.Time, trades ...types.Order) {
+	ctx := f.Ctx.WithBlockTime(blockTime)
+
+	book, found := f.Keeper.GetBuyOrderBook(ctx, pairIndex)
+	if !found {
+		book = types.NewBuyOrderBook("stake", "token")
+		book.Index = pairIndex
+	}
+	var amount int32
+	for _, trade := range trades {
+		_, err := book.AppendOrder(sample.AccAddress(), trade.Amount, trade.Price, 0)
+		require.NoError(t, err)
+		amount += trade.Amount
+	}
+	f.Keeper.SetBuyOrderBook(ctx, book)
+
+	ack, err := f.Keeper.OnRecvSellOrderPacket(ctx, channeltypes.Packet{
+		SourcePort:         "dex",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "dex",
+		DestinationChannel: "channel-1",
+	}, types.SellOrderPacketData{
+		AmountDenom: "stake",
+		Amount:      amount,
+		PriceDenom:  "token",
+		Price:       1,
+		Seller:      sample.AccAddress(),
+	})
+	require.NoError(t, err)
+	require.Zero(t, ack.RemainingAmount)
+}
+
+func TestRecordCandles(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	params := types.DefaultParams()
+	params.CandleIntervals = []time.Duration{time.Minute, time.Hour}
+	params.CandleRetention = 2
+	f.Keeper.SetParams(f.Ctx, params)
+
+	// The buy orders are filled from the highest price
+	matchTrades(t, f, pairIndex, candleBaseTime.Add(30*time.Second), types.Order{Amount: 5, Price: 10})
+	matchTrades(t, f, pairIndex, candleBaseTime.Add(40*time.Second),
+		types.Order{Amount: 2, Price: 8},
+		types.Order{Amount: 3, Price: 12},
+	)
+	candle, found := f.Keeper.GetCandle(f.Ctx, pairIndex, time.Minute, candleBaseTime)
+	require.True(t, found)
+	require.Equal(t, types.Candle{
+		PairIndex: pairIndex,
+		Interval:  time.Minute,
+		OpenTime:  candleBaseTime,
+		Open:      10,
+		High:      12,
+		Low:       8,
+		Close:     8,
+		Volume:    10,
+		Trades:    3,
+	}, candle)
+
+	// A new minute candle is opened while the hour candle keeps aggregating
+	matchTrades(t, f, pairIndex, candleBaseTime.Add(70*time.Second), types.Order{Amount: 1, Price: 9})
+	candle, found = f.Keeper.GetCandle(f.Ctx, pairIndex, time.Minute, candleBaseTime.Add(time.Minute))
+	require.True(t, found)
+	require.Equal(t, types.Candle{
+		PairIndex: pairIndex,
+		Interval:  time.Minute,
+		OpenTime:  candleBaseTime.Add(time.Minute),
+		Open:      9,
+		High:      9,
+		Low:       9,
+		Close:     9,
+		Volume:    1,
+		Trades:    1,
+	}, candle)
+	candle, found = f.Keeper.GetCandle(f.Ctx, pairIndex, time.Hour, candleBaseTime)
+	require.True(t, found)
+	require.EqualValues(t, 9, candle.Close)
+	require.EqualValues(t, 11, candle.Volume)
+	require.EqualValues(t, 4, candle.Trades)
+
+	// Only the last two minute candles are kept at the end of the block
+	matchTrades(t, f, pairIndex, candleBaseTime.Add(2*time.Minute), types.Order{Amount: 1, Price: 11})
+	require.Len(t, f.Keeper.GetAllCandle(f.Ctx), 4)
+	f.Keeper.PruneCandles(f.Ctx.WithBlockTime(candleBaseTime.Add(2 * time.Minute)))
+	_, found = f.Keeper.GetCandle(f.Ctx, pairIndex, time.Minute, candleBaseTime)
+	require.False(t, found)
+	require.Len(t, f.Keeper.GetAllCandle(f.Ctx), 3)
+}
+
+func TestPruneCandles(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	params := types.DefaultParams()
+	params.CandleIntervals = []time.Duration{time.Minute, time.Hour}
+	params.CandleRetention = 2
+	f.Keeper.SetParams(f.Ctx, params)
+
+	matchTrades(t, f, pairIndex, candleBaseTime, types.Order{Amount: 1, Price: 10})
+	matchTrades(t, f, pairIndex, candleBaseTime.Add(time.Minute), types.Order{Amount: 1, Price: 10})
+	require.Len(t, f.Keeper.GetAllCandle(f.Ctx), 3)
+
+	// The candles are pruned as the time passes without new trades
+	f.Keeper.PruneCandles(f.Ctx.WithBlockTime(candleBaseTime.Add(2*time.Minute + 30*time.Second)))
+	_, found := f.Keeper.GetCandle(f.Ctx, pairIndex, time.Minute, candleBaseTime)
+	require.False(t, found)
+	_, found = f.Keeper.GetCandle(f.Ctx, pairIndex, time.Minute, candleBaseTime.Add(time.Minute))
+	require.True(t, found)
+	require.Len(t, f.Keeper.GetAllCandle(f.Ctx), 2)
+
+	// The candles of an interval removed from the params are pruned
+	params.CandleIntervals = []time.Duration{time.Minute}
+	f.Keeper.SetParams(f.Ctx, params)
+	f.Keeper.PruneCandles(f.Ctx.WithBlockTime(candleBaseTime.Add(2*time.Minute + 30*time.Second)))
+	require.Equal(t, []types.Candle{{
+		PairIndex: pairIndex,
+		Interval:  time.Minute,
+		OpenTime:  candleBaseTime.Add(time.Minute),
+		Open:      10,
+		High:      10,
+		Low:       10,
+		Close:     10,
+		Volume:    1,
+		Trades:    1,
+	}}, f.Keeper.GetAllCandle(f.Ctx))
+
+	// All the candles are pruned once disabled
+	params.CandleRetention = 0
+	f.Keeper.SetParams(f.Ctx, params)
+	f.Keeper.PruneCandles(f.Ctx.WithBlockTime(candleBaseTime.Add(2*time.Minute + 30*time.Second)))
+	require.Empty(t, f.Keeper.GetAllCandle(f.Ctx))
+	require.Len(t, f.Keeper.GetAllTickerBucket(f.Ctx), 1)
+}
+
+func TestRecordCandlesDisabled(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	params := types.DefaultParams()
+	params.CandleRetention = 0
+	f.Keeper.SetParams(f.Ctx, params)
+
+	// The ticker is still maintained
+	matchTrades(t, f, pairIndex, candleBaseTime, types.Order{Amount: 5, Price: 10})
+	require.Empty(t, f.Keeper.GetAllCandle(f.Ctx))
+	require.Len(t, f.Keeper.GetAllTickerBucket(f.Ctx), 1)
+}
+
+func TestGetTicker(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+
+	_, found := f.Keeper.GetTicker(f.Ctx, pairIndex)
+	require.False(t, found)
+
+	matchTrades(t, f, pairIndex, candleBaseTime.Add(30*time.Minute), types.Order{Amount: 1, Price: 5})
+	matchTrades(t, f, pairIndex, candleBaseTime.Add(90*time.Minute), types.Order{Amount: 2, Price: 10})
+	matchTrades(t, f, pairIndex, candleBaseTime.Add(23*time.Hour+30*time.Minute),
+		types.Order{Amount: 3, Price: 15},
+		types.Order{Amount: 4, Price: 20},
+	)
+
+	// The window starts at the bucket of the first trade of the last 24 hours
+	ticker, found := f.Keeper.GetTicker(f.Ctx.WithBlockTime(candleBaseTime.Add(25*time.Hour+10*time.Minute)), pairIndex)
+	require.True(t, found)
+	require.Equal(t, types.Ticker{
+		PairIndex:  pairIndex,
+		LastPrice:  15,
+		Open:       10,
+		High:       20,
+		Low:        10,
+		Volume:     9,
+		Trades:     3,
+		Change:     5,
+		ChangeRate: sdk.NewDecWithPrec(5, 1),
+	}, ticker)
+
+	// The buckets out of the window are pruned at the end of the block
+	matchTrades(t, f, pairIndex, candleBaseTime.Add(26*time.Hour+30*time.Minute), types.Order{Amount: 1, Price: 30})
+	f.Keeper.PruneCandles(f.Ctx.WithBlockTime(candleBaseTime.Add(26*time.Hour + 30*time.Minute)))
+	require.Len(t, f.Keeper.GetAllTickerBucket(f.Ctx), 2)
+
+	// Without trades in the window, the stats only hold the last price
+	ticker, found = f.Keeper.GetTicker(f.Ctx.WithBlockTime(candleBaseTime.Add(72*time.Hour)), pairIndex)
+	require.True(t, found)
+	require.Equal(t, types.Ticker{
+		PairIndex:  pairIndex,
+		LastPrice:  30,
+		Open:       30,
+		High:       30,
+		Low:        30,
+		ChangeRate: sdk.ZeroDec(),
+	}, ticker)
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"interchange/x/dex/types"
+)
+
+// setTripped trips or resets the circuit breaker of a type in the given prefix store
+func (k Keeper) setTripped(ctx sdk.Context, keyPrefix string, typ string, tripped bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	if tripped {
+		store.Set(types.CircuitBreakerKey(typ), []byte{1})
+	} else {
+		store.Delete(types.CircuitBreakerKey(typ))
+	}
+}
+
+// isTripped returns true if the circuit breaker of a type is tripped in the given prefix store
+func (k Keeper) isTripped(ctx sdk.Context, keyPrefix string, typ string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	return store.Has(types.CircuitBreakerKey(typ))
+}
+
+// getAllTripped returns all the tripped types of the given prefix store
+func (k Keeper) getAllTripped(ctx sdk.Context, keyPrefix string) (list []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		// キーの末尾の区切り文字を取り除く
+		list = append(list, string(key[:len(key)-1]))
+	}
+
+	return
+}
+
+// SetMsgTripped trips or resets the circuit breaker of a message type URL
+func (k Keeper) SetMsgTripped(ctx sdk.Context, msgType string, tripped bool) {
+	k.setTripped(ctx, types.CircuitBreakerMsgKeyPrefix, msgType, tripped)
+}
+
+// IsMsgTripped returns true if the message type URL is disabled by the circuit breaker
+func (k Keeper) IsMsgTripped(ctx sdk.Context, msgType string) bool {
+	return k.isTripped(ctx, types.CircuitBreakerMsgKeyPrefix, msgType)
+}
+
+// GetAllTrippedMsgTypes returns all the message type URLs disabled by the circuit breaker
+func (k Keeper) GetAllTrippedMsgTypes(ctx sdk.Context) []string {
+	return k.getAllTripped(ctx, types.CircuitBreakerMsgKeyPrefix)
+}
+
+// SetPacketTripped trips or resets the circuit breaker of a packet type
+func (k Keeper) SetPacketTripped(ctx sdk.Context, packetType string, tripped bool) {
+	k.setTripped(ctx, types.CircuitBreakerPacketKeyPrefix, packetType, tripped)
+}
+
+// IsPacketTripped returns true if the packet type is disabled by the circuit breaker
+func (k Keeper) IsPacketTripped(ctx sdk.Context, packetType string) bool {
+	return k.isTripped(ctx, types.CircuitBreakerPacketKeyPrefix, packetType)
+}
+
+// GetAllTrippedPacketTypes returns all the packet types disabled by the circuit breaker
+func (k Keeper) GetAllTrippedPacketTypes(ctx sdk.Context) []string {
+	return k.getAllTripped(ctx, types.CircuitBreakerPacketKeyPrefix)
+}
+
+// SetCircuitBreaker trips or resets the circuit breaker of the message and packet types
+func (k Keeper) SetCircuitBreaker(ctx sdk.Context, msgTypes, packetTypes []string, tripped bool) error {
+	if err := types.ValidateCircuitBreakerTypes(msgTypes, packetTypes); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// パケットタイプはハンドラーが登録されている必要がある
+	for _, packetType := range packetTypes {
+		if _, found := k.GetPacketHandler(packetType); !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unrecognized %s packet type: %s", types.ModuleName, packetType)
+		}
+	}
+
+	for _, msgType := range msgTypes {
+		k.SetMsgTripped(ctx, msgType, tripped)
+	}
+	for _, packetType := range packetTypes {
+		k.SetPacketTripped(ctx, packetType, tripped)
+	}
+
+	return nil
+}
+
+// CheckMsgEnabled returns an error if the message is disabled by the circuit breaker
+func (k Keeper) CheckMsgEnabled(ctx sdk.Context, msg sdk.Msg) error {
+	msgType := sdk.MsgTypeURL(msg)
+	if k.IsMsgTripped(ctx, msgType) {
+		return sdkerrors.Wrapf(types.ErrCircuitBreakerTripped, "message %s is disabled", msgType)
+	}
+	return nil
+}
+
+// CheckPacketEnabled returns an error if the packet type is disabled by the circuit breaker
+func (k Keeper) CheckPacketEnabled(ctx sdk.Context, packetType string) error {
+	if k.IsPacketTripped(ctx, packetType) {
+		return sdkerrors.Wrapf(types.ErrCircuitBreakerTripped, "packet %s is disabled", packetType)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/sample"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	msgType := sdk.MsgTypeURL(&types.MsgSendSellOrder{})
+
+	require.NoError(t, k.SetCircuitBreaker(ctx, []string{msgType}, []string{types.EventTypeBuyOrderPacket}, true))
+	require.ErrorIs(t, k.CheckMsgEnabled(ctx, &types.MsgSendSellOrder{}), types.ErrCircuitBreakerTripped)
+	require.NoError(t, k.CheckMsgEnabled(ctx, &types.MsgSendBuyOrder{}))
+	require.ErrorIs(t, k.CheckPacketEnabled(ctx, types.EventTypeBuyOrderPacket), types.ErrCircuitBreakerTripped)
+	require.NoError(t, k.CheckPacketEnabled(ctx, types.EventTypeSellOrderPacket))
+	require.Equal(t, []string{msgType}, k.GetAllTrippedMsgTypes(ctx))
+	require.Equal(t, []string{types.EventTypeBuyOrderPacket}, k.GetAllTrippedPacketTypes(ctx))
+
+	// Unknown packet types are rejected
+	err := k.SetCircuitBreaker(ctx, nil, []string{"unknown"}, true)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	require.NoError(t, k.SetCircuitBreaker(ctx, []string{msgType}, []string{types.EventTypeBuyOrderPacket}, false))
+	require.NoError(t, k.CheckMsgEnabled(ctx, &types.MsgSendSellOrder{}))
+	require.NoError(t, k.CheckPacketEnabled(ctx, types.EventTypeBuyOrderPacket))
+	require.Empty(t, k.GetAllTrippedMsgTypes(ctx))
+	require.Empty(t, k.GetAllTrippedPacketTypes(ctx))
+}
+
+func TestMsgServerSetCircuitBreaker(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	authority := sample.AccAddress()
+	msg := types.NewMsgSetCircuitBreaker(authority, []string{sdk.MsgTypeURL(&types.MsgCancelSellOrder{})}, nil, true)
+
+	// Only governance can trip the circuit breaker when no authority is set
+	_, err := srv.SetCircuitBreaker(wctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	params := types.DefaultParams()
+	params.CircuitBreakerAuthority = authority
+	k.SetParams(ctx, params)
+	_, err = srv.SetCircuitBreaker(wctx, types.NewMsgSetCircuitBreaker(sample.AccAddress(), msg.MsgTypes, nil, true))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.SetCircuitBreaker(wctx, msg)
+	require.NoError(t, err)
+
+	// Tripped messages are rejected before any other check
+	_, err = srv.CancelSellOrder(wctx, types.NewMsgCancelSellOrder(authority, "dex", "channel-0", "stake", "token", 0))
+	require.ErrorIs(t, err, types.ErrCircuitBreakerTripped)
+}
@@ -6,65 +6,22 @@ import (
 	"interchange/x/dex/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
-	host "github.com/cosmos/ibc-go/v2/modules/core/24-host"
+	"github.com/gogo/protobuf/proto"
 )
 
-// TransmitCreatePairPacketは、指定されたソースポートとソースチャネルを使用してIBC経由でパケットを送信します。
-func (k Keeper) TransmitCreatePairPacket(
-	ctx sdk.Context,
-	packetData types.CreatePairPacketData,
-	sourcePort,
-	sourceChannel string,
-	timeoutHeight clienttypes.Height,
-	timeoutTimestamp uint64,
-) error {
-
-	sourceChannelEnd, found := k.ChannelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
-	if !found {
-		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
-	}
-
-	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
-	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
-
-	// get the next sequence
-	sequence, found := k.ChannelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
-	if !found {
-		return sdkerrors.Wrapf(
-			channeltypes.ErrSequenceSendNotFound,
-			"source port: %s, source channel: %s", sourcePort, sourceChannel,
-		)
-	}
-
-	channelCap, ok := k.ScopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
-	if !ok {
-		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
-	}
-
-	packetBytes, err := packetData.GetBytes()
-	if err != nil {
-		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
-	}
-
-	packet := channeltypes.NewPacket(
-		packetBytes,
-		sequence,
-		sourcePort,
-		sourceChannel,
-		destinationPort,
-		destinationChannel,
-		timeoutHeight,
-		timeoutTimestamp,
-	)
-
-	if err := k.ChannelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
-		return err
-	}
-
-	return nil
+// createPairPacketHandler routes CreatePairPacketData to the keeper callbacks
+var createPairPacketHandler = PacketHandler{
+	OnRecv: func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData) (proto.Message, error) {
+		packetAck, err := k.OnRecvCreatePairPacket(ctx, packet, *data.(*types.CreatePairPacketData))
+		return &packetAck, err
+	},
+	OnAcknowledgement: func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData, ack channeltypes.Acknowledgement) error {
+		return k.OnAcknowledgementCreatePairPacket(ctx, packet, *data.(*types.CreatePairPacketData), ack)
+	},
+	OnTimeout: func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData) error {
+		return k.OnTimeoutCreatePairPacket(ctx, packet, *data.(*types.CreatePairPacketData))
+	},
 }
 
 // パケット受信を処理
@@ -75,21 +32,40 @@ func (k Keeper) OnRecvCreatePairPacket(ctx sdk.Context, packet channeltypes.Pack
 		return packetAck, err
 	}
 
+	//ターゲットチェーンのIBCバウチャーはフルパスのdenomでオーダーブックを作成する
+	targetDenom, err := k.FullDenomPath(ctx, data.TargetDenom)
+	if err != nil {
+		return packetAck, err
+	}
+
 	//IBCパケットがターゲットチェーンで受信されると、
 	//モジュールは買い注文書が既に存在するかどうかを確認する必要がある。
-	pairIndex := types.OrderBookIndex(packet.SourcePort, packet.SourceChannel, data.SourceDenom, data.TargetDenom)
+	pairIndex := types.OrderBookIndex(packet.SourcePort, packet.SourceChannel, data.SourceDenom, targetDenom)
 	_, found := k.GetBuyOrderBook(ctx, pairIndex)
 	//買い注文書(ペア)が既に存在している場合
 	if found {
 		return packetAck, errors.New("the pair already exist")
 	}
+	//ペアの作成が制限されている場合、ガバナンスで許可されている必要がある
+	if !k.IsPairCreationAllowed(ctx, pairIndex) {
+		return packetAck, errors.New("the pair creation is not allowed")
+	}
+	//ペア作成ポリシーはこちらのチェーンでも適用される
+	if err := k.CheckPairCreationPolicy(ctx, data.Creator, data.SourceDenom, targetDenom, data.Deposit); err != nil {
+		return packetAck, err
+	}
 	//買い注文書が存在しなかった場合、指定されたdenomsの買い注文書を作成
-	book := types.NewBuyOrderBook(data.SourceDenom, data.TargetDenom)
+	book := types.NewBuyOrderBook(data.SourceDenom, targetDenom)
 	//OrderBookIndexの割り当て
 	book.Index = pairIndex
+	//送信元チェーンのdenomの表示用メタデータを保存
+	book.AmountDenomMetadata = data.SourceMetadata
 	//買い注文ストアに保存
 	k.SetBuyOrderBook(ctx, book)
+	k.AfterPairCreated(ctx, pairIndex, book.AmountDenom, book.PriceDenom)
 
+	//ソースチェーンが同じdenomで売り注文書を作成できるように、フルパスのdenomを返す
+	packetAck.TargetDenom = targetDenom
 	return packetAck, nil
 }
 
@@ -98,6 +74,10 @@ func (k Keeper) OnRecvCreatePairPacket(ctx sdk.Context, packet channeltypes.Pack
 func (k Keeper) OnAcknowledgementCreatePairPacket(ctx sdk.Context, packet channeltypes.Packet, data types.CreatePairPacketData, ack channeltypes.Acknowledgement) error {
 	switch dispatchedAck := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
+		//ペアが拒否された場合、デポジットを燃焼
+		if data.Deposit != nil {
+			return k.BurnPairCreationDeposit(ctx, *data.Deposit)
+		}
 		return nil
 	case *channeltypes.Acknowledgement_Result:
 		// Decode the packet acknowledgment
@@ -107,13 +87,19 @@ func (k Keeper) OnAcknowledgementCreatePairPacket(ctx sdk.Context, packet channe
 			return errors.New("cannot unmarshal acknowledgment")
 		}
 
-		//売り注文書を作成
-		pairIndex := types.OrderBookIndex(packet.SourcePort, packet.SourceChannel, data.SourceDenom, data.TargetDenom)
-		book := types.NewSellOrderBook(data.SourceDenom, data.TargetDenom)
+		//ターゲットチェーンで解決されたフルパスのdenomで売り注文書を作成
+		targetDenom := data.TargetDenom
+		if packetAck.TargetDenom != "" {
+			targetDenom = packetAck.TargetDenom
+		}
+		pairIndex := types.OrderBookIndex(packet.SourcePort, packet.SourceChannel, data.SourceDenom, targetDenom)
+		book := types.NewSellOrderBook(data.SourceDenom, targetDenom)
 		book.Index = pairIndex
 		k.SetSellOrderBook(ctx, book)
+		k.AfterPairCreated(ctx, pairIndex, book.AmountDenom, book.PriceDenom)
 
-		return nil
+		//ペアが作成された場合、デポジットを作成者に返却
+		return k.refundPairCreationDeposit(ctx, data)
 	default:
 		// 相手方モジュールが正しい確認応答形式を実装していない場合
 		return errors.New("invalid acknowledgment format")
@@ -122,8 +108,18 @@ func (k Keeper) OnAcknowledgementCreatePairPacket(ctx sdk.Context, packet channe
 
 // OnTimeoutCreatePairPacket responds to the case where a packet has not been transmitted because of a timeout
 func (k Keeper) OnTimeoutCreatePairPacket(ctx sdk.Context, packet channeltypes.Packet, data types.CreatePairPacketData) error {
+	//タイムアウトの場合、デポジットを作成者に返却
+	return k.refundPairCreationDeposit(ctx, data)
+}
 
-	// TODO: packet timeout logic
-
-	return nil
+// refundPairCreationDeposit returns the deposit escrowed for the pair creation packet, if any
+func (k Keeper) refundPairCreationDeposit(ctx sdk.Context, data types.CreatePairPacketData) error {
+	if data.Deposit == nil {
+		return nil
+	}
+	creator, err := sdk.AccAddressFromBech32(data.Creator)
+	if err != nil {
+		return err
+	}
+	return k.RefundPairCreationDeposit(ctx, creator, *data.Deposit)
 }
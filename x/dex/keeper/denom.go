@@ -1,41 +1,81 @@
 package keeper
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
 
 	"interchange/x/dex/types"
 )
 
 // denomのバウチャーを保存する(後で元に戻すことができるようにする)
-func (k Keeper) SaveVoucherDenom(ctx sdk.Context, port string, channel string, denom string) {
-	voucher := VoucherDenom(port, channel, denom)
+// バウチャーは相手チェーンで発行されるため、チャネルの相手側のポートIDとチャネルIDから計算する
+func (k Keeper) SaveVoucherDenom(ctx sdk.Context, port string, channel string, denom string) error {
+	channelEnd, found := k.ChannelKeeper.GetChannel(ctx, port, channel)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", port, channel)
+	}
+	counterpartyPort := channelEnd.GetCounterparty().GetPortID()
+	counterpartyChannel := channelEnd.GetCounterparty().GetChannelID()
+
+	// 相手チェーンでのバウチャーのパスを含むdenomTraceを構築
+	trace := types.NewDenomTrace(counterpartyPort, counterpartyChannel, denom, LocalDenom(denom))
 
 	// denomTraceを取得
-	_, saved := k.GetDenomTrace(ctx, voucher)
+	_, saved := k.GetDenomTrace(ctx, trace.Index)
 	//存在しない(保存されていない)場合のみ、保存
 	if !saved {
-		k.SetDenomTrace(ctx, types.DenomTrace{
-			Index:   voucher,
-			Port:    port,
-			Channel: channel,
-			Origin:  denom,
-		})
+		k.SetDenomTrace(ctx, trace)
 	}
+	return nil
 }
 
 // ポートIDとチャネルIDからdenomのバウチャーを返す
 func VoucherDenom(port string, channel string, denom string) string {
-	//sourcePrefixを取得
-	sourcePrefix := ibctransfertypes.GetDenomPrefix(port, channel)
+	return types.NewDenomTrace(port, channel, denom, "").Index
+}
+
+// 受信したトークンのバウチャーを返し、transferモジュールのDenomTraceストアに登録する
+// (ICS-20で送り返したり、transferモジュールのクエリで解決できるようにする)
+func (k Keeper) MintVoucherDenom(ctx sdk.Context, port string, channel string, denom string) string {
+	denomTrace := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetDenomPrefix(port, channel) + denom)
+	if k.transferKeeper != nil && !k.transferKeeper.HasDenomTrace(ctx, denomTrace.Hash()) {
+		k.transferKeeper.SetDenomTrace(ctx, denomTrace)
+	}
+	return denomTrace.IBCDenom()
+}
 
-	// prefixedDenomを取得(sourcePrefixの末尾に「/」が含まれている)
-	prefixedDenom := sourcePrefix + denom
+// ローカルのdenomをパケットで送るためのフルパスに変換する
+// ibc/{hash}形式のバウチャーはtransferモジュールのDenomTraceからパスを復元する
+func (k Keeper) FullDenomPath(ctx sdk.Context, denom string) (string, error) {
+	if !strings.HasPrefix(denom, ibctransfertypes.DenomPrefix+"/") {
+		return denom, nil
+	}
 
-	// construct the denomination trace from the full raw denomination
-	denomTrace := ibctransfertypes.ParseDenomTrace(prefixedDenom)
-	voucher := denomTrace.IBCDenom()
-	return voucher[:16]
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(denom, ibctransfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return "", sdkerrors.Wrap(ibctransfertypes.ErrInvalidDenomForTransfer, err.Error())
+	}
+	if k.transferKeeper == nil {
+		return "", sdkerrors.Wrap(ibctransfertypes.ErrTraceNotFound, hash.String())
+	}
+	denomTrace, found := k.transferKeeper.GetDenomTrace(ctx, hash)
+	if !found {
+		return "", sdkerrors.Wrap(ibctransfertypes.ErrTraceNotFound, hash.String())
+	}
+	return denomTrace.GetFullDenomPath(), nil
+}
+
+// フルパスのdenomをローカルのdenomに変換する
+// ネイティブのdenomとibc/{hash}形式のバウチャーはそのまま返す
+func LocalDenom(denom string) string {
+	if strings.HasPrefix(denom, ibctransfertypes.DenomPrefix+"/") {
+		return denom
+	}
+	return ibctransfertypes.ParseDenomTrace(denom).IBCDenom()
 }
 
 // バウチャーの元のdenomを返す
@@ -44,9 +84,10 @@ func (k Keeper) OriginalDenom(ctx sdk.Context, port string, channel string, vouc
 	trace, exist := k.GetDenomTrace(ctx, voucher)
 	//存在した場合
 	if exist {
-		if trace.Port == port && trace.Channel == channel {
-			//オリジン(denom)とtrueを返す
-			return trace.Origin, true
+		//パスの先頭が指定されたポートIDとチャネルIDの場合、そのホップだけを取り除く
+		if trace.HasCurrentHop(port, channel) {
+			//元のチェーンでのdenomとtrueを返す
+			return LocalDenom(trace.OriginDenomPath()), true
 		}
 	}
 	//存在しない(指定されたポートIDとチャネルIDがバウチャーのオリジンでない)場合は、""とfalseを返す
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"interchange/x/dex/types"
+)
+
+// GetSourceDenomMetadata checks the denom exists on this chain and returns its display metadata
+func (k Keeper) GetSourceDenomMetadata(ctx sdk.Context, denom string) (types.DenomMetadata, error) {
+	//バンクモジュールにメタデータが登録されている場合はそれを使用
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if found {
+		return types.DenomMetadataFromBank(metadata), nil
+	}
+
+	//メタデータが無い場合、供給量が存在する必要がある
+	if !k.bankKeeper.HasSupply(ctx, denom) {
+		return types.DenomMetadata{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "denom %s does not exist", denom)
+	}
+
+	//メタデータが無いdenomはフルパスで表示する
+	display, err := k.FullDenomPath(ctx, denom)
+	if err != nil {
+		return types.DenomMetadata{}, err
+	}
+	return types.DenomMetadata{Display: display}, nil
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	channelkeeper "github.com/cosmos/ibc-go/v2/modules/core/04-channel/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "interchange/testutil/keeper"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+func TestVoucherDenom(t *testing.T) {
+	voucher := keeper.VoucherDenom("dex", "channel-0", "stake")
+	expected := ibctransfertypes.ParseDenomTrace("dex/channel-0/stake").IBCDenom()
+	require.Equal(t, expected, voucher)
+	require.Len(t, voucher, len("ibc/")+64)
+	require.NotEqual(t, voucher, keeper.VoucherDenom("dex", "channel-1", "stake"))
+}
+
+func TestLocalDenom(t *testing.T) {
+	voucher := keeper.VoucherDenom("transfer", "channel-0", "uatom")
+	for _, tc := range []struct {
+		desc     string
+		denom    string
+		expected string
+	}{
+		{desc: "Native", denom: "stake", expected: "stake"},
+		{desc: "Voucher", denom: voucher, expected: voucher},
+		{desc: "FullPath", denom: "transfer/channel-0/uatom", expected: voucher},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expected, keeper.LocalDenom(tc.denom))
+		})
+	}
+}
+
+func TestFullDenomPath(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+
+	denom, err := k.FullDenomPath(ctx, "stake")
+	require.NoError(t, err)
+	require.Equal(t, "stake", denom)
+
+	_, err = k.FullDenomPath(ctx, keeper.VoucherDenom("transfer", "channel-0", "uatom"))
+	require.ErrorIs(t, err, ibctransfertypes.ErrTraceNotFound)
+
+	_, err = k.FullDenomPath(ctx, "ibc/invalid")
+	require.ErrorIs(t, err, ibctransfertypes.ErrInvalidDenomForTransfer)
+}
+
+func setCounterparty(k *keeper.Keeper, ctx sdk.Context, port, channel, counterpartyPort, counterpartyChannel string) {
+	k.ChannelKeeper.(channelkeeper.Keeper).SetChannel(ctx, port, channel, channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(counterpartyPort, counterpartyChannel),
+		nil,
+		types.Version,
+	))
+}
+
+func TestSaveVoucherDenom(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+
+	require.Error(t, k.SaveVoucherDenom(ctx, "dex", "channel-0", "stake"))
+
+	setCounterparty(k, ctx, "dex", "channel-0", "dex", "channel-7")
+	require.NoError(t, k.SaveVoucherDenom(ctx, "dex", "channel-0", "stake"))
+
+	voucher := keeper.VoucherDenom("dex", "channel-7", "stake")
+	origin, found := k.OriginalDenom(ctx, "dex", "channel-7", voucher)
+	require.True(t, found)
+	require.Equal(t, "stake", origin)
+
+	_, found = k.OriginalDenom(ctx, "dex", "channel-0", voucher)
+	require.False(t, found)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	setCounterparty(k, ctx, "dex", "channel-0", "dex", "channel-7")
+
+	voucher := keeper.VoucherDenom("dex", "channel-0", "stake")
+	k.SetDenomTrace(ctx, types.DenomTrace{
+		Index:   voucher[:16],
+		Port:    "dex",
+		Channel: "channel-0",
+		Origin:  "stake",
+	})
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate2to3(ctx))
+
+	traces := k.GetAllDenomTrace(ctx)
+	require.Len(t, traces, 1)
+	require.Equal(t, types.DenomTrace{
+		Index:     keeper.VoucherDenom("dex", "channel-7", "stake"),
+		Port:      "dex",
+		Channel:   "channel-7",
+		Origin:    "stake",
+		Path:      "dex/channel-7",
+		BaseDenom: "stake",
+	}, traces[0])
+}
+
+func TestOriginalDenomMultiHop(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	setCounterparty(k, ctx, "dex", "channel-0", "dex", "channel-7")
+
+	// a token received through ICS-20 and then sent over the dex channel
+	ics20Voucher := keeper.VoucherDenom("transfer", "channel-1", "uatom")
+	require.NoError(t, k.SaveVoucherDenom(ctx, "dex", "channel-0", "transfer/channel-1/uatom"))
+
+	voucher := keeper.VoucherDenom("dex", "channel-7", "transfer/channel-1/uatom")
+	origin, found := k.OriginalDenom(ctx, "dex", "channel-7", voucher)
+	require.True(t, found)
+	require.Equal(t, ics20Voucher, origin)
+
+	trace, found := k.GetDenomTrace(ctx, voucher)
+	require.True(t, found)
+	require.Equal(t, "dex/channel-7/transfer/channel-1", trace.Path)
+	require.Equal(t, "uatom", trace.BaseDenom)
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange/x/dex/types"
+)
+
+func (k Keeper) LiquidityPool(c context.Context, req *types.QueryGetLiquidityPoolRequest) (*types.QueryGetLiquidityPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetLiquidityPool(ctx, req.PairIndex)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetLiquidityPoolResponse{
+		LiquidityPool: val,
+		ShareDenom:    types.PoolShareDenom(val.PairIndex),
+	}, nil
+}
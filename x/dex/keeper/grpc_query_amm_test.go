@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/nullify"
+	"interchange/x/dex/types"
+)
+
+func TestLiquidityPoolQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	pool := types.NewLiquidityPool("pair", "stake", "token")
+	pool.AmountReserve = sdk.NewInt(1000)
+	pool.PriceReserve = sdk.NewInt(10000)
+	pool.TotalShares = sdk.NewInt(3162)
+	keeper.SetLiquidityPool(ctx, pool)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetLiquidityPoolRequest
+		response *types.QueryGetLiquidityPoolResponse
+		err      error
+	}{
+		{
+			desc:     "Found",
+			request:  &types.QueryGetLiquidityPoolRequest{PairIndex: "pair"},
+			response: &types.QueryGetLiquidityPoolResponse{LiquidityPool: pool, ShareDenom: types.PoolShareDenom("pair")},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetLiquidityPoolRequest{PairIndex: "unknown"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.LiquidityPool(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange/x/dex/types"
+)
+
+func (k Keeper) Candles(c context.Context, req *types.QueryCandlesRequest) (*types.QueryCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Interval <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "interval must be positive")
+	}
+
+	var candles []types.Candle
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	candleStore := prefix.NewStore(store, types.KeyPrefix(types.CandleKeyPrefix))
+	intervalStore := prefix.NewStore(candleStore, types.CandleIntervalKey(req.PairIndex, req.Interval))
+
+	pageRes, err := query.Paginate(intervalStore, req.Pagination, func(key []byte, value []byte) error {
+		var candle types.Candle
+		if err := k.cdc.Unmarshal(value, &candle); err != nil {
+			return err
+		}
+
+		candles = append(candles, candle)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCandlesResponse{Candles: candles, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Ticker(c context.Context, req *types.QueryTickerRequest) (*types.QueryTickerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetTicker(ctx, req.PairIndex)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryTickerResponse{Ticker: val}, nil
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "interchange/testutil/keeper"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+func createNCandle(keeper *keeper.Keeper, ctx sdk.Context, pairIndex string, interval time.Duration, n int) []types.Candle {
+	items := make([]types.Candle, n)
+	for i := range items {
+		items[i] = types.NewCandle(pairIndex, interval, candleBaseTime.Add(time.Duration(i)*interval), int32(i+1))
+		keeper.SetCandle(ctx, items[i])
+	}
+	return items
+}
+
+func TestCandlesQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNCandle(keeper, ctx, "pair", time.Minute, 5)
+	createNCandle(keeper, ctx, "pair", time.Hour, 2)
+	createNCandle(keeper, ctx, "other", time.Minute, 2)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryCandlesRequest {
+		return &types.QueryCandlesRequest{
+			PairIndex: "pair",
+			Interval:  time.Minute,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.Candles(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Candles), step)
+			require.Subset(t, msgs, resp.Candles)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.Candles(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Candles), step)
+			require.Subset(t, msgs, resp.Candles)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.Candles(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		// The candles are ordered by open time
+		require.Equal(t, msgs, resp.Candles)
+	})
+	t.Run("InvalidInterval", func(t *testing.T) {
+		_, err := keeper.Candles(wctx, &types.QueryCandlesRequest{PairIndex: "pair"})
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "interval must be positive"))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.Candles(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestTickerQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockTime(candleBaseTime.Add(2 * time.Hour))
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetLastPrice(ctx, types.LastPrice{Index: "pair", Price: 12})
+	bucket := types.NewCandle("pair", types.TickerBucketInterval, candleBaseTime.Add(time.Hour), 8)
+	bucket.AddTrade(5, 8)
+	bucket.AddTrade(5, 12)
+	keeper.SetTickerBucket(ctx, bucket)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryTickerRequest
+		response *types.QueryTickerResponse
+		err      error
+	}{
+		{
+			desc:    "Found",
+			request: &types.QueryTickerRequest{PairIndex: "pair"},
+			response: &types.QueryTickerResponse{Ticker: types.Ticker{
+				PairIndex:  "pair",
+				LastPrice:  12,
+				Open:       8,
+				High:       12,
+				Low:        8,
+				Volume:     10,
+				Trades:     2,
+				Change:     4,
+				ChangeRate: sdk.NewDecWithPrec(5, 1),
+			}},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryTickerRequest{PairIndex: "other"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.Ticker(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange/x/dex/types"
+)
+
+func (k Keeper) CircuitBreaker(c context.Context, req *types.QueryCircuitBreakerRequest) (*types.QueryCircuitBreakerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryCircuitBreakerResponse{
+		TrippedMsgTypes:    k.GetAllTrippedMsgTypes(ctx),
+		TrippedPacketTypes: k.GetAllTrippedPacketTypes(ctx),
+	}, nil
+}
@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	keepertest "interchange/testutil/keeper"
+	"interchange/x/dex/types"
+)
+
+func TestCircuitBreakerQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgType := sdk.MsgTypeURL(&types.MsgSendBuyOrder{})
+	keeper.SetMsgTripped(ctx, msgType, true)
+	keeper.SetPacketTripped(ctx, types.EventTypeCreatePairPacket, true)
+
+	response, err := keeper.CircuitBreaker(wctx, &types.QueryCircuitBreakerRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryCircuitBreakerResponse{
+		TrippedMsgTypes:    []string{msgType},
+		TrippedPacketTypes: []string{types.EventTypeCreatePairPacket},
+	}, response)
+
+	_, err = keeper.CircuitBreaker(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"interchange/x/dex/types"
@@ -55,3 +58,28 @@ func (k Keeper) DenomTrace(c context.Context, req *types.QueryGetDenomTraceReque
 
 	return &types.QueryGetDenomTraceResponse{DenomTrace: val}, nil
 }
+
+func (k Keeper) DenomTraceByHash(c context.Context, req *types.QueryDenomTraceByHashRequest) (*types.QueryDenomTraceByHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(req.Hash, ibctransfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid denom trace hash %s: %s", req.Hash, err))
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Vouchers sent by this chain are traced by the dex, the ones received by the transfer module
+	val, found := k.GetDenomTrace(ctx, ibctransfertypes.DenomPrefix+"/"+hash.String())
+	if !found && k.transferKeeper != nil {
+		if transferTrace, ok := k.transferKeeper.GetDenomTrace(ctx, hash); ok {
+			val, found = types.DenomTraceFromTransfer(transferTrace), true
+		}
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryDenomTraceByHashResponse{DenomTrace: val, FullDenomPath: val.GetFullDenomPath()}, nil
+}
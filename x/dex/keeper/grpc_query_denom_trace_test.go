@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -124,3 +125,51 @@ func TestDenomTraceQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestDenomTraceByHashQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	trace := types.NewDenomTrace("dex", "channel-0", "transfer/channel-1/uatom", "")
+	keeper.SetDenomTrace(ctx, trace)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryDenomTraceByHashRequest
+		response *types.QueryDenomTraceByHashResponse
+		err      error
+	}{
+		{
+			desc:     "Voucher",
+			request:  &types.QueryDenomTraceByHashRequest{Hash: trace.Index},
+			response: &types.QueryDenomTraceByHashResponse{DenomTrace: trace, FullDenomPath: "dex/channel-0/transfer/channel-1/uatom"},
+		},
+		{
+			desc:     "Hash",
+			request:  &types.QueryDenomTraceByHashRequest{Hash: strings.TrimPrefix(trace.Index, "ibc/")},
+			response: &types.QueryDenomTraceByHashResponse{DenomTrace: trace, FullDenomPath: "dex/channel-0/transfer/channel-1/uatom"},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryDenomTraceByHashRequest{Hash: strings.Repeat("A", 64)},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc:    "InvalidHash",
+			request: &types.QueryDenomTraceByHashRequest{Hash: "stake"},
+			err:     status.Error(codes.InvalidArgument, "invalid denom trace hash"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.DenomTraceByHash(wctx, tc.request)
+			if tc.err != nil {
+				require.Equal(t, status.Code(tc.err), status.Code(err))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange/x/dex/types"
+)
+
+func (k Keeper) IncentivePool(c context.Context, req *types.QueryGetIncentivePoolRequest) (*types.QueryGetIncentivePoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetIncentivePool(ctx, req.PairIndex)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetIncentivePoolResponse{IncentivePool: val}, nil
+}
+
+func (k Keeper) MakerReward(c context.Context, req *types.QueryGetMakerRewardRequest) (*types.QueryGetMakerRewardResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rewards, found := k.GetClaimableRewards(ctx, req.Address)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetMakerRewardResponse{MakerReward: types.MakerReward{Address: req.Address, Rewards: rewards}}, nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/nullify"
+	"interchange/testutil/sample"
+	"interchange/x/dex/types"
+)
+
+func TestIncentivePoolQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	pool := types.IncentivePool{PairIndex: "pair", Balance: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}
+	keeper.SetIncentivePool(ctx, pool)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetIncentivePoolRequest
+		response *types.QueryGetIncentivePoolResponse
+		err      error
+	}{
+		{
+			desc:     "Found",
+			request:  &types.QueryGetIncentivePoolRequest{PairIndex: "pair"},
+			response: &types.QueryGetIncentivePoolResponse{IncentivePool: pool},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetIncentivePoolRequest{PairIndex: "unknown"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.IncentivePool(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestMakerRewardQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	reward := types.MakerReward{Address: sample.AccAddress(), Rewards: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}
+	keeper.SetMakerReward(ctx, reward)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetMakerRewardRequest
+		response *types.QueryGetMakerRewardResponse
+		err      error
+	}{
+		{
+			desc:     "Found",
+			request:  &types.QueryGetMakerRewardRequest{Address: reward.Address},
+			response: &types.QueryGetMakerRewardResponse{MakerReward: reward},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetMakerRewardRequest{Address: sample.AccAddress()},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.MakerReward(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
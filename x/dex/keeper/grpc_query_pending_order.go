@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange/x/dex/types"
+)
+
+func (k Keeper) PendingOrders(c context.Context, req *types.QueryPendingOrdersRequest) (*types.QueryPendingOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner address")
+	}
+
+	var pendingOrders []types.PendingOrder
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	pendingOrderStore := prefix.NewStore(store, types.KeyPrefix(types.PendingOrderKeyPrefix))
+	ownerStore := prefix.NewStore(store, append(types.KeyPrefix(types.PendingOrderOwnerKeyPrefix), types.PendingOrderOwnerKey(req.Owner)...))
+
+	pageRes, err := query.Paginate(ownerStore, req.Pagination, func(key []byte, value []byte) error {
+		var pendingOrder types.PendingOrder
+		if err := k.cdc.Unmarshal(pendingOrderStore.Get(value), &pendingOrder); err != nil {
+			return err
+		}
+
+		pendingOrders = append(pendingOrders, pendingOrder)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingOrdersResponse{PendingOrders: pendingOrders, Pagination: pageRes}, nil
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/nullify"
+	"interchange/testutil/sample"
+	"interchange/x/dex/types"
+)
+
+func TestPendingOrdersQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	owner := sample.AccAddress()
+	msgs := createNPendingOrder(keeper, ctx, owner, 5)
+	keeper.SetPendingOrder(ctx, types.PendingOrder{
+		Port:     "dex",
+		Channel:  "channel-1",
+		Sequence: 0,
+		Owner:    sample.AccAddress(),
+	})
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryPendingOrdersRequest {
+		return &types.QueryPendingOrdersRequest{
+			Owner: owner,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PendingOrders(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.PendingOrders), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.PendingOrders),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PendingOrders(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.PendingOrders), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.PendingOrders),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.PendingOrders(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.PendingOrders),
+		)
+	})
+	t.Run("InvalidOwner", func(t *testing.T) {
+		_, err := keeper.PendingOrders(wctx, &types.QueryPendingOrdersRequest{Owner: "invalid"})
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid owner address"))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.PendingOrders(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange/x/dex/types"
+)
+
+func (k Keeper) RateLimitQuota(c context.Context, req *types.QueryRateLimitQuotaRequest) (*types.QueryRateLimitQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimit, found := k.GetRateLimit(ctx, req.Port, req.Channel, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	quota := k.CurrentRateLimitQuota(ctx, rateLimit)
+
+	return &types.QueryRateLimitQuotaResponse{
+		RateLimit: rateLimit,
+		Outflow:   quota.Outflow,
+		Remaining: rateLimit.MaxOutflow - quota.Outflow,
+		WindowEnd: quota.WindowStart.Add(rateLimit.Window),
+	}, nil
+}
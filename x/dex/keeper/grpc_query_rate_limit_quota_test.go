@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "interchange/testutil/keeper"
+	"interchange/x/dex/types"
+)
+
+func TestRateLimitQuotaQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	start := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockTime(start.Add(time.Minute))
+	wctx := sdk.WrapSDKContext(ctx)
+	rateLimit := types.RateLimit{Port: "dex", Channel: "channel-0", Denom: "stake", MaxOutflow: 100, Window: time.Hour}
+	params := types.DefaultParams()
+	params.RateLimits = []types.RateLimit{
+		rateLimit,
+		{Port: "dex", Channel: "channel-1", Denom: "stake", MaxOutflow: 50, Window: time.Hour},
+	}
+	keeper.SetParams(ctx, params)
+	keeper.SetRateLimitQuota(ctx, types.RateLimitQuota{
+		Port:        "dex",
+		Channel:     "channel-0",
+		Denom:       "stake",
+		Outflow:     60,
+		WindowStart: start,
+	})
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryRateLimitQuotaRequest
+		response *types.QueryRateLimitQuotaResponse
+		err      error
+	}{
+		{
+			desc:    "Used",
+			request: &types.QueryRateLimitQuotaRequest{Port: "dex", Channel: "channel-0", Denom: "stake"},
+			response: &types.QueryRateLimitQuotaResponse{
+				RateLimit: rateLimit,
+				Outflow:   60,
+				Remaining: 40,
+				WindowEnd: start.Add(time.Hour),
+			},
+		},
+		{
+			desc:    "Unused",
+			request: &types.QueryRateLimitQuotaRequest{Port: "dex", Channel: "channel-1", Denom: "stake"},
+			response: &types.QueryRateLimitQuotaResponse{
+				RateLimit: types.RateLimit{Port: "dex", Channel: "channel-1", Denom: "stake", MaxOutflow: 50, Window: time.Hour},
+				Remaining: 50,
+				WindowEnd: ctx.BlockTime().Add(time.Hour),
+			},
+		},
+		{
+			desc:    "NoRateLimit",
+			request: &types.QueryRateLimitQuotaRequest{Port: "dex", Channel: "channel-0", Denom: "token"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.RateLimitQuota(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
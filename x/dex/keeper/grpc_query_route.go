@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange/x/dex/types"
+)
+
+// routeCandidate is a leg whose order is matched on this chain,
+// with the denoms of the tokens it sends and receives on the chain sending it
+type routeCandidate struct {
+	leg      types.RouteLeg
+	sent     string
+	received string
+}
+
+func (k Keeper) Route(c context.Context, req *types.QueryRouteRequest) (*types.QueryRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	for _, denom := range []string{req.FromDenom, req.ToDenom} {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.FromDenom == req.ToDenom {
+		return nil, status.Error(codes.InvalidArgument, "the denoms must be different")
+	}
+	if req.Amount <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "the amount must be positive")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	//直接のルートと、中間のdenomを経由する2区間のルートを見積もる
+	candidates := k.routeCandidates(ctx)
+	routes := []types.RouteQuote{}
+	for _, first := range candidates {
+		if first.sent != req.FromDenom {
+			continue
+		}
+		firstLeg, firstOut := k.quoteRouteLeg(ctx, first.leg, req.Amount)
+		if firstOut <= 0 {
+			continue
+		}
+		if first.received == req.ToDenom {
+			routes = append(routes, types.RouteQuote{Legs: []types.RouteLeg{firstLeg}, AmountOut: firstOut})
+			continue
+		}
+		for _, second := range candidates {
+			if second.sent != first.received || second.received != req.ToDenom {
+				continue
+			}
+			secondLeg, secondOut := k.quoteRouteLeg(ctx, second.leg, firstOut)
+			if secondOut <= 0 {
+				continue
+			}
+			routes = append(routes, types.RouteQuote{Legs: []types.RouteLeg{firstLeg, secondLeg}, AmountOut: secondOut})
+		}
+	}
+
+	//受け取るトークンが多い順、同じ場合は区間が少ない順
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].AmountOut != routes[j].AmountOut {
+			return routes[i].AmountOut > routes[j].AmountOut
+		}
+		return len(routes[i].Legs) < len(routes[j].Legs)
+	})
+
+	return &types.QueryRouteResponse{Routes: routes}, nil
+}
+
+// routeCandidates returns the legs matched by the order books of this chain that are not paused
+func (k Keeper) routeCandidates(ctx sdk.Context) (candidates []routeCandidate) {
+	//買いオーダーブックは、送信元チェーンからの売り注文を約定する
+	for _, book := range k.GetAllBuyOrderBook(ctx) {
+		port, channel, found := types.OrderBookChannel(book.Index, book.AmountDenom, book.PriceDenom)
+		if !found || k.IsPairPaused(ctx, book.Index) {
+			continue
+		}
+		priceDenom, err := k.FullDenomPath(ctx, book.PriceDenom)
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, routeCandidate{
+			leg: types.RouteLeg{
+				Port:        port,
+				Channel:     channel,
+				OrderType:   types.OrderTypeSell,
+				AmountDenom: book.AmountDenom,
+				PriceDenom:  book.PriceDenom,
+			},
+			sent:     LocalDenom(book.AmountDenom),
+			received: LocalDenom(types.CounterpartyDenom(port, channel, priceDenom)),
+		})
+	}
+	//売りオーダーブックは、送信元チェーンからの買い注文を約定する
+	for _, book := range k.GetAllSellOrderBook(ctx) {
+		port, channel, found := types.OrderBookChannel(book.Index, book.AmountDenom, book.PriceDenom)
+		if !found || k.IsPairPaused(ctx, book.Index) {
+			continue
+		}
+		candidates = append(candidates, routeCandidate{
+			leg: types.RouteLeg{
+				Port:        port,
+				Channel:     channel,
+				OrderType:   types.OrderTypeBuy,
+				AmountDenom: book.AmountDenom,
+				PriceDenom:  book.PriceDenom,
+			},
+			sent:     LocalDenom(book.PriceDenom),
+			received: LocalDenom(types.CounterpartyDenom(port, channel, book.AmountDenom)),
+		})
+	}
+	return candidates
+}
+
+// quoteRouteLeg returns the leg with its price and the tokens received from it when it is sent with the tokens.
+// A sell order sells all the tokens, its price is the lowest price it is filled at.
+// A buy order is sent at the price that buys the largest amount with the tokens.
+func (k Keeper) quoteRouteLeg(ctx sdk.Context, leg types.RouteLeg, tokens int64) (types.RouteLeg, int64) {
+	if leg.OrderType == types.OrderTypeSell {
+		leg.Price = types.MinPrice
+		amount, err := leg.OrderAmount(tokens)
+		if err != nil {
+			return leg, 0
+		}
+		_, gain, lowest := k.simulateRouteLeg(ctx, leg, amount)
+		leg.Price = lowest
+		return leg, gain
+	}
+
+	//価格が高いほど板と流動性プールから買える数量は増えるが、トークンで買える数量は減る
+	//トークンで買える数量がすべて約定する最低の価格を二分探索する
+	filled := func(price int32) (int64, bool) {
+		leg.Price = price
+		amount, err := leg.OrderAmount(tokens)
+		if err != nil {
+			return 0, false
+		}
+		_, purchase, _ := k.simulateRouteLeg(ctx, leg, amount)
+		return purchase, purchase == int64(amount)
+	}
+	low, high := types.MinPrice, types.MaxPrice
+	if tokens < int64(high) {
+		high = int32(tokens)
+	}
+	for low < high {
+		middle := low + (high-low)/2
+		if _, ok := filled(middle); ok {
+			high = middle
+		} else {
+			low = middle + 1
+		}
+	}
+	//直前の価格では一部だけ約定するが、より多く買える場合がある
+	price := low
+	purchase, _ := filled(low)
+	if low > types.MinPrice {
+		if partial, _ := filled(low - 1); partial > purchase {
+			price, purchase = low-1, partial
+		}
+	}
+	leg.Price = price
+	return leg, purchase
+}
+
+// simulateRouteLeg fills the order of a leg like a received order, without saving the order book and the liquidity pool.
+// It returns the filled amount, the tokens received and the lowest price of the fills of a sell order.
+func (k Keeper) simulateRouteLeg(ctx sdk.Context, leg types.RouteLeg, amount int32) (filled int32, received int64, lowest int32) {
+	pairIndex := leg.PairIndex()
+	order := types.Order{Amount: amount, Price: leg.Price}
+	var poolRef *types.LiquidityPool
+	if pool, found := k.GetLiquidityPool(ctx, pairIndex); found {
+		poolRef = &pool
+	}
+
+	if leg.OrderType == types.OrderTypeBuy {
+		book, found := k.GetSellOrderBook(ctx, pairIndex)
+		if !found {
+			return 0, 0, 0
+		}
+		remaining, _, purchase, _, _ := book.RouteBuyOrder(order, types.SelfTradeAllowed, poolRef, k.PoolSwapFee(ctx))
+		return amount - remaining.Amount, int64(purchase), 0
+	}
+
+	book, found := k.GetBuyOrderBook(ctx, pairIndex)
+	if !found {
+		return 0, 0, 0
+	}
+	remaining, liquidated, gain, _, swap := book.RouteSellOrder(order, types.SelfTradeAllowed, poolRef, k.PoolSwapFee(ctx))
+	lowest = types.MaxPrice
+	for _, fill := range liquidated {
+		if fill.Price < lowest {
+			lowest = fill.Price
+		}
+	}
+	//流動性プールは取引後の限界価格まで買う
+	if swap.Amount > 0 {
+		if marginal := int32(poolRef.PriceReserve.Quo(poolRef.AmountReserve).Int64()); marginal < lowest {
+			lowest = marginal
+		}
+	}
+	if lowest < types.MinPrice {
+		lowest = types.MinPrice
+	}
+	return amount - remaining.Amount, gain, lowest
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "interchange/testutil/keeper"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+func TestRouteQuery(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// The sending chain sells marscoin for a voucher of venuscoin on each channel,
+	// then buys its marsgold back with the voucher
+	type route struct {
+		channel   string
+		bidPrice  int32
+		askPrice  int32
+		voucher   string
+		goldIndex string
+	}
+	routes := []route{
+		{channel: "channel-0", bidPrice: 5, askPrice: 2},
+		{channel: "channel-1", bidPrice: 6, askPrice: 4},
+	}
+	for i, r := range routes {
+		buyBook := types.NewBuyOrderBook("marscoin", "venuscoin")
+		buyBook.Index = types.OrderBookIndex("dex", r.channel, "marscoin", "venuscoin")
+		_, err := buyBook.AppendOrder("alice", 10, r.bidPrice, 0)
+		require.NoError(t, err)
+		k.SetBuyOrderBook(ctx, buyBook)
+
+		voucher := keeper.LocalDenom(types.CounterpartyDenom("dex", r.channel, "venuscoin"))
+		gold := types.CounterpartyDenom("dex", r.channel, "marsgold")
+		sellBook := types.NewSellOrderBook(gold, voucher)
+		sellBook.Index = types.OrderBookIndex("dex", r.channel, gold, voucher)
+		_, err = sellBook.AppendOrder("bob", 20, r.askPrice, 0)
+		require.NoError(t, err)
+		k.SetSellOrderBook(ctx, sellBook)
+
+		routes[i].voucher = voucher
+		routes[i].goldIndex = sellBook.Index
+	}
+
+	sellLeg := func(channel string, price int32) types.RouteLeg {
+		return types.RouteLeg{Port: "dex", Channel: channel, OrderType: types.OrderTypeSell, AmountDenom: "marscoin", PriceDenom: "venuscoin", Price: price}
+	}
+	buyLeg := func(r route, price int32) types.RouteLeg {
+		return types.RouteLeg{
+			Port:        "dex",
+			Channel:     r.channel,
+			OrderType:   types.OrderTypeBuy,
+			AmountDenom: types.CounterpartyDenom("dex", r.channel, "marsgold"),
+			PriceDenom:  r.voucher,
+			Price:       price,
+		}
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryRouteRequest
+		response *types.QueryRouteResponse
+		err      error
+	}{
+		{
+			desc:    "Direct",
+			request: &types.QueryRouteRequest{FromDenom: "marscoin", ToDenom: routes[0].voucher, Amount: 10},
+			response: &types.QueryRouteResponse{Routes: []types.RouteQuote{
+				{Legs: []types.RouteLeg{sellLeg("channel-0", 5)}, AmountOut: 50},
+			}},
+		},
+		{
+			// 50 vouchers buy the 20 marsgold at 2 on channel-0, 60 vouchers buy 15 at 4 on channel-1
+			desc:    "TwoLegsBestFirst",
+			request: &types.QueryRouteRequest{FromDenom: "marscoin", ToDenom: "marsgold", Amount: 10},
+			response: &types.QueryRouteResponse{Routes: []types.RouteQuote{
+				{Legs: []types.RouteLeg{sellLeg("channel-0", 5), buyLeg(routes[0], 2)}, AmountOut: 20},
+				{Legs: []types.RouteLeg{sellLeg("channel-1", 6), buyLeg(routes[1], 4)}, AmountOut: 15},
+			}},
+		},
+		{
+			desc:     "NoRoute",
+			request:  &types.QueryRouteRequest{FromDenom: "marsgold", ToDenom: "marscoin", Amount: 10},
+			response: &types.QueryRouteResponse{Routes: []types.RouteQuote{}},
+		},
+		{
+			desc:    "SameDenoms",
+			request: &types.QueryRouteRequest{FromDenom: "marscoin", ToDenom: "marscoin", Amount: 10},
+			err:     status.Error(codes.InvalidArgument, "the denoms must be different"),
+		},
+		{
+			desc:    "InvalidAmount",
+			request: &types.QueryRouteRequest{FromDenom: "marscoin", ToDenom: "marsgold", Amount: 0},
+			err:     status.Error(codes.InvalidArgument, "the amount must be positive"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := k.Route(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+
+	// The books of a paused pair are not routed through
+	k.SetPairPaused(ctx, routes[0].goldIndex, true)
+	response, err := k.Route(wctx, &types.QueryRouteRequest{FromDenom: "marscoin", ToDenom: "marsgold", Amount: 10})
+	require.NoError(t, err)
+	require.Len(t, response.Routes, 1)
+	require.Equal(t, "channel-1", response.Routes[0].Legs[0].Channel)
+}
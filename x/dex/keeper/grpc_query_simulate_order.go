@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange/x/dex/types"
+)
+
+func (k Keeper) SimulateOrder(c context.Context, req *types.QuerySimulateOrderRequest) (*types.QuerySimulateOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateAmountAndPrice(req.Amount, req.Price); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, ok := types.SelfTradePrevention_name[int32(req.SelfTradePrevention)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid self-trade prevention")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if k.IsPairPaused(ctx, req.PairIndex) {
+		return nil, status.Error(codes.FailedPrecondition, "the pair is paused")
+	}
+
+	//注文を受信した場合と同じように約定させる
+	//ストアから読み込んだオーダーブックは保存しないため、板は変更されない
+	order := types.Order{
+		Creator: req.Creator,
+		Amount:  req.Amount,
+		Price:   req.Price,
+	}
+	//流動性プールもストアに保存しないため変更されない
+	var poolRef *types.LiquidityPool
+	if pool, found := k.GetLiquidityPool(ctx, req.PairIndex); found {
+		poolRef = &pool
+	}
+	var (
+		remaining types.Order
+		fills     []types.Order
+		selfTrade types.SelfTrade
+		swap      types.PoolSwap
+	)
+	switch req.OrderType {
+	case types.OrderTypeSell:
+		book, found := k.GetBuyOrderBook(ctx, req.PairIndex)
+		if !found {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+		remaining, fills, _, selfTrade, swap = book.RouteSellOrder(order, req.SelfTradePrevention, poolRef, k.PoolSwapFee(ctx))
+	case types.OrderTypeBuy:
+		book, found := k.GetSellOrderBook(ctx, req.PairIndex)
+		if !found {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+		remaining, fills, _, selfTrade, swap = book.RouteBuyOrder(order, req.SelfTradePrevention, poolRef, k.PoolSwapFee(ctx))
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order type %s, must be %s or %s", req.OrderType, types.OrderTypeSell, types.OrderTypeBuy)
+	}
+
+	res := &types.QuerySimulateOrderResponse{
+		Fills:           fills,
+		AveragePrice:    sdk.ZeroDec(),
+		RemainingAmount: remaining.Amount,
+		PreventedAmount: selfTrade.Prevented,
+	}
+	//約定は板に残っている注文の価格で行われる
+	for _, fill := range fills {
+		res.FilledAmount += fill.Amount
+		res.Total += int64(fill.Amount) * int64(fill.Price)
+	}
+	//流動性プールとの取引は平均価格の約定として返す
+	if swap.Amount > 0 {
+		res.Fills = append(res.Fills, swap.Trade())
+		res.FilledAmount += swap.Amount
+		res.Total += int64(swap.Total)
+		res.PoolFee = &sdk.Coin{Denom: poolRef.PriceDenom, Amount: sdk.NewInt(swap.Fee)}
+	}
+	if res.FilledAmount > 0 {
+		res.AveragePrice = sdk.NewDec(res.Total).QuoInt64(int64(res.FilledAmount))
+	}
+
+	//板に残る注文にはデポジットが必要
+	if deposit := k.OrderDepositPolicy(ctx).Deposit; deposit != nil && res.RemainingAmount > 0 {
+		res.Deposit = deposit
+	}
+
+	return res, nil
+}
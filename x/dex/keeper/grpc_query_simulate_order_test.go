@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "interchange/testutil/keeper"
+	"interchange/x/dex/types"
+)
+
+func TestSimulateOrderQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "marscoin", "venuscoin")
+
+	buyBook := types.NewBuyOrderBook("marscoin", "venuscoin")
+	buyBook.Index = pairIndex
+	for _, order := range []types.Order{
+		{Creator: "alice", Amount: 10, Price: 5},
+		{Creator: "bob", Amount: 20, Price: 6},
+	} {
+		_, err := buyBook.AppendOrder(order.Creator, order.Amount, order.Price, 0)
+		require.NoError(t, err)
+	}
+	keeper.SetBuyOrderBook(ctx, buyBook)
+
+	sellBook := types.NewSellOrderBook("marscoin", "venuscoin")
+	sellBook.Index = pairIndex
+	_, err := sellBook.AppendOrder("carol", 15, 8, 0)
+	require.NoError(t, err)
+	keeper.SetSellOrderBook(ctx, sellBook)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QuerySimulateOrderRequest
+		response *types.QuerySimulateOrderResponse
+		err      error
+	}{
+		{
+			desc:    "SellPartiallyFilled",
+			request: &types.QuerySimulateOrderRequest{OrderType: types.OrderTypeSell, PairIndex: pairIndex, Amount: 40, Price: 5},
+			response: &types.QuerySimulateOrderResponse{
+				Fills: []types.Order{
+					{Id: 1, Creator: "bob", Amount: 20, Price: 6},
+					{Id: 0, Creator: "alice", Amount: 10, Price: 5},
+				},
+				FilledAmount:    30,
+				Total:           170,
+				AveragePrice:    sdk.MustNewDecFromStr("5.666666666666666666"),
+				RemainingAmount: 10,
+			},
+		},
+		{
+			desc:    "SellLimitPrice",
+			request: &types.QuerySimulateOrderRequest{OrderType: types.OrderTypeSell, PairIndex: pairIndex, Amount: 40, Price: 7},
+			response: &types.QuerySimulateOrderResponse{
+				AveragePrice:    sdk.ZeroDec(),
+				RemainingAmount: 40,
+			},
+		},
+		{
+			desc:    "BuyFilled",
+			request: &types.QuerySimulateOrderRequest{OrderType: types.OrderTypeBuy, PairIndex: pairIndex, Amount: 5, Price: 9},
+			response: &types.QuerySimulateOrderResponse{
+				Fills:        []types.Order{{Id: 0, Creator: "carol", Amount: 5, Price: 8}},
+				FilledAmount: 5,
+				Total:        40,
+				AveragePrice: sdk.NewDec(8),
+			},
+		},
+		{
+			desc: "SelfTradePrevention",
+			request: &types.QuerySimulateOrderRequest{
+				OrderType:           types.OrderTypeBuy,
+				PairIndex:           pairIndex,
+				Amount:              5,
+				Price:               9,
+				Creator:             "carol",
+				SelfTradePrevention: types.CancelNewest,
+			},
+			response: &types.QuerySimulateOrderResponse{
+				AveragePrice:    sdk.ZeroDec(),
+				PreventedAmount: 5,
+			},
+		},
+		{
+			desc:    "PairNotFound",
+			request: &types.QuerySimulateOrderRequest{OrderType: types.OrderTypeBuy, PairIndex: "missing", Amount: 5, Price: 9},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc:    "InvalidOrderType",
+			request: &types.QuerySimulateOrderRequest{OrderType: "swap", PairIndex: pairIndex, Amount: 5, Price: 9},
+			err:     status.Error(codes.InvalidArgument, "invalid order type swap, must be sell or buy"),
+		},
+		{
+			desc:    "InvalidAmount",
+			request: &types.QuerySimulateOrderRequest{OrderType: types.OrderTypeBuy, PairIndex: pairIndex, Amount: 0, Price: 9},
+			err:     status.Error(codes.InvalidArgument, types.ErrZeroAmount.Error()),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.SimulateOrder(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+
+	// The simulation doesn't change the order books
+	book, found := keeper.GetBuyOrderBook(ctx, pairIndex)
+	require.True(t, found)
+	require.Equal(t, buyBook, book)
+}
+
+func TestSimulateOrderQueryDeposit(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	deposit := sdk.NewInt64Coin("stake", 10)
+	params := types.DefaultParams()
+	params.OrderDepositPolicy = types.OrderDepositPolicy{Deposit: &deposit, Lifetime: time.Hour, SlashFraction: sdk.ZeroDec()}
+	keeper.SetParams(ctx, params)
+
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "marscoin", "venuscoin")
+	book := types.NewBuyOrderBook("marscoin", "venuscoin")
+	book.Index = pairIndex
+	keeper.SetBuyOrderBook(ctx, book)
+
+	// An order resting in the order book needs a deposit
+	response, err := keeper.SimulateOrder(wctx, &types.QuerySimulateOrderRequest{OrderType: types.OrderTypeSell, PairIndex: pairIndex, Amount: 5, Price: 9})
+	require.NoError(t, err)
+	require.Equal(t, int32(5), response.RemainingAmount)
+	require.Equal(t, &deposit, response.Deposit)
+
+	// A paused pair cannot be simulated
+	keeper.SetPairPaused(ctx, pairIndex, true)
+	_, err = keeper.SimulateOrder(wctx, &types.QuerySimulateOrderRequest{OrderType: types.OrderTypeSell, PairIndex: pairIndex, Amount: 5, Price: 9})
+	require.ErrorIs(t, err, status.Error(codes.FailedPrecondition, "the pair is paused"))
+}
+
+func TestSimulateOrderQueryLargeTotal(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "marscoin", "venuscoin")
+
+	// The total of orders within the bounds exceeds an int32
+	book := types.NewSellOrderBook("marscoin", "venuscoin")
+	book.Index = pairIndex
+	_, err := book.AppendOrder("alice", types.MaxAmount, types.MaxPrice, 0)
+	require.NoError(t, err)
+	keeper.SetSellOrderBook(ctx, book)
+
+	response, err := keeper.SimulateOrder(wctx, &types.QuerySimulateOrderRequest{OrderType: types.OrderTypeBuy, PairIndex: pairIndex, Amount: types.MaxAmount, Price: types.MaxPrice})
+	require.NoError(t, err)
+	require.Equal(t, int64(types.MaxAmount)*int64(types.MaxPrice), response.Total)
+	require.Equal(t, sdk.NewDec(int64(types.MaxPrice)), response.AveragePrice)
+	require.Nil(t, response.PoolFee)
+}
+
+func TestSimulateOrderQueryPoolFee(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	fee := sdk.NewDecWithPrec(3, 3)
+	params := types.DefaultParams()
+	params.PoolSwapFee = fee
+	keeper.SetParams(ctx, params)
+
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "marscoin", "venuscoin")
+	book := types.NewSellOrderBook("marscoin", "venuscoin")
+	book.Index = pairIndex
+	keeper.SetSellOrderBook(ctx, book)
+	pool := types.NewLiquidityPool(pairIndex, "marscoin", "venuscoin")
+	pool.AmountReserve = sdk.NewInt(1000)
+	pool.PriceReserve = sdk.NewInt(10000)
+	pool.TotalShares = sdk.NewInt(1)
+	keeper.SetLiquidityPool(ctx, pool)
+
+	// The fee kept by the pool is reported in the price denom
+	response, err := keeper.SimulateOrder(wctx, &types.QuerySimulateOrderRequest{OrderType: types.OrderTypeBuy, PairIndex: pairIndex, Amount: 50, Price: 20})
+	require.NoError(t, err)
+	require.Equal(t, int32(50), response.FilledAmount)
+	require.Equal(t, &sdk.Coin{Denom: "venuscoin", Amount: pool.BuyFee(50, fee)}, response.PoolFee)
+	require.True(t, response.PoolFee.Amount.IsPositive())
+}
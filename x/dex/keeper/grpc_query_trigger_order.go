@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange/x/dex/types"
+)
+
+func (k Keeper) TriggerOrderAll(c context.Context, req *types.QueryAllTriggerOrderRequest) (*types.QueryAllTriggerOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var triggerOrders []types.TriggerOrder
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	triggerOrderStore := prefix.NewStore(store, types.KeyPrefix(types.TriggerOrderKeyPrefix))
+
+	pageRes, err := query.Paginate(triggerOrderStore, req.Pagination, func(key []byte, value []byte) error {
+		var triggerOrder types.TriggerOrder
+		if err := k.cdc.Unmarshal(value, &triggerOrder); err != nil {
+			return err
+		}
+
+		triggerOrders = append(triggerOrders, triggerOrder)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTriggerOrderResponse{TriggerOrder: triggerOrders, Pagination: pageRes}, nil
+}
+
+func (k Keeper) TriggerOrder(c context.Context, req *types.QueryGetTriggerOrderRequest) (*types.QueryGetTriggerOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetTriggerOrder(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetTriggerOrderResponse{TriggerOrder: val}, nil
+}
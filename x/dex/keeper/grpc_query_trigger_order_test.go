@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/nullify"
+	"interchange/x/dex/types"
+)
+
+func TestTriggerOrderQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNTriggerOrder(keeper, ctx, "pair", 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetTriggerOrderRequest
+		response *types.QueryGetTriggerOrderResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetTriggerOrderRequest{Id: msgs[0].Id},
+			response: &types.QueryGetTriggerOrderResponse{TriggerOrder: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetTriggerOrderRequest{Id: msgs[1].Id},
+			response: &types.QueryGetTriggerOrderResponse{TriggerOrder: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetTriggerOrderRequest{Id: uint64(len(msgs))},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.TriggerOrder(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestTriggerOrderQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNTriggerOrder(keeper, ctx, "pair", 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllTriggerOrderRequest {
+		return &types.QueryAllTriggerOrderRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.TriggerOrderAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.TriggerOrder), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.TriggerOrder),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.TriggerOrderAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.TriggerOrder), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.TriggerOrder),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.TriggerOrderAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.TriggerOrder),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.TriggerOrderAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange/x/dex/types"
+)
+
+func (k Keeper) TWAP(c context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	end := req.EndTime
+	if end.IsZero() {
+		end = ctx.BlockTime()
+	}
+
+	twap, err := k.GetTWAP(ctx, req.PairIndex, req.StartTime, end)
+	switch {
+	case sdkerrors.IsOf(err, types.ErrInvalidTWAPRange):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case sdkerrors.IsOf(err, types.ErrTWAPUnavailable):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTWAPResponse{Twap: twap}, nil
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "interchange/testutil/keeper"
+	"interchange/x/dex/types"
+)
+
+func TestTWAPQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockTime(candleBaseTime.Add(time.Minute))
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetTWAPRecord(ctx, types.TWAPRecord{PairIndex: "pair", Time: candleBaseTime, Price: 10, CumulativePrice: sdk.ZeroDec()})
+	keeper.SetTWAPRecord(ctx, types.TWAPRecord{PairIndex: "pair", Time: candleBaseTime.Add(30 * time.Second), Price: 20, CumulativePrice: sdk.NewDec(300)})
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryTWAPRequest
+		response *types.QueryTWAPResponse
+		err      codes.Code
+	}{
+		{
+			desc:     "UntilBlockTime",
+			request:  &types.QueryTWAPRequest{PairIndex: "pair", StartTime: candleBaseTime},
+			response: &types.QueryTWAPResponse{Twap: sdk.NewDec(15)},
+		},
+		{
+			desc:     "WithEndTime",
+			request:  &types.QueryTWAPRequest{PairIndex: "pair", StartTime: candleBaseTime, EndTime: candleBaseTime.Add(30 * time.Second)},
+			response: &types.QueryTWAPResponse{Twap: sdk.NewDec(10)},
+		},
+		{
+			desc:    "InvalidRange",
+			request: &types.QueryTWAPRequest{PairIndex: "pair", StartTime: candleBaseTime, EndTime: candleBaseTime.Add(time.Hour)},
+			err:     codes.InvalidArgument,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryTWAPRequest{PairIndex: "other", StartTime: candleBaseTime},
+			err:     codes.NotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  codes.InvalidArgument,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.TWAP(wctx, tc.request)
+			if tc.err != codes.OK {
+				require.Equal(t, tc.err, status.Code(err))
+			} else {
+				require.NoError(t, err)
+				require.True(t, tc.response.Twap.Equal(response.Twap), "expected %s, got %s", tc.response.Twap, response.Twap)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"interchange/x/dex/types"
+)
+
+// Implements DexHooks interface
+var _ types.DexHooks = Keeper{}
+
+// SetHooks sets the hooks called by the keeper, they can only be set once
+func (k *Keeper) SetHooks(dh types.DexHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set dex hooks twice")
+	}
+	k.hooks = dh
+	return k
+}
+
+// AfterPairCreated - call hook if registered
+func (k Keeper) AfterPairCreated(ctx sdk.Context, pairIndex string, amountDenom string, priceDenom string) {
+	if k.hooks != nil {
+		k.hooks.AfterPairCreated(ctx, pairIndex, amountDenom, priceDenom)
+	}
+}
+
+// AfterOrderPlaced - call hook if registered
+func (k Keeper) AfterOrderPlaced(ctx sdk.Context, orderType string, pairIndex string, order types.Order) {
+	if k.hooks != nil {
+		k.hooks.AfterOrderPlaced(ctx, orderType, pairIndex, order)
+	}
+}
+
+// AfterOrderFilled - call hook if registered
+func (k Keeper) AfterOrderFilled(ctx sdk.Context, orderType string, pairIndex string, order types.Order, taker string) {
+	if k.hooks != nil {
+		k.hooks.AfterOrderFilled(ctx, orderType, pairIndex, order, taker)
+	}
+}
+
+// AfterOrderCancelled - call hook if registered
+func (k Keeper) AfterOrderCancelled(ctx sdk.Context, orderType string, pairIndex string, order types.Order) {
+	if k.hooks != nil {
+		k.hooks.AfterOrderCancelled(ctx, orderType, pairIndex, order)
+	}
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/sample"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+// recordingHooks records the calls of the hooks in order
+type recordingHooks struct {
+	calls []string
+}
+
+var _ types.DexHooks = &recordingHooks{}
+
+func (h *recordingHooks) AfterPairCreated(_ sdk.Context, pairIndex string, amountDenom string, priceDenom string) {
+	h.calls = append(h.calls, fmt.Sprintf("created %s %s/%s", pairIndex, amountDenom, priceDenom))
+}
+
+func (h *recordingHooks) AfterOrderPlaced(_ sdk.Context, orderType string, pairIndex string, order types.Order) {
+	h.calls = append(h.calls, fmt.Sprintf("placed %s %s %d %s %d@%d", orderType, pairIndex, order.Id, order.Creator, order.Amount, order.Price))
+}
+
+func (h *recordingHooks) AfterOrderFilled(_ sdk.Context, orderType string, pairIndex string, order types.Order, taker string) {
+	h.calls = append(h.calls, fmt.Sprintf("filled %s %s %d %s %d@%d by %s", orderType, pairIndex, order.Id, order.Creator, order.Amount, order.Price, taker))
+}
+
+func (h *recordingHooks) AfterOrderCancelled(_ sdk.Context, orderType string, pairIndex string, order types.Order) {
+	h.calls = append(h.calls, fmt.Sprintf("cancelled %s %s %d %s %d@%d", orderType, pairIndex, order.Id, order.Creator, order.Amount, order.Price))
+}
+
+func TestSetHooks(t *testing.T) {
+	k, _ := keepertest.DexKeeper(t)
+	k.SetHooks(types.NewMultiDexHooks())
+	require.Panics(t, func() { k.SetHooks(types.NewMultiDexHooks()) })
+}
+
+func TestHooks(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	hooks := &recordingHooks{}
+	f.Keeper.SetHooks(types.NewMultiDexHooks(hooks))
+	alice, bob, carol, dave := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	packet := channeltypes.Packet{
+		SourcePort:         "dex",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "dex",
+		DestinationChannel: "channel-1",
+	}
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+
+	// The buy order book is created on the target chain
+	_, err := f.Keeper.OnRecvCreatePairPacket(f.Ctx, packet, types.CreatePairPacketData{
+		SourceDenom: "stake",
+		TargetDenom: "token",
+		Creator:     alice,
+	})
+	require.NoError(t, err)
+
+	book, found := f.Keeper.GetBuyOrderBook(f.Ctx, pairIndex)
+	require.True(t, found)
+	for _, order := range []types.Order{
+		{Creator: alice, Amount: 10, Price: 5},
+		{Creator: bob, Amount: 10, Price: 6},
+		{Creator: dave, Amount: 4, Price: 4},
+	} {
+		_, err := book.AppendOrder(order.Creator, order.Amount, order.Price, 0)
+		require.NoError(t, err)
+	}
+	f.Keeper.SetBuyOrderBook(f.Ctx, book)
+
+	// The resting orders filled or cancelled by the self-trade prevention are reported
+	_, err = f.Keeper.OnRecvSellOrderPacket(f.Ctx, packet, types.SellOrderPacketData{
+		AmountDenom: "stake",
+		Amount:      5,
+		PriceDenom:  "token",
+		Price:       5,
+		Seller:      carol,
+	})
+	require.NoError(t, err)
+	f.FundEscrow("dex", "channel-1", sdk.NewInt64Coin("token", 50))
+	_, err = f.Keeper.OnRecvSellOrderPacket(f.Ctx, packet, types.SellOrderPacketData{
+		AmountDenom:         "stake",
+		Amount:              20,
+		PriceDenom:          "token",
+		Price:               5,
+		Seller:              alice,
+		SelfTradePrevention: types.CancelOldest,
+	})
+	require.NoError(t, err)
+
+	// The cancelled orders are reported
+	f.FundEscrow("dex", "channel-0", sdk.NewInt64Coin("token", 16))
+	_, err = keeper.NewMsgServerImpl(*f.Keeper).CancelBuyOrder(sdk.WrapSDKContext(f.Ctx),
+		types.NewMsgCancelBuyOrder(dave, "dex", "channel-0", "stake", "token", 2),
+	)
+	require.NoError(t, err)
+
+	// The remaining amount of an acknowledged order rests in the sell order book of the source chain
+	sourceIndex := types.OrderBookIndex("dex", "channel-1", "token", "stake")
+	sellBook := types.NewSellOrderBook("token", "stake")
+	sellBook.Index = sourceIndex
+	f.Keeper.SetSellOrderBook(f.Ctx, sellBook)
+	ackPacket := channeltypes.Packet{SourcePort: "dex", SourceChannel: "channel-1", DestinationPort: "dex", DestinationChannel: "channel-0"}
+	ack := channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(&types.SellOrderPacketAck{RemainingAmount: 3}))
+	require.NoError(t, f.Keeper.OnAcknowledgementSellOrderPacket(f.Ctx, ackPacket, types.SellOrderPacketData{
+		AmountDenom: "token",
+		Amount:      3,
+		PriceDenom:  "stake",
+		Price:       7,
+		Seller:      carol,
+	}, ack))
+
+	require.Equal(t, []string{
+		fmt.Sprintf("created %s stake/token", pairIndex),
+		fmt.Sprintf("filled buy %s 1 %s 5@6 by %s", pairIndex, bob, carol),
+		fmt.Sprintf("filled buy %s 1 %s 5@6 by %s", pairIndex, bob, alice),
+		fmt.Sprintf("cancelled buy %s 0 %s 10@5", pairIndex, alice),
+		fmt.Sprintf("cancelled buy %s 2 %s 4@4", pairIndex, dave),
+		fmt.Sprintf("placed sell %s 0 %s 3@7", sourceIndex, carol),
+	}, hooks.calls)
+}
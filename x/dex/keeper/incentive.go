@@ -0,0 +1,452 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"interchange/x/dex/types"
+)
+
+// SetIncentivePool set a specific incentivePool in the store from its index
+func (k Keeper) SetIncentivePool(ctx sdk.Context, incentivePool types.IncentivePool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IncentivePoolKeyPrefix))
+	b := k.cdc.MustMarshal(&incentivePool)
+	store.Set(types.IncentivePoolKey(
+		incentivePool.PairIndex,
+	), b)
+}
+
+// GetIncentivePool returns an incentivePool from its index
+func (k Keeper) GetIncentivePool(
+	ctx sdk.Context,
+	pairIndex string,
+
+) (val types.IncentivePool, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IncentivePoolKeyPrefix))
+
+	b := store.Get(types.IncentivePoolKey(
+		pairIndex,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveIncentivePool removes an incentivePool from the store
+func (k Keeper) RemoveIncentivePool(
+	ctx sdk.Context,
+	pairIndex string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IncentivePoolKeyPrefix))
+	store.Delete(types.IncentivePoolKey(
+		pairIndex,
+	))
+}
+
+// GetAllIncentivePool returns all incentivePool
+func (k Keeper) GetAllIncentivePool(ctx sdk.Context) (list []types.IncentivePool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IncentivePoolKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.IncentivePool
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetMakerReward set a specific makerReward in the store from its index
+func (k Keeper) SetMakerReward(ctx sdk.Context, makerReward types.MakerReward) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MakerRewardKeyPrefix))
+	b := k.cdc.MustMarshal(&makerReward)
+	store.Set(types.MakerRewardKey(
+		makerReward.Address,
+	), b)
+}
+
+// GetMakerReward returns a makerReward from its index
+func (k Keeper) GetMakerReward(
+	ctx sdk.Context,
+	address string,
+
+) (val types.MakerReward, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MakerRewardKeyPrefix))
+
+	b := store.Get(types.MakerRewardKey(
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveMakerReward removes a makerReward from the store
+func (k Keeper) RemoveMakerReward(
+	ctx sdk.Context,
+	address string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MakerRewardKeyPrefix))
+	store.Delete(types.MakerRewardKey(
+		address,
+	))
+}
+
+// GetAllMakerReward returns all makerReward
+func (k Keeper) GetAllMakerReward(ctx sdk.Context) (list []types.MakerReward) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MakerRewardKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MakerReward
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetRewardStake set a specific rewardStake in the store from its index
+func (k Keeper) SetRewardStake(ctx sdk.Context, rewardStake types.RewardStake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RewardStakeKeyPrefix))
+	b := k.cdc.MustMarshal(&rewardStake)
+	store.Set(types.RewardStakeKey(
+		rewardStake.Address,
+		rewardStake.PairIndex,
+		rewardStake.OrderType,
+	), b)
+}
+
+// GetRewardStake returns a rewardStake from its index
+func (k Keeper) GetRewardStake(
+	ctx sdk.Context,
+	address string,
+	pairIndex string,
+	orderType string,
+
+) (val types.RewardStake, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RewardStakeKeyPrefix))
+
+	b := store.Get(types.RewardStakeKey(
+		address,
+		pairIndex,
+		orderType,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRewardStake removes a rewardStake from the store
+func (k Keeper) RemoveRewardStake(
+	ctx sdk.Context,
+	address string,
+	pairIndex string,
+	orderType string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RewardStakeKeyPrefix))
+	store.Delete(types.RewardStakeKey(
+		address,
+		pairIndex,
+		orderType,
+	))
+}
+
+// GetAllRewardStake returns all rewardStake
+func (k Keeper) GetAllRewardStake(ctx sdk.Context) (list []types.RewardStake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RewardStakeKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RewardStake
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetRewardStakesByAddress returns all rewardStake of an address
+func (k Keeper) GetRewardStakesByAddress(ctx sdk.Context, address string) (list []types.RewardStake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RewardStakeKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.RewardStakeAddressKey(address))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RewardStake
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// FundIncentivePool moves the coins of a sponsor to the incentive pool of a pair
+func (k Keeper) FundIncentivePool(ctx sdk.Context, sponsor sdk.AccAddress, pairIndex string, amount sdk.Coins) error {
+	if err := k.checkLocalPair(ctx, pairIndex); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, sponsor, types.IncentivePoolAddress(), amount); err != nil {
+		return err
+	}
+	k.addToIncentivePool(ctx, pairIndex, amount)
+	return nil
+}
+
+// FundIncentivePoolFromCommunityPool moves coins of the community pool to the incentive pool of a pair
+func (k Keeper) FundIncentivePoolFromCommunityPool(ctx sdk.Context, pairIndex string, amount sdk.Coins) error {
+	if err := k.checkLocalPair(ctx, pairIndex); err != nil {
+		return err
+	}
+	if err := k.distrKeeper.DistributeFromFeePool(ctx, amount, types.IncentivePoolAddress()); err != nil {
+		return err
+	}
+	k.addToIncentivePool(ctx, pairIndex, amount)
+	return nil
+}
+
+// checkLocalPair checks an order book of the pair rests on this chain
+func (k Keeper) checkLocalPair(ctx sdk.Context, pairIndex string) error {
+	_, sellFound := k.GetSellOrderBook(ctx, pairIndex)
+	_, buyFound := k.GetBuyOrderBook(ctx, pairIndex)
+	if !sellFound && !buyFound {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %s", pairIndex)
+	}
+	return nil
+}
+
+// addToIncentivePool credits the coins received by the incentive pool address to the pool of a pair,
+// the resting orders of the pair are staked when its pool is created
+func (k Keeper) addToIncentivePool(ctx sdk.Context, pairIndex string, amount sdk.Coins) {
+	pool, found := k.GetIncentivePool(ctx, pairIndex)
+	if !found {
+		pool = types.IncentivePool{PairIndex: pairIndex}
+		k.stakeOrderBooks(ctx, &pool)
+	}
+	pool.Balance = pool.Balance.Add(amount...)
+	k.SetIncentivePool(ctx, pool)
+}
+
+// stakeOrderBooks stakes the orders of the order books of the pair resting on this chain
+// and records the reference price their distance is measured from
+func (k Keeper) stakeOrderBooks(ctx sdk.Context, pool *types.IncentivePool) {
+	pool.ReferencePrice, _ = k.rewardReferencePrice(ctx, pool.PairIndex)
+	if sellOrderBook, found := k.GetSellOrderBook(ctx, pool.PairIndex); found {
+		k.updateRewardStakes(ctx, pool, types.OrderTypeSell, nil, sellOrderBook.Book)
+	}
+	if buyOrderBook, found := k.GetBuyOrderBook(ctx, pool.PairIndex); found {
+		k.updateRewardStakes(ctx, pool, types.OrderTypeBuy, nil, buyOrderBook.Book)
+	}
+}
+
+// afterOrderBookChanged updates the reward stakes of the makers whose orders changed between
+// two versions of an order book, it is called every time an order book is written
+func (k Keeper) afterOrderBookChanged(ctx sdk.Context, orderType string, pairIndex string, previous *types.OrderBook, book *types.OrderBook) {
+	pool, found := k.GetIncentivePool(ctx, pairIndex)
+	if !found {
+		return
+	}
+	if k.updateRewardStakes(ctx, &pool, orderType, previous, book) {
+		k.SetIncentivePool(ctx, pool)
+	}
+}
+
+// updateRewardStakes settles the rewards of the makers whose orders changed and stakes the amount
+// of their orders within the rewarded distance of the price. The distance of the orders of the other
+// makers is checked again when the reference price moves, it returns false when no stake changed.
+func (k Keeper) updateRewardStakes(ctx sdk.Context, pool *types.IncentivePool, orderType string, previous *types.OrderBook, book *types.OrderBook) bool {
+	owners := previous.ChangedOwners(book)
+	if len(owners) == 0 {
+		return false
+	}
+
+	reference, found := k.rewardReferencePrice(ctx, pool.PairIndex)
+	policy := k.LiquidityMiningPolicy(ctx)
+
+	for _, owner := range owners {
+		var weight int64
+		if found {
+			weight = rewardWeight(policy, book, owner, reference)
+		}
+		k.settleRewardStake(ctx, pool, owner, orderType, weight)
+	}
+	return true
+}
+
+// settleRewardStake credits the rewards accrued by the stake of a maker and replaces its weight
+func (k Keeper) settleRewardStake(ctx sdk.Context, pool *types.IncentivePool, owner string, orderType string, weight int64) {
+	stake, found := k.GetRewardStake(ctx, owner, pool.PairIndex, orderType)
+	if found {
+		k.creditMakerReward(ctx, owner, stake.Rewards(pool.RewardPerUnit))
+		pool.TotalWeight -= stake.Weight
+	}
+
+	if weight == 0 {
+		k.RemoveRewardStake(ctx, owner, pool.PairIndex, orderType)
+		return
+	}
+	k.SetRewardStake(ctx, types.RewardStake{
+		Address:       owner,
+		PairIndex:     pool.PairIndex,
+		OrderType:     orderType,
+		Weight:        weight,
+		RewardPerUnit: pool.RewardPerUnit,
+	})
+	pool.TotalWeight += weight
+}
+
+// creditMakerReward adds settled rewards to the rewards of a maker
+func (k Keeper) creditMakerReward(ctx sdk.Context, owner string, rewards sdk.Coins) {
+	if rewards.IsZero() {
+		return
+	}
+	reward, found := k.GetMakerReward(ctx, owner)
+	if !found {
+		reward = types.MakerReward{Address: owner}
+	}
+	reward.Rewards = reward.Rewards.Add(rewards...)
+	k.SetMakerReward(ctx, reward)
+}
+
+// closeIncentivePool returns the rewards left in the incentive pool of a delisted pair to the
+// community pool, the stakes of the pair are settled when its order books are removed
+func (k Keeper) closeIncentivePool(ctx sdk.Context, pairIndex string) error {
+	pool, found := k.GetIncentivePool(ctx, pairIndex)
+	if !found {
+		return nil
+	}
+
+	k.RemoveIncentivePool(ctx, pairIndex)
+	if pool.Balance.IsZero() {
+		return nil
+	}
+	return k.distrKeeper.FundCommunityPool(ctx, pool.Balance, types.IncentivePoolAddress())
+}
+
+// GetClaimableRewards returns the rewards a maker can claim, the settled rewards and the rewards
+// accrued by its stakes since they were last settled
+func (k Keeper) GetClaimableRewards(ctx sdk.Context, address string) (sdk.Coins, bool) {
+	reward, found := k.GetMakerReward(ctx, address)
+	rewards := reward.Rewards
+	for _, stake := range k.GetRewardStakesByAddress(ctx, address) {
+		found = true
+		if pool, poolFound := k.GetIncentivePool(ctx, stake.PairIndex); poolFound {
+			rewards = rewards.Add(stake.Rewards(pool.RewardPerUnit)...)
+		}
+	}
+	return rewards, found
+}
+
+// ClaimRewards settles the stakes of a maker and pays its rewards
+func (k Keeper) ClaimRewards(ctx sdk.Context, maker sdk.AccAddress) (sdk.Coins, error) {
+	for _, stake := range k.GetRewardStakesByAddress(ctx, maker.String()) {
+		pool, found := k.GetIncentivePool(ctx, stake.PairIndex)
+		if !found {
+			continue
+		}
+		k.creditMakerReward(ctx, stake.Address, stake.Rewards(pool.RewardPerUnit))
+		stake.RewardPerUnit = pool.RewardPerUnit
+		k.SetRewardStake(ctx, stake)
+	}
+
+	reward, found := k.GetMakerReward(ctx, maker.String())
+	if !found || reward.Rewards.IsZero() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no rewards for %s", maker)
+	}
+
+	k.RemoveMakerReward(ctx, reward.Address)
+	if err := k.bankKeeper.SendCoins(ctx, types.IncentivePoolAddress(), maker, reward.Rewards); err != nil {
+		return nil, err
+	}
+	return reward.Rewards, nil
+}
+
+// AccrueRewards adds the emission of every incentive pool to its rewards per unit of staked order
+// amount, so the rewards of a maker grow with the size of its orders and the number of blocks they
+// rest in the book. The orders are staked again when the last price moved since they were measured,
+// and nothing accrues before the first trade of the pair. The rewards are credited to the makers
+// when their stakes are settled, the truncated dust stays on the incentive pool address.
+func (k Keeper) AccrueRewards(ctx sdk.Context) {
+	policy := k.LiquidityMiningPolicy(ctx)
+	if !policy.IsEnabled() {
+		return
+	}
+
+	for _, pool := range k.GetAllIncentivePool(ctx) {
+		//取引が停止されたペアには報酬を配らない
+		if k.IsPairPaused(ctx, pool.PairIndex) {
+			continue
+		}
+
+		//最初の取引までは基準価格がないため報酬を配らない
+		reference, found := k.rewardReferencePrice(ctx, pool.PairIndex)
+		if !found {
+			continue
+		}
+		//基準価格が動いた場合、すべての注文の距離を測り直す
+		if reference != pool.ReferencePrice {
+			k.stakeOrderBooks(ctx, &pool)
+			k.SetIncentivePool(ctx, pool)
+		}
+
+		if pool.TotalWeight == 0 {
+			continue
+		}
+		emission := policy.Emission(pool.Balance)
+		if emission.IsZero() {
+			continue
+		}
+
+		//注文量の単位あたりの報酬を累積する
+		perUnit := sdk.NewDecCoinsFromCoins(emission...).QuoDecTruncate(sdk.NewDec(pool.TotalWeight))
+		pool.RewardPerUnit = pool.RewardPerUnit.Add(perUnit...)
+		pool.Balance = pool.Balance.Sub(emission)
+		k.SetIncentivePool(ctx, pool)
+	}
+}
+
+// rewardWeight returns the amount of the orders of an owner within the rewarded distance of the price
+func rewardWeight(policy types.LiquidityMiningPolicy, book *types.OrderBook, owner string, reference int32) (weight int64) {
+	if book == nil {
+		return 0
+	}
+	for _, order := range book.Orders {
+		if order.Creator != owner {
+			continue
+		}
+		//報酬が無効な間は距離を問わず全ての注文をステークする
+		if !policy.MaxDistance.IsNil() && !policy.IsWithinDistance(order.Price, reference) {
+			continue
+		}
+		weight += int64(order.Amount)
+	}
+	return weight
+}
+
+// rewardReferencePrice returns the price the distance of the rewarded orders is measured from.
+// The other side of a pair rests on the counterparty chain, so the last trade price stands for
+// the mid-price, no order is rewarded before the first trade.
+func (k Keeper) rewardReferencePrice(ctx sdk.Context, pairIndex string) (int32, bool) {
+	lastPrice, found := k.GetLastPrice(ctx, pairIndex)
+	return lastPrice.Price, found
+}
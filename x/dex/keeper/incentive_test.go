@@ -0,0 +1,221 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/x/dex/types"
+)
+
+// setupIncentivePool creates a sell order book with orders near and far from the last price
+// and funds its incentive pool with 1000stake
+func setupIncentivePool(t *testing.T, f *keepertest.BankFixture) (pairIndex string, alice sdk.AccAddress, bob sdk.AccAddress, carol sdk.AccAddress) {
+	pairIndex = types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	alice = sampleAccAddress(t)
+	bob = sampleAccAddress(t)
+	carol = sampleAccAddress(t)
+
+	params := types.DefaultParams()
+	params.LiquidityMiningPolicy = types.LiquidityMiningPolicy{
+		EmissionRate: sdk.NewDecWithPrec(1, 1),
+		MaxDistance:  sdk.NewDecWithPrec(5, 2),
+	}
+	f.Keeper.SetParams(f.Ctx, params)
+
+	book := types.NewSellOrderBook("stake", "token")
+	book.Index = pairIndex
+	for _, order := range []types.Order{
+		{Creator: alice.String(), Amount: 30, Price: 100},
+		{Creator: bob.String(), Amount: 10, Price: 104},
+		{Creator: carol.String(), Amount: 50, Price: 200},
+	} {
+		_, err := book.AppendOrder(order.Creator, order.Amount, order.Price, 0)
+		require.NoError(t, err)
+	}
+	f.Keeper.SetSellOrderBook(f.Ctx, book)
+	f.Keeper.SetLastPrice(f.Ctx, types.LastPrice{Index: pairIndex, Price: 100})
+
+	sponsor := sampleAccAddress(t)
+	f.Fund(sponsor, sdk.NewInt64Coin("stake", 1000))
+	require.NoError(t, f.Keeper.FundIncentivePool(f.Ctx, sponsor, pairIndex, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+	f.RequireBalance(sponsor, "stake", 0)
+	f.RequireBalance(types.IncentivePoolAddress(), "stake", 1000)
+
+	return pairIndex, alice, bob, carol
+}
+
+func TestFundIncentivePool(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex, _, _, _ := setupIncentivePool(t, f)
+
+	pool, found := f.Keeper.GetIncentivePool(f.Ctx, pairIndex)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), pool.Balance)
+
+	// The pair must have an order book on this chain
+	sponsor := sampleAccAddress(t)
+	f.Fund(sponsor, sdk.NewInt64Coin("stake", 10))
+	err := f.Keeper.FundIncentivePool(f.Ctx, sponsor, "unknown", sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	f.RequireBalance(sponsor, "stake", 10)
+
+	// The sponsor must own the coins
+	err = f.Keeper.FundIncentivePool(f.Ctx, sponsor, pairIndex, sdk.NewCoins(sdk.NewInt64Coin("stake", 11)))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// Governance funds the pool from the community pool
+	f.FundCommunityPool(sdk.NewInt64Coin("stake", 500))
+	require.NoError(t, f.Keeper.FundIncentivePoolFromCommunityPool(f.Ctx, pairIndex, sdk.NewCoins(sdk.NewInt64Coin("stake", 200))))
+	pool, _ = f.Keeper.GetIncentivePool(f.Ctx, pairIndex)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1200)), pool.Balance)
+	f.RequireBalance(types.IncentivePoolAddress(), "stake", 1200)
+}
+
+func TestAccrueRewards(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex, alice, bob, carol := setupIncentivePool(t, f)
+
+	// The distance is measured from the last price, 100, and the orders within 5% of it
+	// are staked when the pool is created
+	pool, _ := f.Keeper.GetIncentivePool(f.Ctx, pairIndex)
+	require.Equal(t, int64(40), pool.TotalWeight)
+	_, found := f.Keeper.GetRewardStake(f.Ctx, carol.String(), pairIndex, types.OrderTypeSell)
+	require.False(t, found)
+
+	// The 100stake emitted are split between the 40 staked tokens
+	f.Keeper.AccrueRewards(f.Ctx)
+	requireMakerReward(t, f, alice, 75)
+	requireMakerReward(t, f, bob, 25)
+	_, found = f.Keeper.GetClaimableRewards(f.Ctx, carol.String())
+	require.False(t, found)
+
+	// The orders keep accruing every block they rest, the rewards are credited when settled
+	f.Keeper.AccrueRewards(f.Ctx)
+	requireMakerReward(t, f, alice, 75+67)
+	requireMakerReward(t, f, bob, 25+22)
+	_, found = f.Keeper.GetMakerReward(f.Ctx, alice.String())
+	require.False(t, found)
+	pool, _ = f.Keeper.GetIncentivePool(f.Ctx, pairIndex)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000-100-90)), pool.Balance)
+
+	// The orders of a maker are staked again when they change
+	f.Keeper.SetLastPrice(f.Ctx, types.LastPrice{Index: pairIndex, Price: 195})
+	book, _ := f.Keeper.GetSellOrderBook(f.Ctx, pairIndex)
+	orderID, err := book.AppendOrder(carol.String(), 10, 199, 0)
+	require.NoError(t, err)
+	f.Keeper.SetSellOrderBook(f.Ctx, book)
+	pool, _ = f.Keeper.GetIncentivePool(f.Ctx, pairIndex)
+	require.Equal(t, int64(40+60), pool.TotalWeight)
+
+	// All the orders are staked again once the last price moved, the orders now too far
+	// from it stop accruing and their rewards are settled
+	f.Keeper.AccrueRewards(f.Ctx)
+	pool, _ = f.Keeper.GetIncentivePool(f.Ctx, pairIndex)
+	require.Equal(t, int64(60), pool.TotalWeight)
+	require.Equal(t, int32(195), pool.ReferencePrice)
+	requireMakerReward(t, f, carol, 81)
+	reward, found := f.Keeper.GetMakerReward(f.Ctx, alice.String())
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 142)), reward.Rewards)
+	_, found = f.Keeper.GetRewardStake(f.Ctx, alice.String(), pairIndex, types.OrderTypeSell)
+	require.False(t, found)
+	requireMakerReward(t, f, bob, 47)
+
+	// A removed order stops accruing, its rewards are settled
+	require.NoError(t, book.Book.RemoveOrderFromID(orderID))
+	f.Keeper.SetSellOrderBook(f.Ctx, book)
+	reward, found = f.Keeper.GetMakerReward(f.Ctx, carol.String())
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 81)), reward.Rewards)
+	stake, found := f.Keeper.GetRewardStake(f.Ctx, carol.String(), pairIndex, types.OrderTypeSell)
+	require.True(t, found)
+	require.Equal(t, int64(50), stake.Weight)
+
+	// Paused pairs don't accrue rewards
+	f.Keeper.SetPairPaused(f.Ctx, pairIndex, true)
+	f.Keeper.AccrueRewards(f.Ctx)
+	requireMakerReward(t, f, carol, 81)
+}
+
+func TestAccrueRewardsBeforeFirstTrade(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	alice := sampleAccAddress(t)
+
+	params := types.DefaultParams()
+	params.LiquidityMiningPolicy = types.LiquidityMiningPolicy{
+		EmissionRate: sdk.NewDecWithPrec(1, 1),
+		MaxDistance:  sdk.NewDecWithPrec(5, 2),
+	}
+	f.Keeper.SetParams(f.Ctx, params)
+
+	book := types.NewSellOrderBook("stake", "token")
+	book.Index = pairIndex
+	_, err := book.AppendOrder(alice.String(), 20, 100, 0)
+	require.NoError(t, err)
+	f.Keeper.SetSellOrderBook(f.Ctx, book)
+	f.FundCommunityPool(sdk.NewInt64Coin("stake", 1000))
+	require.NoError(t, f.Keeper.FundIncentivePoolFromCommunityPool(f.Ctx, pairIndex, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+
+	// Without a trade there is no reference price, the orders are not staked and nothing accrues
+	f.Keeper.AccrueRewards(f.Ctx)
+	pool, _ := f.Keeper.GetIncentivePool(f.Ctx, pairIndex)
+	require.Zero(t, pool.TotalWeight)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), pool.Balance)
+	_, found := f.Keeper.GetClaimableRewards(f.Ctx, alice.String())
+	require.False(t, found)
+
+	// The orders are staked from the first trade
+	f.Keeper.SetLastPrice(f.Ctx, types.LastPrice{Index: pairIndex, Price: 101})
+	f.Keeper.AccrueRewards(f.Ctx)
+	requireMakerReward(t, f, alice, 100)
+}
+
+func TestClaimRewards(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex, alice, _, carol := setupIncentivePool(t, f)
+	f.Keeper.AccrueRewards(f.Ctx)
+
+	rewards, err := f.Keeper.ClaimRewards(f.Ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 75)), rewards)
+	f.RequireBalance(alice, "stake", 75)
+	f.RequireBalance(types.IncentivePoolAddress(), "stake", 1000-75)
+
+	// The rewards are only paid once, the stake keeps accruing
+	_, err = f.Keeper.ClaimRewards(f.Ctx, alice)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	_, found := f.Keeper.GetRewardStake(f.Ctx, alice.String(), pairIndex, types.OrderTypeSell)
+	require.True(t, found)
+
+	_, err = f.Keeper.ClaimRewards(f.Ctx, carol)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
+
+func TestDelistPairIncentivePool(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex, alice, bob, _ := setupIncentivePool(t, f)
+	f.Keeper.AccrueRewards(f.Ctx)
+	f.FundEscrow("dex", "channel-0", sdk.NewInt64Coin("stake", 90))
+
+	// The makers keep the accrued rewards and the rest of the pool goes back to the community pool
+	require.NoError(t, f.Keeper.DelistPair(f.Ctx, "dex", "channel-0", "stake", "token"))
+	_, found := f.Keeper.GetIncentivePool(f.Ctx, pairIndex)
+	require.False(t, found)
+	require.Empty(t, f.Keeper.GetAllRewardStake(f.Ctx))
+	requireMakerReward(t, f, alice, 75)
+	requireMakerReward(t, f, bob, 25)
+	f.RequireBalance(types.IncentivePoolAddress(), "stake", 100)
+	f.RequireBalance(authtypes.NewModuleAddress(distrtypes.ModuleName), "stake", 900)
+}
+
+func requireMakerReward(t *testing.T, f *keepertest.BankFixture, maker sdk.AccAddress, amount int64) {
+	rewards, found := f.Keeper.GetClaimableRewards(f.Ctx, maker.String())
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)), rewards)
+}
@@ -20,7 +20,12 @@ type (
 		memKey     sdk.StoreKey
 		paramstore paramtypes.Subspace
 
-		bankKeeper types.BankKeeper
+		bankKeeper     types.BankKeeper
+		transferKeeper types.TransferKeeper
+		distrKeeper    types.DistributionKeeper
+
+		packetHandlers map[string]PacketHandler
+		hooks          types.DexHooks
 	}
 )
 
@@ -33,13 +38,15 @@ func NewKeeper(
 	portKeeper cosmosibckeeper.PortKeeper,
 	scopedKeeper cosmosibckeeper.ScopedKeeper,
 	bankKeeper types.BankKeeper,
+	transferKeeper types.TransferKeeper,
+	distrKeeper types.DistributionKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
 		ps = ps.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return &Keeper{
+	k := &Keeper{
 		Keeper: cosmosibckeeper.NewKeeper(
 			types.PortKey,
 			storeKey,
@@ -47,12 +54,19 @@ func NewKeeper(
 			portKeeper,
 			scopedKeeper,
 		),
-		cdc:        cdc,
-		storeKey:   storeKey,
-		memKey:     memKey,
-		paramstore: ps,
-		bankKeeper: bankKeeper,
+		cdc:            cdc,
+		storeKey:       storeKey,
+		memKey:         memKey,
+		paramstore:     ps,
+		bankKeeper:     bankKeeper,
+		transferKeeper: transferKeeper,
+		distrKeeper:    distrKeeper,
+
+		packetHandlers: make(map[string]PacketHandler),
 	}
+	k.registerPacketHandlers()
+
+	return k
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
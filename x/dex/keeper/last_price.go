@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	"interchange/x/dex/types"
+)
+
+// SetLastPrice set a specific lastPrice in the store from its index
+func (k Keeper) SetLastPrice(ctx sdk.Context, lastPrice types.LastPrice) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastPriceKeyPrefix))
+	b := k.cdc.MustMarshal(&lastPrice)
+	store.Set(types.LastPriceKey(
+		lastPrice.Index,
+	), b)
+}
+
+// GetLastPrice returns a lastPrice from its index
+func (k Keeper) GetLastPrice(
+	ctx sdk.Context,
+	index string,
+
+) (val types.LastPrice, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastPriceKeyPrefix))
+
+	b := store.Get(types.LastPriceKey(
+		index,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllLastPrice returns all lastPrice
+func (k Keeper) GetAllLastPrice(ctx sdk.Context) (list []types.LastPrice) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastPriceKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.LastPrice
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// recordTrades records the orders of a book liquidated by the matching engine,
+// or the order of this chain filled on the counterparty chain
+func (k Keeper) recordTrades(ctx sdk.Context, index string, liquidated []types.Order) {
+	if len(liquidated) == 0 {
+		return
+	}
+
+	//最後に約定した注文の価格を記録する
+	k.SetLastPrice(ctx, types.LastPrice{
+		Index:  index,
+		Price:  liquidated[len(liquidated)-1].Price,
+		Height: ctx.BlockHeight(),
+	})
+
+	//ローソク足とティッカーを更新する
+	k.recordCandles(ctx, index, liquidated)
+
+	//TWAPの累積価格を更新する
+	k.updateTWAP(ctx, index, liquidated[len(liquidated)-1].Price)
+}
+
+// recordSellSettlement records the part of a sell order filled on the counterparty chain
+// at the average price of the gain
+func (k Keeper) recordSellSettlement(ctx sdk.Context, packet channeltypes.Packet, data types.SellOrderPacketData, ack types.SellOrderPacketAck) {
+	pairIndex := types.OrderBookIndex(packet.SourcePort, packet.SourceChannel, data.AmountDenom, data.PriceDenom)
+	k.recordSettlement(ctx, pairIndex, data.Amount-ack.RemainingAmount-ack.PreventedAmount, ack.Gain)
+}
+
+// recordBuySettlement records the purchase of a buy order on the counterparty chain
+// at the average price paid, the price of the order less the refund
+func (k Keeper) recordBuySettlement(ctx sdk.Context, packet channeltypes.Packet, data types.BuyOrderPacketData, ack types.BuyOrderPacketAck) {
+	pairIndex := types.OrderBookIndex(packet.SourcePort, packet.SourceChannel, data.AmountDenom, data.PriceDenom)
+	k.recordSettlement(ctx, pairIndex, ack.Purchase, int64(ack.Purchase)*int64(data.Price)-ack.Refund)
+}
+
+// recordSettlement records the amount filled for a total price as a single trade at the rounded average price
+func (k Keeper) recordSettlement(ctx sdk.Context, index string, filled int32, total int64) {
+	if filled <= 0 || total <= 0 {
+		return
+	}
+
+	//約定した平均価格を注文の価格の範囲に丸める
+	price := (total + int64(filled)/2) / int64(filled)
+	switch {
+	case price < 1:
+		price = 1
+	case price > int64(types.MaxPrice):
+		price = int64(types.MaxPrice)
+	}
+	k.recordTrades(ctx, index, []types.Order{{Amount: filled, Price: int32(price)}})
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"interchange/x/dex/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates the DenomTrace store from version 2 to 3.
+// Version 2 indexed the traces by the voucher truncated to 16 characters and
+// recorded the local port and channel, version 3 indexes them by the full
+// ibc/{hash} voucher minted on the counterparty chain and records its full path.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	k := m.keeper
+	for _, trace := range k.GetAllDenomTrace(ctx) {
+		port, channel := trace.Port, trace.Channel
+		if channelEnd, found := k.ChannelKeeper.GetChannel(ctx, port, channel); found {
+			port = channelEnd.GetCounterparty().GetPortID()
+			channel = channelEnd.GetCounterparty().GetChannelID()
+		}
+
+		// バウチャーはフルパスのdenomから計算する
+		denom, err := k.FullDenomPath(ctx, trace.Origin)
+		if err != nil {
+			denom = trace.Origin
+		}
+
+		k.RemoveDenomTrace(ctx, trace.Index)
+		k.SetDenomTrace(ctx, types.NewDenomTrace(port, channel, denom, trace.Origin))
+	}
+	return nil
+}
+
+// Migrate3to4 migrates the order book stores from version 3 to 4.
+// Version 4 indexes the sell and buy order books by the owners of their orders.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	k := m.keeper
+	for _, book := range k.GetAllSellOrderBook(ctx) {
+		k.SetSellOrderBook(ctx, book)
+	}
+	for _, book := range k.GetAllBuyOrderBook(ctx) {
+		k.SetBuyOrderBook(ctx, book)
+	}
+	return nil
+}
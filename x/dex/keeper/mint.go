@@ -18,15 +18,15 @@ func isIBCToken(denom string) bool {
 }
 
 // トークンがIBCバウチャーである場合はトークンを燃焼、トークンがチェーンにネイティブである場合はトークンをロックする
-func (k Keeper) SafeBurn(ctx sdk.Context, port string, channel string, sender sdk.AccAddress, denom string, amount int32) error {
+func (k Keeper) SafeBurn(ctx sdk.Context, port string, channel string, sender sdk.AccAddress, denom string, amount int64) error {
 	if isIBCToken(denom) {
 		//トークンの燃焼
-		if err := k.BurnTokens(ctx, sender, sdk.NewCoin(denom, sdk.NewInt(int64(amount)))); err != nil {
+		if err := k.BurnTokens(ctx, sender, sdk.NewCoin(denom, sdk.NewInt(amount))); err != nil {
 			return err
 		}
 	} else {
 		// トークンをロック
-		if err := k.LockTokens(ctx, port, channel, sender, sdk.NewCoin(denom, sdk.NewInt(int64(amount)))); err != nil {
+		if err := k.LockTokens(ctx, port, channel, sender, sdk.NewCoin(denom, sdk.NewInt(amount))); err != nil {
 			return err
 		}
 	}
@@ -70,11 +70,11 @@ func (k Keeper) LockTokens(ctx sdk.Context, sourcePort string, sourceChannel str
 
 // トークンがIBCバウチャートークン(ibc/....) である場合、MintTokens(トークンを受信者のアカウントに送信する)
 // それ以外の場合は、UnlockTokens(ネイティブトークンのロックを解除)
-func (k Keeper) SafeMint(ctx sdk.Context, port string, channel string, receiver sdk.AccAddress, denom string, amount int32) error {
+func (k Keeper) SafeMint(ctx sdk.Context, port string, channel string, receiver sdk.AccAddress, denom string, amount int64) error {
 	//IBCバウチャートークンの場合
 	if isIBCToken(denom) {
 		//トークンを受信者のアカウントに送信する
-		if err := k.MintTokens(ctx, receiver, sdk.NewCoin(denom, sdk.NewInt(int64(amount)))); err != nil {
+		if err := k.MintTokens(ctx, port, channel, receiver, sdk.NewCoin(denom, sdk.NewInt(amount))); err != nil {
 			return err
 		}
 	} else {
@@ -84,7 +84,7 @@ func (k Keeper) SafeMint(ctx sdk.Context, port string, channel string, receiver
 			port,
 			channel,
 			receiver,
-			sdk.NewCoin(denom, sdk.NewInt(int64(amount))),
+			sdk.NewCoin(denom, sdk.NewInt(amount)),
 		); err != nil {
 			return err
 		}
@@ -94,7 +94,13 @@ func (k Keeper) SafeMint(ctx sdk.Context, port string, channel string, receiver
 }
 
 // トークンを受信者のアカウントに送信する
-func (k Keeper) MintTokens(ctx sdk.Context, receiver sdk.AccAddress, tokens sdk.Coin) error {
+// ミントしたトークンはチャネルからの送出として、レート制限のクォータを消費する
+func (k Keeper) MintTokens(ctx sdk.Context, sourcePort string, sourceChannel string, receiver sdk.AccAddress, tokens sdk.Coin) error {
+	//ポート、チャネルとdenomのレート制限を確認
+	if err := k.consumeOutflowQuota(ctx, sourcePort, sourceChannel, tokens.Denom, tokens.Amount.Int64()); err != nil {
+		return err
+	}
+
 	//転送元が同じチェーンの場合、新しいトークンを作成
 	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(tokens)); err != nil {
 		return err
@@ -109,7 +115,13 @@ func (k Keeper) MintTokens(ctx sdk.Context, receiver sdk.AccAddress, tokens sdk.
 }
 
 // ネイティブブロックチェーンに送り返された後にトークンをロック解除する
+// エスクローからの送出として、レート制限のクォータを消費する
 func (k Keeper) UnlockTokens(ctx sdk.Context, sourcePort string, sourceChannel string, receiver sdk.AccAddress, tokens sdk.Coin) error {
+	//ポート、チャネルとdenomのレート制限を確認
+	if err := k.consumeOutflowQuota(ctx, sourcePort, sourceChannel, tokens.Denom, tokens.Amount.Int64()); err != nil {
+		return err
+	}
+
 	// ネイティブトークンのエスクローアドレスを作成する(トークンをロック解除する為)
 	escrowAddress := ibctransfertypes.GetEscrowAddress(sourcePort, sourceChannel)
 
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/sample"
+	"interchange/x/dex/types"
+)
+
+const (
+	testPort    = "dex"
+	testChannel = "channel-0"
+	nativeDenom = "marscoin"
+	ibcDenom    = "ibc/C053D637CCA2A2BA030E2C5EE1B28A16F71CCB0E45E8BE52766DC1B241B77878"
+)
+
+func TestSafeBurn(t *testing.T) {
+	for _, tc := range []struct {
+		desc    string
+		denom   string
+		balance int64
+		amount  int64
+		escrow  int64
+		supply  int64
+		err     error
+	}{
+		{
+			desc:    "NativeLocked",
+			denom:   nativeDenom,
+			balance: 100,
+			amount:  40,
+			escrow:  40,
+			supply:  100,
+		},
+		{
+			desc:    "VoucherBurned",
+			denom:   ibcDenom,
+			balance: 100,
+			amount:  40,
+			supply:  60,
+		},
+		{
+			desc:    "NativeInsufficientFunds",
+			denom:   nativeDenom,
+			balance: 10,
+			amount:  40,
+			supply:  10,
+			err:     sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			desc:    "VoucherInsufficientFunds",
+			denom:   ibcDenom,
+			balance: 10,
+			amount:  40,
+			supply:  10,
+			err:     sdkerrors.ErrInsufficientFunds,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			f := keepertest.DexKeeperWithBank(t)
+			sender, err := sdk.AccAddressFromBech32(sample.AccAddress())
+			require.NoError(t, err)
+			f.Fund(sender, sdk.NewInt64Coin(tc.denom, tc.balance))
+
+			err = f.Keeper.SafeBurn(f.Ctx, testPort, testChannel, sender, tc.denom, tc.amount)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				f.RequireBalance(sender, tc.denom, tc.balance)
+			} else {
+				require.NoError(t, err)
+				f.RequireBalance(sender, tc.denom, tc.balance-int64(tc.amount))
+			}
+			f.RequireEscrow(testPort, testChannel, tc.denom, tc.escrow)
+			f.RequireSupply(tc.denom, tc.supply)
+		})
+	}
+}
+
+func TestSafeMint(t *testing.T) {
+	for _, tc := range []struct {
+		desc       string
+		denom      string
+		escrow     int64
+		rateLimits []types.RateLimit
+		amount     int64
+		received   int64
+		unlocked   int64
+		supply     int64
+		err        error
+	}{
+		{
+			desc:     "NativeUnlocked",
+			denom:    nativeDenom,
+			escrow:   100,
+			amount:   40,
+			received: 40,
+			unlocked: 40,
+			supply:   100,
+		},
+		{
+			desc:     "VoucherMinted",
+			denom:    ibcDenom,
+			amount:   40,
+			received: 40,
+			supply:   40,
+		},
+		{
+			desc:   "NativeInsufficientEscrow",
+			denom:  nativeDenom,
+			escrow: 10,
+			amount: 40,
+			supply: 10,
+			err:    sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			desc:  "VoucherRateLimitExceeded",
+			denom: ibcDenom,
+			rateLimits: []types.RateLimit{
+				{Port: testPort, Channel: testChannel, Denom: ibcDenom, MaxOutflow: 30, Window: time.Hour},
+			},
+			amount: 40,
+			err:    types.ErrRateLimitExceeded,
+		},
+		{
+			desc:   "NativeRateLimitExceeded",
+			denom:  nativeDenom,
+			escrow: 100,
+			rateLimits: []types.RateLimit{
+				{Port: testPort, Channel: testChannel, Denom: nativeDenom, MaxOutflow: 30, Window: time.Hour},
+			},
+			amount: 40,
+			supply: 100,
+			err:    types.ErrRateLimitExceeded,
+		},
+		{
+			desc:  "RateLimitOfOtherChannel",
+			denom: ibcDenom,
+			rateLimits: []types.RateLimit{
+				{Port: testPort, Channel: "channel-1", Denom: ibcDenom, MaxOutflow: 30, Window: time.Hour},
+			},
+			amount:   40,
+			received: 40,
+			supply:   40,
+		},
+		{
+			desc:  "RateLimitOfOtherPort",
+			denom: ibcDenom,
+			rateLimits: []types.RateLimit{
+				{Port: "other", Channel: testChannel, Denom: ibcDenom, MaxOutflow: 30, Window: time.Hour},
+			},
+			amount:   40,
+			received: 40,
+			supply:   40,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			f := keepertest.DexKeeperWithBank(t)
+			if tc.escrow > 0 {
+				f.FundEscrow(testPort, testChannel, sdk.NewInt64Coin(tc.denom, tc.escrow))
+			}
+			if tc.rateLimits != nil {
+				params := types.DefaultParams()
+				params.RateLimits = tc.rateLimits
+				f.Keeper.SetParams(f.Ctx, params)
+			}
+			receiver, err := sdk.AccAddressFromBech32(sample.AccAddress())
+			require.NoError(t, err)
+
+			err = f.Keeper.SafeMint(f.Ctx, testPort, testChannel, receiver, tc.denom, tc.amount)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+			f.RequireEscrow(testPort, testChannel, tc.denom, tc.escrow-tc.unlocked)
+			f.RequireBalance(receiver, tc.denom, tc.received)
+			f.RequireSupply(tc.denom, tc.supply)
+		})
+	}
+}
+
+func TestSafeBurnSafeMintRoundTrip(t *testing.T) {
+	for _, denom := range []string{nativeDenom, ibcDenom} {
+		t.Run(denom, func(t *testing.T) {
+			f := keepertest.DexKeeperWithBank(t)
+			addr, err := sdk.AccAddressFromBech32(sample.AccAddress())
+			require.NoError(t, err)
+			f.Fund(addr, sdk.NewInt64Coin(denom, 100))
+
+			// Tokens sent then refunded leave the balances and the supply unchanged
+			require.NoError(t, f.Keeper.SafeBurn(f.Ctx, testPort, testChannel, addr, denom, 40))
+			require.NoError(t, f.Keeper.SafeMint(f.Ctx, testPort, testChannel, addr, denom, 40))
+			f.RequireBalance(addr, denom, 100)
+			f.RequireEscrow(testPort, testChannel, denom, 0)
+			f.RequireSupply(denom, 100)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"interchange/x/dex/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AddLiquidity(goCtx context.Context, msg *types.MsgAddLiquidity) (*types.MsgAddLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// サーキットブレーカーで停止されたメッセージは受け付けない
+	if err := k.CheckMsgEnabled(ctx, msg); err != nil {
+		return &types.MsgAddLiquidityResponse{}, err
+	}
+
+	provider, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return &types.MsgAddLiquidityResponse{}, err
+	}
+
+	//オーダーブックはフルパスのdenomで作成されている
+	amountDenom, err := k.FullDenomPath(ctx, msg.AmountDenom)
+	if err != nil {
+		return &types.MsgAddLiquidityResponse{}, err
+	}
+	pairIndex := types.OrderBookIndex(msg.Port, msg.Channel, amountDenom, msg.PriceDenom)
+
+	//トークンをペアの流動性プールに預け入れ、シェアトークンを受け取る
+	shares, err := k.Keeper.AddLiquidity(ctx, provider, msg.Port, msg.Channel, pairIndex, msg.AmountDeposit, msg.PriceDeposit)
+	if err != nil {
+		return &types.MsgAddLiquidityResponse{}, err
+	}
+
+	return &types.MsgAddLiquidityResponse{Shares: shares}, nil
+}
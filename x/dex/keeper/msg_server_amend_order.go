@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"interchange/x/dex/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AmendOrder(goCtx context.Context, msg *types.MsgAmendOrder) (*types.MsgAmendOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// サーキットブレーカーで停止されたメッセージは受け付けない
+	if err := k.CheckMsgEnabled(ctx, msg); err != nil {
+		return &types.MsgAmendOrderResponse{}, err
+	}
+
+	//送信者のアドレスを取得する
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return &types.MsgAmendOrderResponse{}, err
+	}
+
+	//オーダーブックはこのチェーンのdenomをフルパスで作成されている
+	amountDenom, priceDenom := msg.AmountDenom, msg.PriceDenom
+	if msg.OrderType == types.OrderTypeSell {
+		amountDenom, err = k.FullDenomPath(ctx, msg.AmountDenom)
+	} else {
+		priceDenom, err = k.FullDenomPath(ctx, msg.PriceDenom)
+	}
+	if err != nil {
+		return &types.MsgAmendOrderResponse{}, err
+	}
+
+	pairIndex := types.OrderBookIndex(msg.Port, msg.Channel, amountDenom, priceDenom)
+	//ガバナンスで一時停止されている場合
+	if k.IsPairPaused(ctx, pairIndex) {
+		return &types.MsgAmendOrderResponse{}, errors.New("the pair is paused")
+	}
+
+	if msg.OrderType == types.OrderTypeSell {
+		//特定の売り注文表を取得する
+		s, found := k.GetSellOrderBook(ctx, pairIndex)
+		if !found {
+			return &types.MsgAmendOrderResponse{}, errors.New("the pair doesn't exist")
+		}
+		order, err := s.Book.GetOrderFromID(msg.OrderID)
+		if err != nil {
+			return &types.MsgAmendOrderResponse{}, err
+		}
+		if order.Creator != msg.Creator {
+			return &types.MsgAmendOrderResponse{}, errors.New("amender must be creator")
+		}
+		if _, err := s.AmendOrder(msg.OrderID, msg.Amount, msg.Price); err != nil {
+			return &types.MsgAmendOrderResponse{}, err
+		}
+
+		//ストアにセットする
+		k.SetSellOrderBook(ctx, s)
+
+		//差額のみエスクローを調整する
+		if err := k.adjustOrderEscrow(ctx, msg.Port, msg.Channel, creator, msg.AmountDenom, int64(msg.Amount)-int64(order.Amount)); err != nil {
+			return &types.MsgAmendOrderResponse{}, err
+		}
+		return &types.MsgAmendOrderResponse{}, nil
+	}
+
+	//特定の買い注文表を取得する
+	b, found := k.GetBuyOrderBook(ctx, pairIndex)
+	if !found {
+		return &types.MsgAmendOrderResponse{}, errors.New("the pair doesn't exist")
+	}
+	order, err := b.Book.GetOrderFromID(msg.OrderID)
+	if err != nil {
+		return &types.MsgAmendOrderResponse{}, err
+	}
+	if order.Creator != msg.Creator {
+		return &types.MsgAmendOrderResponse{}, errors.New("amender must be creator")
+	}
+	if _, err := b.AmendOrder(msg.OrderID, msg.Amount, msg.Price); err != nil {
+		return &types.MsgAmendOrderResponse{}, err
+	}
+
+	//ストアにセットする
+	k.SetBuyOrderBook(ctx, b)
+
+	//差額のみエスクローを調整する
+	if err := k.adjustOrderEscrow(ctx, msg.Port, msg.Channel, creator, msg.PriceDenom, int64(msg.Amount)*int64(msg.Price)-int64(order.Amount)*int64(order.Price)); err != nil {
+		return &types.MsgAmendOrderResponse{}, err
+	}
+	return &types.MsgAmendOrderResponse{}, nil
+}
+
+// adjustOrderEscrow escrows the additional tokens of an amended order or refunds the released ones
+func (k msgServer) adjustOrderEscrow(ctx sdk.Context, port string, channel string, creator sdk.AccAddress, denom string, delta int64) error {
+	switch {
+	case delta > 0:
+		//SafeBurnを使用して、新しいネイティブトークンが作成されないようにする
+		return k.SafeBurn(ctx, port, channel, creator, denom, delta)
+	case delta < 0:
+		//作成者に差額を返金する
+		return k.SafeMint(ctx, port, channel, creator, denom, -delta)
+	default:
+		return nil
+	}
+}
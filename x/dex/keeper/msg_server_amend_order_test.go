@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/sample"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+func TestMsgServerAmendOrder(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := sample.AccAddress()
+
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	book := types.NewBuyOrderBook("stake", "token")
+	book.Index = pairIndex
+	id, err := book.AppendOrder(creator, 10, 20, 0)
+	require.NoError(t, err)
+	_, err = book.AppendOrder(sample.AccAddress(), 10, 25, 0)
+	require.NoError(t, err)
+	k.SetBuyOrderBook(ctx, book)
+
+	msg := types.NewMsgAmendOrder(creator, "dex", "channel-0", types.OrderTypeBuy, "stake", "token", id, 20, 10)
+
+	// Only the creator can amend the order
+	other := *msg
+	other.Creator = sample.AccAddress()
+	_, err = srv.AmendOrder(wctx, &other)
+	require.EqualError(t, err, "amender must be creator")
+
+	// The escrowed price is unchanged, no tokens are moved
+	_, err = srv.AmendOrder(wctx, msg)
+	require.NoError(t, err)
+	book, found := k.GetBuyOrderBook(ctx, pairIndex)
+	require.True(t, found)
+	order, err := book.Book.GetOrderFromID(id)
+	require.NoError(t, err)
+	require.Equal(t, types.Order{Id: id, Creator: creator, Amount: 20, Price: 10}, order)
+	require.Equal(t, id, book.Book.Orders[0].Id)
+
+	msg.OrderID = 5
+	_, err = srv.AmendOrder(wctx, msg)
+	require.ErrorIs(t, err, types.ErrOrderNotFound)
+
+	k.SetPairPaused(ctx, pairIndex, true)
+	_, err = srv.AmendOrder(wctx, msg)
+	require.EqualError(t, err, "the pair is paused")
+
+	msg.PriceDenom = "unknown"
+	k.SetPairPaused(ctx, pairIndex, false)
+	_, err = srv.AmendOrder(wctx, msg)
+	require.EqualError(t, err, "the pair doesn't exist")
+}
+
+func TestMsgServerAmendOrderLargeEscrow(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	srv := keeper.NewMsgServerImpl(*f.Keeper)
+	wctx := sdk.WrapSDKContext(f.Ctx)
+	creator := sample.AccAddress()
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	require.NoError(t, err)
+
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	book := types.NewBuyOrderBook("stake", "token")
+	book.Index = pairIndex
+	id, err := book.AppendOrder(creator, 1, 1, 0)
+	require.NoError(t, err)
+	f.Keeper.SetBuyOrderBook(f.Ctx, book)
+
+	// The price of the amended order does not fit in an int32
+	escrow := int64(types.MaxAmount) * int64(types.MaxPrice)
+	f.Fund(creatorAddr, sdk.NewInt64Coin("token", escrow))
+	_, err = srv.AmendOrder(wctx, types.NewMsgAmendOrder(creator, "dex", "channel-0", types.OrderTypeBuy, "stake", "token", id, types.MaxAmount, types.MaxPrice))
+	require.NoError(t, err)
+	f.RequireBalance(creatorAddr, "token", 1)
+	f.RequireEscrow("dex", "channel-0", "token", escrow-1)
+
+	// Amending the order back refunds the difference
+	_, err = srv.AmendOrder(wctx, types.NewMsgAmendOrder(creator, "dex", "channel-0", types.OrderTypeBuy, "stake", "token", id, 1, 1))
+	require.NoError(t, err)
+	f.RequireBalance(creatorAddr, "token", escrow)
+	f.RequireEscrow("dex", "channel-0", "token", 0)
+}
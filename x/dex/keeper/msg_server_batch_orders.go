@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"interchange/x/dex/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
+)
+
+func (k msgServer) BatchOrders(goCtx context.Context, msg *types.MsgBatchOrders) (*types.MsgBatchOrdersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// サーキットブレーカーで停止されたメッセージは受け付けない
+	if err := k.CheckMsgEnabled(ctx, msg); err != nil {
+		return &types.MsgBatchOrdersResponse{}, err
+	}
+
+	//送信者のアドレスを取得する
+	sender, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return &types.MsgBatchOrdersResponse{}, err
+	}
+
+	//オーダーブックはこのチェーンのdenomをフルパスで作成されている
+	amountDenom, priceDenom := msg.AmountDenom, msg.PriceDenom
+	if msg.OrderType == types.OrderTypeSell {
+		amountDenom, err = k.FullDenomPath(ctx, msg.AmountDenom)
+	} else {
+		priceDenom, err = k.FullDenomPath(ctx, msg.PriceDenom)
+	}
+	if err != nil {
+		return &types.MsgBatchOrdersResponse{}, err
+	}
+
+	//指定されたdenomペアのオーダーブックが存在することを確認します。
+	pairIndex := types.OrderBookIndex(msg.Port, msg.ChannelID, amountDenom, priceDenom)
+	if len(msg.Orders) > 0 {
+		var found bool
+		if msg.OrderType == types.OrderTypeSell {
+			_, found = k.GetSellOrderBook(ctx, pairIndex)
+		} else {
+			_, found = k.GetBuyOrderBook(ctx, pairIndex)
+		}
+		//存在しなかった場合
+		if !found {
+			return &types.MsgBatchOrdersResponse{}, errors.New("the pair doesn't exist")
+		}
+		//新規注文はガバナンスで一時停止されたペアには出せないが、キャンセルは受け付ける
+		if k.IsPairPaused(ctx, pairIndex) {
+			return &types.MsgBatchOrdersResponse{}, errors.New("the pair is paused")
+		}
+	}
+
+	//注文をキャンセルする
+	if msg.OrderType == types.OrderTypeSell {
+		err = k.cancelBatchSellOrders(ctx, msg, pairIndex, sender)
+	} else {
+		err = k.cancelBatchBuyOrders(ctx, msg, pairIndex, sender)
+	}
+	if err != nil {
+		return &types.MsgBatchOrdersResponse{}, err
+	}
+
+	if len(msg.Orders) == 0 {
+		return &types.MsgBatchOrdersResponse{}, nil
+	}
+
+	//SafeBurnを使用して、新しいネイティブトークンが作成されないようにする
+	for _, order := range msg.Orders {
+		escrowDenom, escrowAmount := msg.AmountDenom, int64(order.Amount)
+		if msg.OrderType == types.OrderTypeBuy {
+			escrowDenom, escrowAmount = msg.PriceDenom, int64(order.Amount)*int64(order.Price)
+		}
+		if err := k.SafeBurn(
+			ctx, msg.Port,
+			msg.ChannelID,
+			sender,
+			escrowDenom,
+			escrowAmount,
+		); err != nil {
+			return &types.MsgBatchOrdersResponse{}, err
+		}
+	}
+
+	//ターゲットチェーンで受け取ったバウチャーを保存(後で元に戻すことができるようにする)
+	voucherDenom := amountDenom
+	if msg.OrderType == types.OrderTypeBuy {
+		voucherDenom = priceDenom
+	}
+	if err := k.SaveVoucherDenom(ctx, msg.Port, msg.ChannelID, voucherDenom); err != nil {
+		return &types.MsgBatchOrdersResponse{}, err
+	}
+
+	//パケットを構築
+	var packet types.BatchOrderPacketData
+	packet.OrderType = msg.OrderType
+	packet.AmountDenom = amountDenom
+	packet.PriceDenom = priceDenom
+	packet.Creator = msg.Creator
+	packet.Orders = msg.Orders
+	packet.SelfTradePrevention = msg.SelfTradePrevention
+
+	//IBCパケットをターゲットチェーンに送信
+	_, err = k.TransmitPacket(
+		ctx,
+		&packet,
+		msg.Port,
+		msg.ChannelID,
+		clienttypes.ZeroHeight(),
+		msg.TimeoutTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBatchOrdersResponse{}, nil
+}
+
+// cancelBatchSellOrders removes the cancelled orders of a batch from the sell order book and refunds them
+func (k msgServer) cancelBatchSellOrders(ctx sdk.Context, msg *types.MsgBatchOrders, pairIndex string, creator sdk.AccAddress) error {
+	if len(msg.CancelOrderIDs) == 0 {
+		return nil
+	}
+
+	s, found := k.GetSellOrderBook(ctx, pairIndex)
+	if !found {
+		return errors.New("the pair doesn't exist")
+	}
+
+	var refund int64
+	for _, id := range msg.CancelOrderIDs {
+		order, err := s.Book.GetOrderFromID(id)
+		if err != nil {
+			return err
+		}
+		if order.Creator != msg.Creator {
+			return errors.New("canceller must be creator")
+		}
+		if err := s.Book.RemoveOrderFromID(id); err != nil {
+			return err
+		}
+		//注文のデポジットを返金する
+		if err := k.ReleaseOrderDeposit(ctx, types.OrderTypeSell, pairIndex, id); err != nil {
+			return err
+		}
+		k.AfterOrderCancelled(ctx, types.OrderTypeSell, pairIndex, order)
+		refund += int64(order.Amount)
+	}
+
+	//ストアにセットする
+	k.SetSellOrderBook(ctx, s)
+
+	//出品者に残額を返金する
+	return k.SafeMint(ctx, msg.Port, msg.ChannelID, creator, msg.AmountDenom, refund)
+}
+
+// cancelBatchBuyOrders removes the cancelled orders of a batch from the buy order book and refunds them
+func (k msgServer) cancelBatchBuyOrders(ctx sdk.Context, msg *types.MsgBatchOrders, pairIndex string, creator sdk.AccAddress) error {
+	if len(msg.CancelOrderIDs) == 0 {
+		return nil
+	}
+
+	b, found := k.GetBuyOrderBook(ctx, pairIndex)
+	if !found {
+		return errors.New("the pair doesn't exist")
+	}
+
+	var refund int64
+	for _, id := range msg.CancelOrderIDs {
+		order, err := b.Book.GetOrderFromID(id)
+		if err != nil {
+			return err
+		}
+		if order.Creator != msg.Creator {
+			return errors.New("canceller must be creator")
+		}
+		if err := b.Book.RemoveOrderFromID(id); err != nil {
+			return err
+		}
+		//注文のデポジットを返金する
+		if err := k.ReleaseOrderDeposit(ctx, types.OrderTypeBuy, pairIndex, id); err != nil {
+			return err
+		}
+		k.AfterOrderCancelled(ctx, types.OrderTypeBuy, pairIndex, order)
+		refund += int64(order.Amount) * int64(order.Price)
+	}
+
+	//ストアにセットする
+	k.SetBuyOrderBook(ctx, b)
+
+	//購入者に残額を返金する
+	return k.SafeMint(ctx, msg.Port, msg.ChannelID, creator, msg.PriceDenom, refund)
+}
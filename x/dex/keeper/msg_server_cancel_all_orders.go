@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"interchange/x/dex/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CancelAllOrders(goCtx context.Context, msg *types.MsgCancelAllOrders) (*types.MsgCancelAllOrdersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// サーキットブレーカーで停止されたメッセージは受け付けない
+	if err := k.CheckMsgEnabled(ctx, msg); err != nil {
+		return &types.MsgCancelAllOrdersResponse{}, err
+	}
+
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return &types.MsgCancelAllOrdersResponse{}, err
+	}
+
+	var cancelled uint32
+
+	//売り注文をキャンセルする
+	if msg.OrderType != types.OrderTypeBuy {
+		//オーダーブックはフルパスのdenomで作成されている
+		amountDenom := msg.AmountDenom
+		if amountDenom != "" {
+			amountDenom, err = k.FullDenomPath(ctx, msg.AmountDenom)
+			if err != nil {
+				return &types.MsgCancelAllOrdersResponse{}, err
+			}
+		}
+
+		for _, s := range k.GetSellOrderBooksByOwner(ctx, msg.Creator) {
+			if !matchOrderBook(s.Index, msg.Port, msg.Channel, amountDenom, msg.PriceDenom) {
+				continue
+			}
+			removed, refund := removeOrdersOfCreator(s.Book, msg.Creator, func(order types.Order) int64 {
+				return int64(order.Amount)
+			})
+			cancelled += uint32(len(removed))
+
+			//ストアにセットする
+			k.SetSellOrderBook(ctx, s)
+
+			//注文のデポジットを返金する
+			for _, order := range removed {
+				if err := k.ReleaseOrderDeposit(ctx, types.OrderTypeSell, s.Index, order.Id); err != nil {
+					return &types.MsgCancelAllOrdersResponse{}, err
+				}
+				k.AfterOrderCancelled(ctx, types.OrderTypeSell, s.Index, order)
+			}
+
+			//出品者に残額を返金する
+			if err := k.SafeMint(ctx, msg.Port, msg.Channel, creator, LocalDenom(s.AmountDenom), refund); err != nil {
+				return &types.MsgCancelAllOrdersResponse{}, err
+			}
+		}
+	}
+
+	//買い注文をキャンセルする
+	if msg.OrderType != types.OrderTypeSell {
+		//オーダーブックはフルパスのdenomで作成されている
+		priceDenom := msg.PriceDenom
+		if priceDenom != "" {
+			priceDenom, err = k.FullDenomPath(ctx, msg.PriceDenom)
+			if err != nil {
+				return &types.MsgCancelAllOrdersResponse{}, err
+			}
+		}
+
+		for _, b := range k.GetBuyOrderBooksByOwner(ctx, msg.Creator) {
+			if !matchOrderBook(b.Index, msg.Port, msg.Channel, msg.AmountDenom, priceDenom) {
+				continue
+			}
+			removed, refund := removeOrdersOfCreator(b.Book, msg.Creator, func(order types.Order) int64 {
+				return int64(order.Amount) * int64(order.Price)
+			})
+			cancelled += uint32(len(removed))
+
+			//ストアにセットする
+			k.SetBuyOrderBook(ctx, b)
+
+			//注文のデポジットを返金する
+			for _, order := range removed {
+				if err := k.ReleaseOrderDeposit(ctx, types.OrderTypeBuy, b.Index, order.Id); err != nil {
+					return &types.MsgCancelAllOrdersResponse{}, err
+				}
+				k.AfterOrderCancelled(ctx, types.OrderTypeBuy, b.Index, order)
+			}
+
+			//購入者に残額を返金する
+			if err := k.SafeMint(ctx, msg.Port, msg.Channel, creator, LocalDenom(b.PriceDenom), refund); err != nil {
+				return &types.MsgCancelAllOrdersResponse{}, err
+			}
+		}
+	}
+
+	return &types.MsgCancelAllOrdersResponse{CancelledOrders: cancelled}, nil
+}
+
+// matchOrderBook checks the order book is on the channel and, if the denoms are provided, is the book of the pair
+func matchOrderBook(index string, port string, channel string, amountDenom string, priceDenom string) bool {
+	if amountDenom != "" {
+		return index == types.OrderBookIndex(port, channel, amountDenom, priceDenom)
+	}
+	return strings.HasPrefix(index, types.OrderBookChannelPrefix(port, channel))
+}
+
+// removeOrdersOfCreator removes all the orders of the creator from the book and returns the removed orders
+// and their escrowed amount
+func removeOrdersOfCreator(book *types.OrderBook, creator string, escrow func(types.Order) int64) (removed []types.Order, refund int64) {
+	orders := book.Orders[:0]
+	for _, order := range book.Orders {
+		if order.Creator != creator {
+			orders = append(orders, order)
+			continue
+		}
+		refund += escrow(*order)
+		removed = append(removed, *order)
+	}
+	book.Orders = orders
+	return removed, refund
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/sample"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+func TestMsgServerCancelAllOrdersFilters(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := sample.AccAddress()
+
+	book := types.NewBuyOrderBook("stake", "token")
+	book.Index = types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	_, err := book.AppendOrder(creator, 10, 20, 0)
+	require.NoError(t, err)
+	k.SetBuyOrderBook(ctx, book)
+
+	// None of the filters match the buy order, nothing is cancelled
+	for _, msg := range []*types.MsgCancelAllOrders{
+		types.NewMsgCancelAllOrders(creator, "dex", "channel-1", "", "", ""),
+		types.NewMsgCancelAllOrders(creator, "dex", "channel-0", types.OrderTypeSell, "", ""),
+		types.NewMsgCancelAllOrders(creator, "dex", "channel-0", "", "stake", "other"),
+		types.NewMsgCancelAllOrders(sample.AccAddress(), "dex", "channel-0", "", "", ""),
+	} {
+		res, err := srv.CancelAllOrders(wctx, msg)
+		require.NoError(t, err)
+		require.Zero(t, res.CancelledOrders)
+	}
+	require.Len(t, k.GetBuyOrderBooksByOwner(ctx, creator), 1)
+}
+
+func TestMsgServerCancelAllOrders(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	srv := keeper.NewMsgServerImpl(*f.Keeper)
+	creator := sampleAccAddress(t)
+	other := sample.AccAddress()
+
+	sellIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	sellBook := types.NewSellOrderBook("stake", "token")
+	sellBook.Index = sellIndex
+	buyIndex := types.OrderBookIndex("dex", "channel-0", "coin", "token")
+	buyBook := types.NewBuyOrderBook("coin", "token")
+	buyBook.Index = buyIndex
+	var deposits []types.OrderDeposit
+	deposit := func(orderType string, index string, id int32) {
+		deposits = append(deposits, types.OrderDeposit{
+			OrderType: orderType,
+			Index:     index,
+			OrderID:   id,
+			Owner:     creator.String(),
+			Port:      "dex",
+			Channel:   "channel-0",
+			Deposit:   sdk.NewInt64Coin("deposit", 10),
+		})
+	}
+	for _, order := range []types.Order{
+		{Creator: creator.String(), Amount: 10, Price: 5},
+		{Creator: other, Amount: 15, Price: 6},
+		{Creator: creator.String(), Amount: 20, Price: 7},
+	} {
+		id, err := sellBook.AppendOrder(order.Creator, order.Amount, order.Price, 0)
+		require.NoError(t, err)
+		if order.Creator == creator.String() {
+			deposit(types.OrderTypeSell, sellIndex, id)
+		}
+	}
+	id, err := buyBook.AppendOrder(creator.String(), 5, 4, 0)
+	require.NoError(t, err)
+	deposit(types.OrderTypeBuy, buyIndex, id)
+	f.Keeper.SetSellOrderBook(f.Ctx, sellBook)
+	f.Keeper.SetBuyOrderBook(f.Ctx, buyBook)
+	for _, orderDeposit := range deposits {
+		f.Keeper.SetOrderDeposit(f.Ctx, orderDeposit)
+	}
+	require.NoError(t, f.BankKeeper.MintCoins(f.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("deposit", 30))))
+	f.FundEscrow("dex", "channel-0", sdk.NewInt64Coin("stake", 45), sdk.NewInt64Coin("token", 20))
+
+	// The orders of the creator on both sides are removed, their escrow and deposit are refunded
+	res, err := srv.CancelAllOrders(sdk.WrapSDKContext(f.Ctx), types.NewMsgCancelAllOrders(creator.String(), "dex", "channel-0", "", "", ""))
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), res.CancelledOrders)
+	f.RequireBalance(creator, "stake", 30)
+	f.RequireBalance(creator, "token", 20)
+	f.RequireBalance(creator, "deposit", 30)
+	f.RequireEscrow("dex", "channel-0", "stake", 15)
+	f.RequireEscrow("dex", "channel-0", "token", 0)
+	for _, orderDeposit := range deposits {
+		_, found := f.Keeper.GetOrderDeposit(f.Ctx, orderDeposit.OrderType, orderDeposit.Index, orderDeposit.OrderID)
+		require.False(t, found)
+	}
+
+	// The orders of the other makers keep resting, the owner index of the creator is emptied
+	book, found := f.Keeper.GetSellOrderBook(f.Ctx, sellIndex)
+	require.True(t, found)
+	require.Len(t, book.Book.Orders, 1)
+	require.Equal(t, other, book.Book.Orders[0].Creator)
+	require.Empty(t, f.Keeper.GetSellOrderBooksByOwner(f.Ctx, creator.String()))
+	require.Empty(t, f.Keeper.GetBuyOrderBooksByOwner(f.Ctx, creator.String()))
+	require.Len(t, f.Keeper.GetSellOrderBooksByOwner(f.Ctx, other), 1)
+}
+
+func TestOrderBookChannelPrefix(t *testing.T) {
+	prefix := types.OrderBookChannelPrefix("dex", "channel-1")
+	require.Contains(t, types.OrderBookIndex("dex", "channel-1", "stake", "token"), prefix)
+	require.NotContains(t, types.OrderBookIndex("dex", "channel-10", "stake", "token"), prefix)
+}
+
+func sampleAccAddress(t *testing.T) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, err)
+	return addr
+}
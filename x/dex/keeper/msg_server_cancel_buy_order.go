@@ -12,8 +12,19 @@ import (
 func (k msgServer) CancelBuyOrder(goCtx context.Context, msg *types.MsgCancelBuyOrder) (*types.MsgCancelBuyOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// サーキットブレーカーで停止されたメッセージは受け付けない
+	if err := k.CheckMsgEnabled(ctx, msg); err != nil {
+		return &types.MsgCancelBuyOrderResponse{}, err
+	}
+
+	//オーダーブックはフルパスのdenomで作成されている
+	priceDenom, err := k.FullDenomPath(ctx, msg.PriceDenom)
+	if err != nil {
+		return &types.MsgCancelBuyOrderResponse{}, err
+	}
+
 	//指定されたdenomペアのオーダーブックが存在することを確認
-	pairIndex := types.OrderBookIndex(msg.Port, msg.Channel, msg.AmountDenom, msg.PriceDenom)
+	pairIndex := types.OrderBookIndex(msg.Port, msg.Channel, msg.AmountDenom, priceDenom)
 	//特定の買い注文表を取得する
 	b, found := k.GetBuyOrderBook(ctx, pairIndex)
 	if !found {
@@ -37,6 +48,12 @@ func (k msgServer) CancelBuyOrder(goCtx context.Context, msg *types.MsgCancelBuy
 
 	//ストアにセットする
 	k.SetBuyOrderBook(ctx, b)
+	k.AfterOrderCancelled(ctx, types.OrderTypeBuy, pairIndex, order)
+
+	//注文のデポジットを返金する
+	if err := k.ReleaseOrderDeposit(ctx, types.OrderTypeBuy, pairIndex, msg.OrderID); err != nil {
+		return &types.MsgCancelBuyOrderResponse{}, err
+	}
 
 	//購入者に残額を返金する
 	buyer, err := sdk.AccAddressFromBech32(order.Creator)
@@ -47,8 +64,8 @@ func (k msgServer) CancelBuyOrder(goCtx context.Context, msg *types.MsgCancelBuy
 		ctx, msg.Port,
 		msg.Channel,
 		buyer,
-		msg.AmountDenom,
-		order.Amount*order.Price,
+		msg.PriceDenom,
+		int64(order.Amount)*int64(order.Price),
 	); err != nil {
 		return &types.MsgCancelBuyOrderResponse{}, err
 	}
@@ -12,8 +12,19 @@ import (
 func (k msgServer) CancelSellOrder(goCtx context.Context, msg *types.MsgCancelSellOrder) (*types.MsgCancelSellOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// サーキットブレーカーで停止されたメッセージは受け付けない
+	if err := k.CheckMsgEnabled(ctx, msg); err != nil {
+		return &types.MsgCancelSellOrderResponse{}, err
+	}
+
+	//オーダーブックはフルパスのdenomで作成されている
+	amountDenom, err := k.FullDenomPath(ctx, msg.AmountDenom)
+	if err != nil {
+		return &types.MsgCancelSellOrderResponse{}, err
+	}
+
 	//指定されたdenomペアのオーダーブックが存在することを確認
-	pairIndex := types.OrderBookIndex(msg.Port, msg.Channel, msg.AmountDenom, msg.PriceDenom)
+	pairIndex := types.OrderBookIndex(msg.Port, msg.Channel, amountDenom, msg.PriceDenom)
 	//特定の売り注文表を取得する
 	s, found := k.GetSellOrderBook(ctx, pairIndex)
 	if !found {
@@ -37,6 +48,12 @@ func (k msgServer) CancelSellOrder(goCtx context.Context, msg *types.MsgCancelSe
 
 	//ストアにセットする
 	k.SetSellOrderBook(ctx, s)
+	k.AfterOrderCancelled(ctx, types.OrderTypeSell, pairIndex, order)
+
+	//注文のデポジットを返金する
+	if err := k.ReleaseOrderDeposit(ctx, types.OrderTypeSell, pairIndex, msg.OrderID); err != nil {
+		return &types.MsgCancelSellOrderResponse{}, err
+	}
 
 	//出品者に残額を返金する
 	seller, err := sdk.AccAddressFromBech32(order.Creator)
@@ -48,7 +65,7 @@ func (k msgServer) CancelSellOrder(goCtx context.Context, msg *types.MsgCancelSe
 		msg.Channel,
 		seller,
 		msg.AmountDenom,
-		order.Amount,
+		int64(order.Amount),
 	); err != nil {
 		return &types.MsgCancelSellOrderResponse{}, err
 	}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"interchange/x/dex/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CancelTriggerOrder(goCtx context.Context, msg *types.MsgCancelTriggerOrder) (*types.MsgCancelTriggerOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// サーキットブレーカーで停止されたメッセージは受け付けない
+	if err := k.CheckMsgEnabled(ctx, msg); err != nil {
+		return &types.MsgCancelTriggerOrderResponse{}, err
+	}
+
+	order, found := k.GetTriggerOrder(ctx, msg.Id)
+	if !found {
+		return &types.MsgCancelTriggerOrderResponse{}, types.ErrOrderNotFound
+	}
+
+	if order.Creator != msg.Creator {
+		return &types.MsgCancelTriggerOrderResponse{}, errors.New("canceller must be creator")
+	}
+
+	k.RemoveTriggerOrder(ctx, msg.Id)
+
+	//作成者にエスクローを返金する
+	if err := k.refundTriggerOrder(ctx, order); err != nil {
+		return &types.MsgCancelTriggerOrderResponse{}, err
+	}
+
+	return &types.MsgCancelTriggerOrderResponse{}, nil
+}
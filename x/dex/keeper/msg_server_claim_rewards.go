@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"interchange/x/dex/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// サーキットブレーカーで停止されたメッセージは受け付けない
+	if err := k.CheckMsgEnabled(ctx, msg); err != nil {
+		return &types.MsgClaimRewardsResponse{}, err
+	}
+
+	maker, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return &types.MsgClaimRewardsResponse{}, err
+	}
+
+	//蓄積された報酬をメーカーに支払う
+	rewards, err := k.Keeper.ClaimRewards(ctx, maker)
+	if err != nil {
+		return &types.MsgClaimRewardsResponse{}, err
+	}
+
+	return &types.MsgClaimRewardsResponse{Rewards: rewards}, nil
+}
@@ -13,24 +13,71 @@ import (
 func (k msgServer) SendCreatePair(goCtx context.Context, msg *types.MsgSendCreatePair) (*types.MsgSendCreatePairResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// サーキットブレーカーで停止されたメッセージは受け付けない
+	if err := k.CheckMsgEnabled(ctx, msg); err != nil {
+		return &types.MsgSendCreatePairResponse{}, err
+	}
+
+	// IBC vouchers are sent with their full denom path
+	sourceDenom, err := k.FullDenomPath(ctx, msg.SourceDenom)
+	if err != nil {
+		return &types.MsgSendCreatePairResponse{}, err
+	}
+
 	// Get an order book index
-	pairIndex := types.OrderBookIndex(msg.Port, msg.ChannelID, msg.SourceDenom, msg.TargetDenom)
+	pairIndex := types.OrderBookIndex(msg.Port, msg.ChannelID, sourceDenom, msg.TargetDenom)
 
 	_, found := k.GetSellOrderBook(ctx, pairIndex)
 	if found {
 		return &types.MsgSendCreatePairResponse{}, errors.New("the pair already exist")
 	}
 
+	// Check governance allowed the pair when pair creation is restricted
+	if !k.IsPairCreationAllowed(ctx, pairIndex) {
+		return &types.MsgSendCreatePairResponse{}, errors.New("the pair creation is not allowed")
+	}
+
+	// The source denom must exist on this chain, its metadata is sent for display
+	sourceMetadata, err := k.GetSourceDenomMetadata(ctx, msg.SourceDenom)
+	if err != nil {
+		return &types.MsgSendCreatePairResponse{}, err
+	}
+
+	// Check the pair creation policy, the deposit is taken from the params
+	var deposit *sdk.Coin
+	policy := k.PairCreationPolicy(ctx)
+	if policy.Type == types.PairCreationDeposit {
+		deposit = policy.Deposit
+	}
+	if err := k.CheckPairCreationPolicy(ctx, msg.Creator, sourceDenom, msg.TargetDenom, deposit); err != nil {
+		return &types.MsgSendCreatePairResponse{}, err
+	}
+
+	// Escrow the deposit until the pair creation is acknowledged
+	if deposit != nil {
+		creator, err := sdk.AccAddressFromBech32(msg.Creator)
+		if err != nil {
+			return &types.MsgSendCreatePairResponse{}, err
+		}
+		if err := k.EscrowPairCreationDeposit(ctx, creator, *deposit); err != nil {
+			return &types.MsgSendCreatePairResponse{}, err
+		}
+	}
+
 	// Construct the packet
 	var packet types.CreatePairPacketData
 
-	packet.SourceDenom = msg.SourceDenom
+	packet.SourceDenom = sourceDenom
+	// The target denom is resolved to its full denom path on the target chain and returned in the acknowledgement
 	packet.TargetDenom = msg.TargetDenom
+	packet.Creator = msg.Creator
+	packet.Deposit = deposit
+	packet.SourceMetadata = &sourceMetadata
 
 	// Transmit the packet
-	err := k.TransmitCreatePairPacket(
+	_, err = k.TransmitPacket(
 		ctx,
-		packet,
+		&packet,
 		msg.Port,
 		msg.ChannelID,
 		clienttypes.ZeroHeight(),
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"interchange/x/dex/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) FundIncentivePool(goCtx context.Context, msg *types.MsgFundIncentivePool) (*types.MsgFundIncentivePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// サーキットブレーカーで停止されたメッセージは受け付けない
+	if err := k.CheckMsgEnabled(ctx, msg); err != nil {
+		return &types.MsgFundIncentivePoolResponse{}, err
+	}
+
+	sponsor, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return &types.MsgFundIncentivePoolResponse{}, err
+	}
+
+	//スポンサーのコインをペアのインセンティブプールに移す
+	if err := k.Keeper.FundIncentivePool(ctx, sponsor, msg.PairIndex, msg.Amount); err != nil {
+		return &types.MsgFundIncentivePoolResponse{}, err
+	}
+
+	return &types.MsgFundIncentivePoolResponse{}, nil
+}
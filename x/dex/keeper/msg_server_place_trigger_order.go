@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"interchange/x/dex/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) PlaceTriggerOrder(goCtx context.Context, msg *types.MsgPlaceTriggerOrder) (*types.MsgPlaceTriggerOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// サーキットブレーカーで停止されたメッセージは受け付けない
+	if err := k.CheckMsgEnabled(ctx, msg); err != nil {
+		return &types.MsgPlaceTriggerOrderResponse{}, err
+	}
+
+	order := msg.TriggerOrder()
+
+	//指定されたdenomペアのオーダーブックが存在することを確認します。
+	var found bool
+	voucherDenom := msg.PriceDenom
+	if msg.OrderType == types.OrderTypeSell {
+		//オーダーブックはフルパスのdenomで作成されている
+		amountDenom, err := k.FullDenomPath(ctx, msg.AmountDenom)
+		if err != nil {
+			return &types.MsgPlaceTriggerOrderResponse{}, err
+		}
+		order.PairIndex = types.OrderBookIndex(msg.Port, msg.ChannelID, amountDenom, msg.PriceDenom)
+		_, found = k.GetSellOrderBook(ctx, order.PairIndex)
+		voucherDenom = amountDenom
+	} else {
+		//オーダーブックはフルパスのdenomで作成されている
+		priceDenom, err := k.FullDenomPath(ctx, msg.PriceDenom)
+		if err != nil {
+			return &types.MsgPlaceTriggerOrderResponse{}, err
+		}
+		order.PairIndex = types.OrderBookIndex(msg.Port, msg.ChannelID, msg.AmountDenom, priceDenom)
+		_, found = k.GetBuyOrderBook(ctx, order.PairIndex)
+		voucherDenom = priceDenom
+	}
+	//存在しなかった場合
+	if !found {
+		return &types.MsgPlaceTriggerOrderResponse{}, errors.New("the pair doesn't exist")
+	}
+	//ガバナンスで一時停止されている場合
+	if k.IsPairPaused(ctx, order.PairIndex) {
+		return &types.MsgPlaceTriggerOrderResponse{}, errors.New("the pair is paused")
+	}
+
+	//作成者ごとの未発動の注文数を制限する
+	if k.GetCreatorTriggerOrderCount(ctx, msg.Creator) >= types.MaxTriggerOrdersPerCreator {
+		return &types.MsgPlaceTriggerOrderResponse{}, sdkerrors.Wrapf(types.ErrTooManyTriggerOrders, "the creator has %d open trigger orders", types.MaxTriggerOrdersPerCreator)
+	}
+
+	//注文が発動されるまでトークンをエスクローする
+	if err := k.escrowTriggerOrder(ctx, order); err != nil {
+		return &types.MsgPlaceTriggerOrderResponse{}, err
+	}
+
+	//ターゲットチェーンで受け取ったバウチャーを保存(後で元に戻すことができるようにする)
+	if err := k.SaveVoucherDenom(ctx, msg.Port, msg.ChannelID, voucherDenom); err != nil {
+		return &types.MsgPlaceTriggerOrderResponse{}, err
+	}
+
+	id := k.AppendTriggerOrder(ctx, order)
+
+	return &types.MsgPlaceTriggerOrderResponse{Id: id}, nil
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"interchange/x/dex/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLiquidity) (*types.MsgRemoveLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// サーキットブレーカーで停止されたメッセージは受け付けない
+	if err := k.CheckMsgEnabled(ctx, msg); err != nil {
+		return &types.MsgRemoveLiquidityResponse{}, err
+	}
+
+	provider, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return &types.MsgRemoveLiquidityResponse{}, err
+	}
+
+	//オーダーブックはフルパスのdenomで作成されている
+	amountDenom, err := k.FullDenomPath(ctx, msg.AmountDenom)
+	if err != nil {
+		return &types.MsgRemoveLiquidityResponse{}, err
+	}
+	pairIndex := types.OrderBookIndex(msg.Port, msg.Channel, amountDenom, msg.PriceDenom)
+
+	//シェアトークンを燃焼し、準備金を引き出す
+	withdrawn, err := k.Keeper.RemoveLiquidity(ctx, provider, pairIndex, msg.Shares)
+	if err != nil {
+		return &types.MsgRemoveLiquidityResponse{}, err
+	}
+
+	return &types.MsgRemoveLiquidityResponse{Withdrawn: withdrawn}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"interchange/x/dex/types"
+)
+
+func (k msgServer) RoutedOrder(goCtx context.Context, msg *types.MsgRoutedOrder) (*types.MsgRoutedOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// サーキットブレーカーで停止されたメッセージは受け付けない
+	if err := k.CheckMsgEnabled(ctx, msg); err != nil {
+		return &types.MsgRoutedOrderResponse{}, err
+	}
+
+	//各区間で受け取るトークンが次の区間で送るトークンであることを確認する
+	if err := k.checkRouteLegs(ctx, msg.Legs); err != nil {
+		return &types.MsgRoutedOrderResponse{}, err
+	}
+
+	//最初の区間を送信し、次の区間は確認応答を受け取ってから送信する
+	if err := k.sendRouteLeg(ctx, types.RoutedOrder{
+		Creator:       msg.Creator,
+		Legs:          msg.Legs,
+		PacketTimeout: msg.PacketTimeout,
+	}, msg.Amount); err != nil {
+		return &types.MsgRoutedOrderResponse{}, err
+	}
+
+	return &types.MsgRoutedOrderResponse{}, nil
+}
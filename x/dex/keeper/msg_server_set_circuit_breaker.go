@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"interchange/x/dex/types"
+)
+
+func (k msgServer) SetCircuitBreaker(goCtx context.Context, msg *types.MsgSetCircuitBreaker) (*types.MsgSetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// ガバナンス以外ではパラメータで指定されたアドレスのみが操作できる
+	authority := k.CircuitBreakerAuthority(ctx)
+	if authority == "" || msg.Creator != authority {
+		return &types.MsgSetCircuitBreakerResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the circuit breaker authority", msg.Creator)
+	}
+
+	if err := k.Keeper.SetCircuitBreaker(ctx, msg.MsgTypes, msg.PacketTypes, msg.Tripped); err != nil {
+		return &types.MsgSetCircuitBreakerResponse{}, err
+	}
+
+	return &types.MsgSetCircuitBreakerResponse{}, nil
+}
@@ -0,0 +1,293 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	"interchange/x/dex/types"
+)
+
+// SetOrderDeposit set a specific orderDeposit in the store from its index
+func (k Keeper) SetOrderDeposit(ctx sdk.Context, orderDeposit types.OrderDeposit) {
+	// drop the expiry of the record being replaced
+	k.RemoveOrderDeposit(ctx, orderDeposit.OrderType, orderDeposit.Index, orderDeposit.OrderID)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderDepositKeyPrefix))
+	key := types.OrderDepositKey(
+		orderDeposit.OrderType,
+		orderDeposit.Index,
+		orderDeposit.OrderID,
+	)
+	b := k.cdc.MustMarshal(&orderDeposit)
+	store.Set(key, b)
+
+	// queue the deposit by expiration
+	if !orderDeposit.Expiration.IsZero() {
+		expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderDepositExpiryKeyPrefix))
+		expiryStore.Set(types.OrderDepositExpiryKey(
+			orderDeposit.Expiration,
+			orderDeposit.OrderType,
+			orderDeposit.Index,
+			orderDeposit.OrderID,
+		), key)
+	}
+}
+
+// GetOrderDeposit returns an orderDeposit from its index
+func (k Keeper) GetOrderDeposit(
+	ctx sdk.Context,
+	orderType string,
+	index string,
+	orderID int32,
+
+) (val types.OrderDeposit, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderDepositKeyPrefix))
+
+	b := store.Get(types.OrderDepositKey(
+		orderType,
+		index,
+		orderID,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveOrderDeposit removes an orderDeposit from the store
+func (k Keeper) RemoveOrderDeposit(
+	ctx sdk.Context,
+	orderType string,
+	index string,
+	orderID int32,
+
+) {
+	orderDeposit, found := k.GetOrderDeposit(ctx, orderType, index, orderID)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderDepositKeyPrefix))
+	store.Delete(types.OrderDepositKey(
+		orderType,
+		index,
+		orderID,
+	))
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderDepositExpiryKeyPrefix))
+	expiryStore.Delete(types.OrderDepositExpiryKey(
+		orderDeposit.Expiration,
+		orderType,
+		index,
+		orderID,
+	))
+}
+
+// GetAllOrderDeposit returns all orderDeposit
+func (k Keeper) GetAllOrderDeposit(ctx sdk.Context) (list []types.OrderDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderDepositKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.OrderDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetExpiredOrderDeposits returns at most limit orderDeposit expired at the block time, in expiration order,
+// a negative limit reads all of them
+func (k Keeper) GetExpiredOrderDeposits(ctx sdk.Context, limit int) (list []types.OrderDeposit) {
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderDepositExpiryKeyPrefix))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderDepositKeyPrefix))
+	iterator := expiryStore.Iterator(nil, sdk.PrefixEndBytes(types.OrderDepositExpiryTimeKey(ctx.BlockTime())))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && limit != 0; iterator.Next() {
+		var val types.OrderDeposit
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &val)
+		list = append(list, val)
+		limit--
+	}
+
+	return
+}
+
+// escrowOrderDeposit sends the deposit of the orders to the module account and returns the deposit of an order,
+// nil if the orders don't require a deposit
+func (k Keeper) escrowOrderDeposit(ctx sdk.Context, owner string, count int) (*sdk.Coin, error) {
+	policy := k.OrderDepositPolicy(ctx)
+	if !policy.IsEnabled() || count == 0 {
+		return nil, nil
+	}
+	addr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return nil, err
+	}
+	deposit := *policy.Deposit
+	total := sdk.NewCoin(deposit.Denom, deposit.Amount.MulRaw(int64(count)))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, sdk.NewCoins(total)); err != nil {
+		return nil, err
+	}
+	return &deposit, nil
+}
+
+// refundOrderDeposit returns the deposit of orders that don't rest in an order book
+func (k Keeper) refundOrderDeposit(ctx sdk.Context, owner string, deposit *sdk.Coin, count int) error {
+	if deposit == nil || count == 0 {
+		return nil
+	}
+	addr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return err
+	}
+	total := sdk.NewCoin(deposit.Denom, deposit.Amount.MulRaw(int64(count)))
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.NewCoins(total))
+}
+
+// lockOrderDeposit attaches the deposit paid when the order was sent to the order resting in the book
+func (k Keeper) lockOrderDeposit(ctx sdk.Context, packet channeltypes.Packet, orderType string, index string, orderID int32, owner string, deposit *sdk.Coin) {
+	if deposit == nil {
+		return
+	}
+	orderDeposit := types.OrderDeposit{
+		OrderType: orderType,
+		Index:     index,
+		OrderID:   orderID,
+		Owner:     owner,
+		Port:      packet.SourcePort,
+		Channel:   packet.SourceChannel,
+		Deposit:   *deposit,
+	}
+	//有効期限が設定されている場合、注文は期限切れで削除される
+	if lifetime := k.OrderDepositPolicy(ctx).Lifetime; lifetime > 0 {
+		orderDeposit.Expiration = ctx.BlockTime().Add(lifetime)
+	}
+	k.SetOrderDeposit(ctx, orderDeposit)
+}
+
+// ReleaseOrderDeposit returns the deposit of a resting order filled or cancelled, if any
+func (k Keeper) ReleaseOrderDeposit(ctx sdk.Context, orderType string, index string, orderID int32) error {
+	orderDeposit, found := k.GetOrderDeposit(ctx, orderType, index, orderID)
+	if !found {
+		return nil
+	}
+	k.RemoveOrderDeposit(ctx, orderType, index, orderID)
+	return k.refundOrderDeposit(ctx, orderDeposit.Owner, &orderDeposit.Deposit, 1)
+}
+
+// releaseClosedOrderDeposits returns the deposit of the orders removed from the book by the matching
+func (k Keeper) releaseClosedOrderDeposits(ctx sdk.Context, orderType string, index string, book *types.OrderBook, orders []types.Order) error {
+	for _, order := range orders {
+		//一部約定の注文は板に残る
+		if _, err := book.GetOrderFromID(order.Id); err == nil {
+			continue
+		}
+		if err := k.ReleaseOrderDeposit(ctx, orderType, index, order.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExpireOrders removes the resting orders whose deposit has expired, their tokens are refunded
+// and their deposit is partly burned. It is called at the end of every block and expires at most
+// MaxOrderExpirations orders, the orders that cannot be expired keep resting without expiration.
+func (k Keeper) ExpireOrders(ctx sdk.Context) {
+	for _, orderDeposit := range k.GetExpiredOrderDeposits(ctx, types.MaxOrderExpirations) {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.expireOrder(cacheCtx, orderDeposit); err != nil {
+			k.Logger(ctx).Error("cannot expire order",
+				"type", orderDeposit.OrderType,
+				"index", orderDeposit.Index,
+				"id", orderDeposit.OrderID,
+				"error", err,
+			)
+
+			//失敗した注文は有効期限を外して板に残し、約定または取り消しでデポジットを返金する
+			orderDeposit.Expiration = time.Time{}
+			k.SetOrderDeposit(ctx, orderDeposit)
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderExpiryFailed{
+				OrderType: orderDeposit.OrderType,
+				Index:     orderDeposit.Index,
+				OrderID:   orderDeposit.OrderID,
+				Owner:     orderDeposit.Owner,
+				Reason:    err.Error(),
+			}); err != nil {
+				k.Logger(ctx).Error("cannot emit the event of a failed order expiry", "error", err)
+			}
+			continue
+		}
+		write()
+	}
+}
+
+// expireOrder removes an expired order from its book, refunds its tokens and slashes its deposit
+func (k Keeper) expireOrder(ctx sdk.Context, orderDeposit types.OrderDeposit) error {
+	k.RemoveOrderDeposit(ctx, orderDeposit.OrderType, orderDeposit.Index, orderDeposit.OrderID)
+
+	owner, err := sdk.AccAddressFromBech32(orderDeposit.Owner)
+	if err != nil {
+		return err
+	}
+
+	//注文をオーダーブックから削除し、エスクローを返金する
+	var denom string
+	var escrow int64
+	switch orderDeposit.OrderType {
+	case types.OrderTypeSell:
+		book, found := k.GetSellOrderBook(ctx, orderDeposit.Index)
+		if found {
+			order, err := book.Book.GetOrderFromID(orderDeposit.OrderID)
+			if err == nil {
+				if err := book.Book.RemoveOrderFromID(order.Id); err != nil {
+					return err
+				}
+				k.SetSellOrderBook(ctx, book)
+				k.AfterOrderCancelled(ctx, types.OrderTypeSell, book.Index, order)
+				denom, escrow = LocalDenom(book.AmountDenom), int64(order.Amount)
+			}
+		}
+	case types.OrderTypeBuy:
+		book, found := k.GetBuyOrderBook(ctx, orderDeposit.Index)
+		if found {
+			order, err := book.Book.GetOrderFromID(orderDeposit.OrderID)
+			if err == nil {
+				if err := book.Book.RemoveOrderFromID(order.Id); err != nil {
+					return err
+				}
+				k.SetBuyOrderBook(ctx, book)
+				k.AfterOrderCancelled(ctx, types.OrderTypeBuy, book.Index, order)
+				denom, escrow = LocalDenom(book.PriceDenom), int64(order.Amount)*int64(order.Price)
+			}
+		}
+	}
+	if escrow > 0 {
+		if err := k.SafeMint(ctx, orderDeposit.Port, orderDeposit.Channel, owner, denom, escrow); err != nil {
+			return err
+		}
+	}
+
+	//デポジットの一部を焼却し、残りを返金する
+	slashed, refund := k.OrderDepositPolicy(ctx).Slash(orderDeposit.Deposit)
+	if slashed.IsPositive() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(slashed)); err != nil {
+			return err
+		}
+	}
+	if refund.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(refund)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
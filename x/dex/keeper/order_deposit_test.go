@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/nullify"
+	"interchange/testutil/sample"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+func createNOrderDeposit(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.OrderDeposit {
+	items := make([]types.OrderDeposit, n)
+	for i := range items {
+		items[i].OrderType = types.OrderTypeSell
+		items[i].Index = strconv.Itoa(i)
+		items[i].OrderID = int32(i)
+		items[i].Owner = sample.AccAddress()
+		items[i].Deposit = sdk.NewInt64Coin("stake", 10)
+		items[i].Expiration = time.Unix(int64(1000+i), 0).UTC()
+
+		keeper.SetOrderDeposit(ctx, items[i])
+	}
+	return items
+}
+
+func TestOrderDepositGet(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNOrderDeposit(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetOrderDeposit(ctx,
+			item.OrderType,
+			item.Index,
+			item.OrderID,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestOrderDepositRemove(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNOrderDeposit(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveOrderDeposit(ctx,
+			item.OrderType,
+			item.Index,
+			item.OrderID,
+		)
+		_, found := keeper.GetOrderDeposit(ctx,
+			item.OrderType,
+			item.Index,
+			item.OrderID,
+		)
+		require.False(t, found)
+	}
+
+	// The removed deposits leave the expiry queue
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	require.Empty(t, keeper.GetExpiredOrderDeposits(ctx, -1))
+}
+
+func TestOrderDepositGetAll(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNOrderDeposit(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllOrderDeposit(ctx)),
+	)
+}
+
+func TestOrderDepositGetExpired(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNOrderDeposit(keeper, ctx, 10)
+
+	// Deposits without expiration are never expired
+	keeper.SetOrderDeposit(ctx, types.OrderDeposit{
+		OrderType: types.OrderTypeBuy,
+		Index:     "0",
+		Owner:     sample.AccAddress(),
+		Deposit:   sdk.NewInt64Coin("stake", 10),
+	})
+
+	ctx = ctx.WithBlockTime(time.Unix(999, 0))
+	require.Empty(t, keeper.GetExpiredOrderDeposits(ctx, -1))
+
+	// Deposits expire at their expiration included, in expiration order
+	ctx = ctx.WithBlockTime(time.Unix(1004, 0))
+	require.Equal(t,
+		nullify.Fill(items[:5]),
+		nullify.Fill(keeper.GetExpiredOrderDeposits(ctx, -1)),
+	)
+
+	// Replacing a deposit moves it in the queue
+	items[0].Expiration = time.Unix(2000, 0).UTC()
+	keeper.SetOrderDeposit(ctx, items[0])
+	require.Equal(t,
+		nullify.Fill(items[1:5]),
+		nullify.Fill(keeper.GetExpiredOrderDeposits(ctx, -1)),
+	)
+
+	// The number of deposits read is bounded
+	require.Equal(t,
+		nullify.Fill(items[1:3]),
+		nullify.Fill(keeper.GetExpiredOrderDeposits(ctx, 2)),
+	)
+}
+
+func TestExpireOrders(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	params := types.DefaultParams()
+	params.OrderDepositPolicy = types.OrderDepositPolicy{
+		Deposit:       &sdk.Coin{Denom: "deposit", Amount: sdk.NewInt(10)},
+		Lifetime:      time.Hour,
+		SlashFraction: sdk.NewDecWithPrec(5, 1),
+	}
+	f.Keeper.SetParams(f.Ctx, params)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	alice := sampleAccAddress(t)
+	bob := sampleAccAddress(t)
+
+	book := types.NewSellOrderBook("stake", "token")
+	book.Index = pairIndex
+	for _, owner := range []sdk.AccAddress{alice, bob} {
+		id, err := book.AppendOrder(owner.String(), 10, 5, 0)
+		require.NoError(t, err)
+		f.Keeper.SetOrderDeposit(f.Ctx, types.OrderDeposit{
+			OrderType:  types.OrderTypeSell,
+			Index:      pairIndex,
+			OrderID:    id,
+			Owner:      owner.String(),
+			Port:       "dex",
+			Channel:    "channel-0",
+			Deposit:    sdk.NewInt64Coin("deposit", 10),
+			Expiration: time.Unix(1000, 0).UTC(),
+		})
+	}
+	f.Keeper.SetSellOrderBook(f.Ctx, book)
+	require.NoError(t, f.BankKeeper.MintCoins(f.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("deposit", 20))))
+
+	// Only the escrow of the first order is there, the second order cannot be refunded
+	f.FundEscrow("dex", "channel-0", sdk.NewInt64Coin("stake", 10))
+	ctx := f.Ctx.WithBlockTime(time.Unix(1000, 0))
+	f.Keeper.ExpireOrders(ctx)
+
+	// The expired order is refunded and half of its deposit is burned
+	f.RequireBalance(alice, "stake", 10)
+	f.RequireBalance(alice, "deposit", 5)
+	f.RequireSupply("deposit", 15)
+
+	// The order that cannot be expired keeps resting without expiration
+	book, _ = f.Keeper.GetSellOrderBook(f.Ctx, pairIndex)
+	require.Len(t, book.Book.Orders, 1)
+	require.Equal(t, bob.String(), book.Book.Orders[0].Creator)
+	deposit, found := f.Keeper.GetOrderDeposit(f.Ctx, types.OrderTypeSell, pairIndex, book.Book.Orders[0].Id)
+	require.True(t, found)
+	require.True(t, deposit.Expiration.IsZero())
+	require.Empty(t, f.Keeper.GetExpiredOrderDeposits(ctx, -1))
+	f.RequireBalance(bob, "stake", 0)
+
+	var failed int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(&types.EventOrderExpiryFailed{}) {
+			failed++
+		}
+	}
+	require.Equal(t, 1, failed)
+}
+
+func TestReleaseOrderDepositNotFound(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+
+	// Orders placed without deposit have nothing to release
+	require.NoError(t, keeper.ReleaseOrderDeposit(ctx, types.OrderTypeSell, "0", 0))
+	keeper.ExpireOrders(ctx)
+}
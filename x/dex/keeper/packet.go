@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v2/modules/core/24-host"
+
+	"interchange/x/dex/types"
+)
+
+// PacketHandler defines the callbacks a packet type registers with the keeper.
+// The module dispatches every received, acknowledged or timed out packet to the
+// handler registered for its type.
+type PacketHandler struct {
+	// OnTransmit is called after the packet has been sent, it is optional
+	OnTransmit func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData) error
+	// OnRecv processes the packet on the target chain and returns the packet acknowledgment
+	OnRecv func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData) (proto.Message, error)
+	// OnAcknowledgement processes the acknowledgment on the source chain
+	OnAcknowledgement func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData, ack channeltypes.Acknowledgement) error
+	// OnTimeout processes the packet timeout on the source chain
+	OnTimeout func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData) error
+}
+
+// registerPacketHandlers registers the handlers of the packet types supported by the module
+func (k Keeper) registerPacketHandlers() {
+	k.RegisterPacketHandler(types.EventTypeCreatePairPacket, createPairPacketHandler)
+	k.RegisterPacketHandler(types.EventTypeSellOrderPacket, sellOrderPacketHandler)
+	k.RegisterPacketHandler(types.EventTypeBuyOrderPacket, buyOrderPacketHandler)
+	k.RegisterPacketHandler(types.EventTypeBatchOrderPacket, batchOrderPacketHandler)
+	// this line is used by starport scaffolding # ibc/packet/keeper/register
+}
+
+// RegisterPacketHandler registers the handler of a packet type, it panics if the type is already registered
+func (k Keeper) RegisterPacketHandler(packetType string, handler PacketHandler) {
+	if _, found := k.packetHandlers[packetType]; found {
+		panic(fmt.Sprintf("packet handler for %s already registered", packetType))
+	}
+	if handler.OnRecv == nil || handler.OnAcknowledgement == nil || handler.OnTimeout == nil {
+		panic(fmt.Sprintf("packet handler for %s must define recv, acknowledgement and timeout callbacks", packetType))
+	}
+	k.packetHandlers[packetType] = handler
+}
+
+// GetPacketHandler returns the handler registered for a packet type
+func (k Keeper) GetPacketHandler(packetType string) (PacketHandler, bool) {
+	handler, found := k.packetHandlers[packetType]
+	return handler, found
+}
+
+// ResolvePacket decodes the data of a received packet and returns it with the handler registered for its type
+func (k Keeper) ResolvePacket(bz []byte) (types.PacketData, PacketHandler, error) {
+	packetData, err := types.UnmarshalPacketData(bz)
+	if err != nil {
+		return nil, PacketHandler{}, err
+	}
+	handler, found := k.GetPacketHandler(packetData.Type())
+	if !found {
+		errMsg := fmt.Sprintf("unrecognized %s packet type: %s", types.ModuleName, packetData.Type())
+		return nil, PacketHandler{}, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+	}
+	return packetData, handler, nil
+}
+
+// TransmitPacket transmits the packet over IBC with the specified source port and source channel
+// and returns its sequence
+func (k Keeper) TransmitPacket(
+	ctx sdk.Context,
+	packetData types.PacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	handler, found := k.GetPacketHandler(packetData.Type())
+	if !found {
+		errMsg := fmt.Sprintf("unrecognized %s packet type: %s", types.ModuleName, packetData.Type())
+		return 0, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+	}
+
+	sourceChannelEnd, found := k.ChannelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	// get the next sequence
+	sequence, found := k.ChannelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", sourcePort, sourceChannel,
+		)
+	}
+
+	channelCap, ok := k.ScopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
+	}
+
+	packet := channeltypes.NewPacket(
+		packetBytes,
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.ChannelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	if handler.OnTransmit != nil {
+		if err := handler.OnTransmit(k, ctx, packet, packetData); err != nil {
+			return 0, err
+		}
+	}
+
+	return sequence, nil
+}
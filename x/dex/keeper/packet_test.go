@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	keepertest "interchange/testutil/keeper"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+func TestPacketHandlers(t *testing.T) {
+	k, _ := keepertest.DexKeeper(t)
+
+	for _, packetType := range []string{
+		types.EventTypeCreatePairPacket,
+		types.EventTypeSellOrderPacket,
+		types.EventTypeBuyOrderPacket,
+		types.EventTypeBatchOrderPacket,
+	} {
+		handler, found := k.GetPacketHandler(packetType)
+		require.True(t, found, packetType)
+		require.NotNil(t, handler.OnRecv)
+		require.NotNil(t, handler.OnAcknowledgement)
+		require.NotNil(t, handler.OnTimeout)
+	}
+
+	_, found := k.GetPacketHandler("unknown")
+	require.False(t, found)
+}
+
+func TestRegisterPacketHandler(t *testing.T) {
+	k, _ := keepertest.DexKeeper(t)
+
+	handler := keeper.PacketHandler{
+		OnRecv: func(keeper.Keeper, sdk.Context, channeltypes.Packet, types.PacketData) (proto.Message, error) {
+			return &types.NoData{}, nil
+		},
+		OnAcknowledgement: func(keeper.Keeper, sdk.Context, channeltypes.Packet, types.PacketData, channeltypes.Acknowledgement) error {
+			return nil
+		},
+		OnTimeout: func(keeper.Keeper, sdk.Context, channeltypes.Packet, types.PacketData) error {
+			return nil
+		},
+	}
+	k.RegisterPacketHandler("custom", handler)
+	_, found := k.GetPacketHandler("custom")
+	require.True(t, found)
+
+	// Types can only be registered once
+	require.Panics(t, func() { k.RegisterPacketHandler("custom", handler) })
+	require.Panics(t, func() { k.RegisterPacketHandler(types.EventTypeSellOrderPacket, handler) })
+
+	// Recv, ack and timeout callbacks are mandatory
+	require.Panics(t, func() { k.RegisterPacketHandler("incomplete", keeper.PacketHandler{}) })
+}
+
+func TestResolvePacket(t *testing.T) {
+	k, _ := keepertest.DexKeeper(t)
+
+	data := &types.SellOrderPacketData{AmountDenom: "marscoin", Amount: 10, PriceDenom: "venuscoin", Price: 15}
+	bz, err := data.GetBytes()
+	require.NoError(t, err)
+	packetData, handler, err := k.ResolvePacket(bz)
+	require.NoError(t, err)
+	require.Equal(t, data, packetData)
+	require.NotNil(t, handler.OnRecv)
+
+	// Envelopes without a payload are not dispatched
+	bz, err = (&types.DexPacketData{Packet: &types.DexPacketData_NoData{NoData: &types.NoData{}}}).Marshal()
+	require.NoError(t, err)
+	_, _, err = k.ResolvePacket(bz)
+	require.Error(t, err)
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"interchange/x/dex/types"
+)
+
+// CheckPairCreationPolicy checks the creator can create the pair with the given deposit
+func (k Keeper) CheckPairCreationPolicy(ctx sdk.Context, creator string, sourceDenom string, targetDenom string, deposit *sdk.Coin) error {
+	policy := k.PairCreationPolicy(ctx)
+
+	switch policy.Type {
+	case types.PairCreationOpen:
+		return nil
+	case types.PairCreationAllowList:
+		//ペアの両方のdenomが許可されている必要がある
+		for _, denom := range []string{sourceDenom, targetDenom} {
+			if !policy.IsDenomAllowed(denom) {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "denom %s is not allowed in a pair", denom)
+			}
+		}
+		return nil
+	case types.PairCreationAuthority:
+		if creator != policy.Authority {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the pair creation authority", creator)
+		}
+		return nil
+	case types.PairCreationDeposit:
+		//デポジットが要求額以上であることを確認
+		if deposit == nil || deposit.Denom != policy.Deposit.Denom || deposit.IsLT(*policy.Deposit) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "pair creation requires a deposit of %s", policy.Deposit)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid pair creation policy type: %s", policy.Type)
+	}
+}
+
+// EscrowPairCreationDeposit sends the deposit of the pair creator to the module account
+func (k Keeper) EscrowPairCreationDeposit(ctx sdk.Context, creator sdk.AccAddress, deposit sdk.Coin) error {
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, sdk.NewCoins(deposit))
+}
+
+// RefundPairCreationDeposit returns the escrowed deposit to the pair creator
+func (k Keeper) RefundPairCreationDeposit(ctx sdk.Context, creator sdk.AccAddress, deposit sdk.Coin) error {
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, sdk.NewCoins(deposit))
+}
+
+// BurnPairCreationDeposit burns the escrowed deposit of a rejected pair
+func (k Keeper) BurnPairCreationDeposit(ctx sdk.Context, deposit sdk.Coin) error {
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(deposit))
+}
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/sample"
+	"interchange/x/dex/types"
+)
+
+func TestCheckPairCreationPolicy(t *testing.T) {
+	authority := sample.AccAddress()
+	deposit := sdk.NewInt64Coin("stake", 100)
+	for _, tc := range []struct {
+		desc    string
+		policy  types.PairCreationPolicy
+		creator string
+		source  string
+		target  string
+		deposit *sdk.Coin
+		err     error
+	}{
+		{
+			desc:    "open",
+			policy:  types.PairCreationPolicy{Type: types.PairCreationOpen},
+			creator: sample.AccAddress(),
+			source:  "stake",
+			target:  "token",
+		},
+		{
+			desc:    "allowed denoms",
+			policy:  types.PairCreationPolicy{Type: types.PairCreationAllowList, AllowedDenoms: []string{"stake", "token"}},
+			creator: sample.AccAddress(),
+			source:  "stake",
+			target:  "token",
+		},
+		{
+			desc:    "denom not allowed",
+			policy:  types.PairCreationPolicy{Type: types.PairCreationAllowList, AllowedDenoms: []string{"stake"}},
+			creator: sample.AccAddress(),
+			source:  "stake",
+			target:  "token",
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "authority",
+			policy:  types.PairCreationPolicy{Type: types.PairCreationAuthority, Authority: authority},
+			creator: authority,
+			source:  "stake",
+			target:  "token",
+		},
+		{
+			desc:    "not authority",
+			policy:  types.PairCreationPolicy{Type: types.PairCreationAuthority, Authority: authority},
+			creator: sample.AccAddress(),
+			source:  "stake",
+			target:  "token",
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "deposit",
+			policy:  types.PairCreationPolicy{Type: types.PairCreationDeposit, Deposit: &deposit},
+			creator: sample.AccAddress(),
+			source:  "stake",
+			target:  "token",
+			deposit: &deposit,
+		},
+		{
+			desc:    "no deposit",
+			policy:  types.PairCreationPolicy{Type: types.PairCreationDeposit, Deposit: &deposit},
+			creator: sample.AccAddress(),
+			source:  "stake",
+			target:  "token",
+			err:     sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			desc:    "insufficient deposit",
+			policy:  types.PairCreationPolicy{Type: types.PairCreationDeposit, Deposit: &deposit},
+			creator: sample.AccAddress(),
+			source:  "stake",
+			target:  "token",
+			deposit: &sdk.Coin{Denom: "stake", Amount: sdk.NewInt(99)},
+			err:     sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			desc:    "deposit in another denom",
+			policy:  types.PairCreationPolicy{Type: types.PairCreationDeposit, Deposit: &deposit},
+			creator: sample.AccAddress(),
+			source:  "stake",
+			target:  "token",
+			deposit: &sdk.Coin{Denom: "token", Amount: sdk.NewInt(1000)},
+			err:     sdkerrors.ErrInsufficientFunds,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.DexKeeper(t)
+			params := types.DefaultParams()
+			params.PairCreationPolicy = tc.policy
+			k.SetParams(ctx, params)
+
+			err := k.CheckPairCreationPolicy(ctx, tc.creator, tc.source, tc.target, tc.deposit)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOnRecvCreatePairPacketPolicy(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	authority := sample.AccAddress()
+	params := types.DefaultParams()
+	params.PairCreationPolicy = types.PairCreationPolicy{
+		Type:      types.PairCreationAuthority,
+		Authority: authority,
+	}
+	k.SetParams(ctx, params)
+	packet := channeltypes.Packet{SourcePort: "dex", SourceChannel: "channel-0"}
+
+	_, err := k.OnRecvCreatePairPacket(ctx, packet, types.CreatePairPacketData{
+		SourceDenom: "stake",
+		TargetDenom: "token",
+		Creator:     sample.AccAddress(),
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, found := k.GetBuyOrderBook(ctx, types.OrderBookIndex("dex", "channel-0", "stake", "token"))
+	require.False(t, found)
+
+	_, err = k.OnRecvCreatePairPacket(ctx, packet, types.CreatePairPacketData{
+		SourceDenom:    "stake",
+		TargetDenom:    "token",
+		Creator:        authority,
+		SourceMetadata: &types.DenomMetadata{Display: "STAKE", Decimals: 6},
+	})
+	require.NoError(t, err)
+	book, found := k.GetBuyOrderBook(ctx, types.OrderBookIndex("dex", "channel-0", "stake", "token"))
+	require.True(t, found)
+	require.Equal(t, &types.DenomMetadata{Display: "STAKE", Decimals: 6}, book.AmountDenomMetadata)
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"interchange/x/dex/types"
+)
+
+// SetPairStatus set a specific pairStatus in the store from its index
+func (k Keeper) SetPairStatus(ctx sdk.Context, pairStatus types.PairStatus) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PairStatusKeyPrefix))
+	b := k.cdc.MustMarshal(&pairStatus)
+	store.Set(types.PairStatusKey(
+		pairStatus.Index,
+	), b)
+}
+
+// GetPairStatus returns a pairStatus from its index
+func (k Keeper) GetPairStatus(
+	ctx sdk.Context,
+	index string,
+
+) (val types.PairStatus, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PairStatusKeyPrefix))
+
+	b := store.Get(types.PairStatusKey(
+		index,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePairStatus removes a pairStatus from the store
+func (k Keeper) RemovePairStatus(
+	ctx sdk.Context,
+	index string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PairStatusKeyPrefix))
+	store.Delete(types.PairStatusKey(
+		index,
+	))
+}
+
+// GetAllPairStatus returns all pairStatus
+func (k Keeper) GetAllPairStatus(ctx sdk.Context) (list []types.PairStatus) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PairStatusKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PairStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IsPairCreationAllowed checks the pair can be created under the RestrictPairCreation param
+func (k Keeper) IsPairCreationAllowed(ctx sdk.Context, pairIndex string) bool {
+	if !k.RestrictPairCreation(ctx) {
+		return true
+	}
+	status, found := k.GetPairStatus(ctx, pairIndex)
+	return found && status.Allowed
+}
+
+// IsPairPaused checks if governance paused the trading of the pair
+func (k Keeper) IsPairPaused(ctx sdk.Context, pairIndex string) bool {
+	status, found := k.GetPairStatus(ctx, pairIndex)
+	return found && status.Paused
+}
+
+// AllowPairCreation allows the creation of the pair when pair creation is restricted
+func (k Keeper) AllowPairCreation(ctx sdk.Context, pairIndex string) {
+	status, _ := k.GetPairStatus(ctx, pairIndex)
+	status.Index = pairIndex
+	status.Allowed = true
+	k.SetPairStatus(ctx, status)
+}
+
+// SetPairPaused pauses or resumes the trading of the pair
+func (k Keeper) SetPairPaused(ctx sdk.Context, pairIndex string, paused bool) {
+	status, _ := k.GetPairStatus(ctx, pairIndex)
+	status.Index = pairIndex
+	status.Paused = paused
+	k.SetPairStatus(ctx, status)
+}
+
+// DelistPair removes the order books of the pair, refunds their resting orders and trigger orders
+// and returns the rewards left in its incentive pool to the community pool. The liquidity pool of
+// the pair stops trading and is kept until its providers withdraw their reserves.
+func (k Keeper) DelistPair(ctx sdk.Context, port string, channel string, sourceDenom string, targetDenom string) error {
+	pairIndex := types.OrderBookIndex(port, channel, sourceDenom, targetDenom)
+
+	sellOrderBook, sellFound := k.GetSellOrderBook(ctx, pairIndex)
+	buyOrderBook, buyFound := k.GetBuyOrderBook(ctx, pairIndex)
+	if !sellFound && !buyFound {
+		return errors.New("the pair doesn't exist")
+	}
+
+	//売り注文のエスクローを出品者に返金する
+	if sellFound {
+		for _, order := range sellOrderBook.Book.Orders {
+			seller, err := sdk.AccAddressFromBech32(order.Creator)
+			if err != nil {
+				return err
+			}
+			if err := k.SafeMint(ctx, port, channel, seller, LocalDenom(sellOrderBook.AmountDenom), int64(order.Amount)); err != nil {
+				return err
+			}
+			if err := k.ReleaseOrderDeposit(ctx, types.OrderTypeSell, pairIndex, order.Id); err != nil {
+				return err
+			}
+			k.AfterOrderCancelled(ctx, types.OrderTypeSell, pairIndex, *order)
+		}
+		k.RemoveSellOrderBook(ctx, pairIndex)
+	}
+
+	//買い注文のエスクローを購入者に返金する
+	if buyFound {
+		for _, order := range buyOrderBook.Book.Orders {
+			buyer, err := sdk.AccAddressFromBech32(order.Creator)
+			if err != nil {
+				return err
+			}
+			if err := k.SafeMint(ctx, port, channel, buyer, LocalDenom(buyOrderBook.PriceDenom), int64(order.Amount)*int64(order.Price)); err != nil {
+				return err
+			}
+			if err := k.ReleaseOrderDeposit(ctx, types.OrderTypeBuy, pairIndex, order.Id); err != nil {
+				return err
+			}
+			k.AfterOrderCancelled(ctx, types.OrderTypeBuy, pairIndex, *order)
+		}
+		k.RemoveBuyOrderBook(ctx, pairIndex)
+	}
+
+	//発動前の注文のエスクローを作成者に返金する
+	if err := k.RefundTriggerOrdersByPair(ctx, pairIndex); err != nil {
+		return err
+	}
+
+	//残った報酬はコミュニティプールに戻す
+	if err := k.closeIncentivePool(ctx, pairIndex); err != nil {
+		return err
+	}
+
+	k.RemovePairStatus(ctx, pairIndex)
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/nullify"
+	"interchange/testutil/sample"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNPairStatus(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.PairStatus {
+	items := make([]types.PairStatus, n)
+	for i := range items {
+		items[i].Index = strconv.Itoa(i)
+
+		keeper.SetPairStatus(ctx, items[i])
+	}
+	return items
+}
+
+func TestPairStatusGet(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNPairStatus(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetPairStatus(ctx,
+			item.Index,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestPairStatusRemove(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNPairStatus(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemovePairStatus(ctx,
+			item.Index,
+		)
+		_, found := keeper.GetPairStatus(ctx,
+			item.Index,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestPairStatusGetAll(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNPairStatus(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllPairStatus(ctx)),
+	)
+}
+
+func TestPairCreationAllowed(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	require.True(t, k.IsPairCreationAllowed(ctx, pairIndex))
+
+	params := types.DefaultParams()
+	params.RestrictPairCreation = true
+	k.SetParams(ctx, params)
+	require.False(t, k.IsPairCreationAllowed(ctx, pairIndex))
+
+	packet := channeltypes.Packet{SourcePort: "dex", SourceChannel: "channel-0"}
+	_, err := k.OnRecvCreatePairPacket(ctx, packet, types.CreatePairPacketData{SourceDenom: "stake", TargetDenom: "token"})
+	require.EqualError(t, err, "the pair creation is not allowed")
+
+	k.AllowPairCreation(ctx, pairIndex)
+	require.True(t, k.IsPairCreationAllowed(ctx, pairIndex))
+	_, err = k.OnRecvCreatePairPacket(ctx, packet, types.CreatePairPacketData{SourceDenom: "stake", TargetDenom: "token"})
+	require.NoError(t, err)
+}
+
+func TestPairPaused(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	book := types.NewBuyOrderBook("stake", "token")
+	book.Index = pairIndex
+	k.SetBuyOrderBook(ctx, book)
+	require.False(t, k.IsPairPaused(ctx, pairIndex))
+
+	k.SetPairPaused(ctx, pairIndex, true)
+	require.True(t, k.IsPairPaused(ctx, pairIndex))
+
+	packet := channeltypes.Packet{SourcePort: "dex", SourceChannel: "channel-0"}
+	_, err := k.OnRecvSellOrderPacket(ctx, packet, types.SellOrderPacketData{
+		AmountDenom: "stake",
+		Amount:      10,
+		PriceDenom:  "token",
+		Price:       10,
+		Seller:      sample.AccAddress(),
+	})
+	require.EqualError(t, err, "the pair is paused")
+
+	k.SetPairPaused(ctx, pairIndex, false)
+	require.False(t, k.IsPairPaused(ctx, pairIndex))
+}
+
+func TestDelistPair(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	require.EqualError(t, k.DelistPair(ctx, "dex", "channel-0", "stake", "token"), "the pair doesn't exist")
+
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	book := types.NewSellOrderBook("stake", "token")
+	book.Index = pairIndex
+	k.SetSellOrderBook(ctx, book)
+	k.SetPairPaused(ctx, pairIndex, true)
+
+	require.NoError(t, k.DelistPair(ctx, "dex", "channel-0", "stake", "token"))
+	_, found := k.GetSellOrderBook(ctx, pairIndex)
+	require.False(t, found)
+	_, found = k.GetPairStatus(ctx, pairIndex)
+	require.False(t, found)
+}
+
+func TestDelistPairTriggerOrders(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	book := types.NewSellOrderBook("stake", "token")
+	book.Index = pairIndex
+	f.Keeper.SetSellOrderBook(f.Ctx, book)
+
+	creator := sample.AccAddress()
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	require.NoError(t, err)
+	id := f.Keeper.AppendTriggerOrder(f.Ctx, types.TriggerOrder{
+		Creator:      creator,
+		Port:         "dex",
+		Channel:      "channel-0",
+		OrderType:    types.OrderTypeSell,
+		TriggerType:  types.StopLimit,
+		PairIndex:    pairIndex,
+		AmountDenom:  "stake",
+		PriceDenom:   "token",
+		Amount:       10,
+		TriggerPrice: 20,
+		Price:        18,
+	})
+	f.FundEscrow("dex", "channel-0", sdk.NewInt64Coin("stake", 10))
+
+	// The escrow of the trigger orders of the pair is refunded
+	require.NoError(t, f.Keeper.DelistPair(f.Ctx, "dex", "channel-0", "stake", "token"))
+	_, found := f.Keeper.GetTriggerOrder(f.Ctx, id)
+	require.False(t, found)
+	require.Zero(t, f.Keeper.GetCreatorTriggerOrderCount(f.Ctx, creator))
+	f.RequireBalance(creatorAddr, "stake", 10)
+	f.RequireEscrow("dex", "channel-0", "stake", 0)
+}
@@ -1,16 +1,89 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"interchange/x/dex/types"
 )
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.RateLimits(ctx),
+		k.RestrictPairCreation(ctx),
+		k.CircuitBreakerAuthority(ctx),
+		k.PairCreationPolicy(ctx),
+		k.MaxOpenOrders(ctx),
+		k.OrderDepositPolicy(ctx),
+		k.CandleIntervals(ctx),
+		k.CandleRetention(ctx),
+		k.LiquidityMiningPolicy(ctx),
+		k.PoolSwapFee(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// RateLimits returns the RateLimits param
+func (k Keeper) RateLimits(ctx sdk.Context) (res []types.RateLimit) {
+	k.paramstore.Get(ctx, types.KeyRateLimits, &res)
+	return
+}
+
+// RestrictPairCreation returns the RestrictPairCreation param
+func (k Keeper) RestrictPairCreation(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyRestrictPairCreation, &res)
+	return
+}
+
+// CircuitBreakerAuthority returns the CircuitBreakerAuthority param
+func (k Keeper) CircuitBreakerAuthority(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyCircuitBreakerAuthority, &res)
+	return
+}
+
+// PairCreationPolicy returns the PairCreationPolicy param
+func (k Keeper) PairCreationPolicy(ctx sdk.Context) (res types.PairCreationPolicy) {
+	k.paramstore.Get(ctx, types.KeyPairCreationPolicy, &res)
+	return
+}
+
+// MaxOpenOrders returns the MaxOpenOrders param
+func (k Keeper) MaxOpenOrders(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyMaxOpenOrders, &res)
+	return
+}
+
+// OrderDepositPolicy returns the OrderDepositPolicy param
+func (k Keeper) OrderDepositPolicy(ctx sdk.Context) (res types.OrderDepositPolicy) {
+	k.paramstore.Get(ctx, types.KeyOrderDepositPolicy, &res)
+	return
+}
+
+// CandleIntervals returns the CandleIntervals param
+func (k Keeper) CandleIntervals(ctx sdk.Context) (res []time.Duration) {
+	k.paramstore.Get(ctx, types.KeyCandleIntervals, &res)
+	return
+}
+
+// CandleRetention returns the CandleRetention param
+func (k Keeper) CandleRetention(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyCandleRetention, &res)
+	return
+}
+
+// LiquidityMiningPolicy returns the LiquidityMiningPolicy param
+func (k Keeper) LiquidityMiningPolicy(ctx sdk.Context) (res types.LiquidityMiningPolicy) {
+	k.paramstore.Get(ctx, types.KeyLiquidityMiningPolicy, &res)
+	return
+}
+
+// PoolSwapFee returns the PoolSwapFee param
+func (k Keeper) PoolSwapFee(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyPoolSwapFee, &res)
+	return
+}
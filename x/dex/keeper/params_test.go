@@ -2,15 +2,41 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	testkeeper "interchange/testutil/keeper"
+	"interchange/testutil/sample"
 	"interchange/x/dex/types"
 )
 
 func TestGetParams(t *testing.T) {
 	k, ctx := testkeeper.DexKeeper(t)
+	deposit := sdk.NewInt64Coin("stake", 10)
 	params := types.DefaultParams()
+	params.RateLimits = []types.RateLimit{
+		{Port: "dex", Channel: "channel-0", Denom: "stake", MaxOutflow: 1000, Window: time.Hour},
+	}
+	params.RestrictPairCreation = true
+	params.CircuitBreakerAuthority = sample.AccAddress()
+	params.PairCreationPolicy = types.PairCreationPolicy{
+		Type:          types.PairCreationAllowList,
+		AllowedDenoms: []string{"stake", "token"},
+	}
+	params.MaxOpenOrders = 10
+	params.OrderDepositPolicy = types.OrderDepositPolicy{
+		Deposit:       &deposit,
+		Lifetime:      24 * time.Hour,
+		SlashFraction: sdk.NewDecWithPrec(1, 1),
+	}
+	params.CandleIntervals = []time.Duration{time.Minute, time.Hour}
+	params.CandleRetention = 100
+	params.LiquidityMiningPolicy = types.LiquidityMiningPolicy{
+		EmissionRate: sdk.NewDecWithPrec(1, 2),
+		MaxDistance:  sdk.NewDecWithPrec(1, 1),
+	}
+	params.PoolSwapFee = sdk.NewDecWithPrec(1, 2)
 
 	k.SetParams(ctx, params)
 
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	"interchange/x/dex/types"
+)
+
+// SetPendingOrder set a specific pendingOrder in the store from its index
+func (k Keeper) SetPendingOrder(ctx sdk.Context, pendingOrder types.PendingOrder) {
+	// drop the owner index of the record being replaced
+	k.RemovePendingOrder(ctx, pendingOrder.Port, pendingOrder.Channel, pendingOrder.Sequence)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOrderKeyPrefix))
+	key := types.PendingOrderKey(
+		pendingOrder.Port,
+		pendingOrder.Channel,
+		pendingOrder.Sequence,
+	)
+	b := k.cdc.MustMarshal(&pendingOrder)
+	store.Set(key, b)
+
+	// index the pending order by owner
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOrderOwnerKeyPrefix))
+	ownerStore.Set(append(types.PendingOrderOwnerKey(pendingOrder.Owner), key...), key)
+}
+
+// GetPendingOrder returns a pendingOrder from its index
+func (k Keeper) GetPendingOrder(
+	ctx sdk.Context,
+	port string,
+	channel string,
+	sequence uint64,
+
+) (val types.PendingOrder, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOrderKeyPrefix))
+
+	b := store.Get(types.PendingOrderKey(
+		port,
+		channel,
+		sequence,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePendingOrder removes a pendingOrder from the store
+func (k Keeper) RemovePendingOrder(
+	ctx sdk.Context,
+	port string,
+	channel string,
+	sequence uint64,
+
+) {
+	pendingOrder, found := k.GetPendingOrder(ctx, port, channel, sequence)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOrderKeyPrefix))
+	key := types.PendingOrderKey(
+		port,
+		channel,
+		sequence,
+	)
+	store.Delete(key)
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOrderOwnerKeyPrefix))
+	ownerStore.Delete(append(types.PendingOrderOwnerKey(pendingOrder.Owner), key...))
+}
+
+// GetAllPendingOrder returns all pendingOrder
+func (k Keeper) GetAllPendingOrder(ctx sdk.Context) (list []types.PendingOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOrderKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPendingOrdersByOwner returns all pendingOrder of an owner
+func (k Keeper) GetPendingOrdersByOwner(ctx sdk.Context, owner string) (list []types.PendingOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOrderKeyPrefix))
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOrderOwnerKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(ownerStore, types.PendingOrderOwnerKey(owner))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingOrder
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// pendingOrderDeposit returns the deposit escrowed for each order of the pending order of a packet
+func (k Keeper) pendingOrderDeposit(ctx sdk.Context, packet channeltypes.Packet) *sdk.Coin {
+	pendingOrder, found := k.GetPendingOrder(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+	return pendingOrder.Deposit
+}
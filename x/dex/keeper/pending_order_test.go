@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/nullify"
+	"interchange/testutil/sample"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+func createNPendingOrder(keeper *keeper.Keeper, ctx sdk.Context, owner string, n int) []types.PendingOrder {
+	items := make([]types.PendingOrder, n)
+	for i := range items {
+		items[i].Port = "dex"
+		items[i].Channel = "channel-0"
+		items[i].Sequence = uint64(i)
+		items[i].Owner = owner
+		items[i].OrderType = types.OrderTypeSell
+
+		keeper.SetPendingOrder(ctx, items[i])
+	}
+	return items
+}
+
+func TestPendingOrderGet(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNPendingOrder(keeper, ctx, sample.AccAddress(), 10)
+	for _, item := range items {
+		rst, found := keeper.GetPendingOrder(ctx,
+			item.Port,
+			item.Channel,
+			item.Sequence,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestPendingOrderRemove(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	owner := sample.AccAddress()
+	items := createNPendingOrder(keeper, ctx, owner, 10)
+	for _, item := range items {
+		keeper.RemovePendingOrder(ctx,
+			item.Port,
+			item.Channel,
+			item.Sequence,
+		)
+		_, found := keeper.GetPendingOrder(ctx,
+			item.Port,
+			item.Channel,
+			item.Sequence,
+		)
+		require.False(t, found)
+	}
+	require.Empty(t, keeper.GetPendingOrdersByOwner(ctx, owner))
+}
+
+func TestPendingOrderGetAll(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNPendingOrder(keeper, ctx, sample.AccAddress(), 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllPendingOrder(ctx)),
+	)
+}
+
+func TestPendingOrderGetByOwner(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	owner := sample.AccAddress()
+	items := createNPendingOrder(keeper, ctx, owner, 5)
+	keeper.SetPendingOrder(ctx, types.PendingOrder{
+		Port:     "dex",
+		Channel:  "channel-1",
+		Sequence: 1,
+		Owner:    sample.AccAddress(),
+	})
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetPendingOrdersByOwner(ctx, owner)),
+	)
+
+	// Replacing a pending order moves it to the new owner
+	items[0].Owner = sample.AccAddress()
+	keeper.SetPendingOrder(ctx, items[0])
+	require.ElementsMatch(t,
+		nullify.Fill(items[1:]),
+		nullify.Fill(keeper.GetPendingOrdersByOwner(ctx, owner)),
+	)
+}
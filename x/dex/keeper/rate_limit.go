@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"interchange/x/dex/types"
+)
+
+// SetRateLimitQuota set a specific rateLimitQuota in the store from its index
+func (k Keeper) SetRateLimitQuota(ctx sdk.Context, rateLimitQuota types.RateLimitQuota) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitQuotaKeyPrefix))
+	b := k.cdc.MustMarshal(&rateLimitQuota)
+	store.Set(types.RateLimitQuotaKey(
+		rateLimitQuota.Port,
+		rateLimitQuota.Channel,
+		rateLimitQuota.Denom,
+	), b)
+}
+
+// GetRateLimitQuota returns a rateLimitQuota from its index
+func (k Keeper) GetRateLimitQuota(
+	ctx sdk.Context,
+	port string,
+	channel string,
+	denom string,
+
+) (val types.RateLimitQuota, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitQuotaKeyPrefix))
+
+	b := store.Get(types.RateLimitQuotaKey(
+		port,
+		channel,
+		denom,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRateLimitQuota removes a rateLimitQuota from the store
+func (k Keeper) RemoveRateLimitQuota(
+	ctx sdk.Context,
+	port string,
+	channel string,
+	denom string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitQuotaKeyPrefix))
+	store.Delete(types.RateLimitQuotaKey(
+		port,
+		channel,
+		denom,
+	))
+}
+
+// GetAllRateLimitQuota returns all rateLimitQuota
+func (k Keeper) GetAllRateLimitQuota(ctx sdk.Context) (list []types.RateLimitQuota) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitQuotaKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RateLimitQuota
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetRateLimit returns the rate limit configured for a port, channel and denom
+func (k Keeper) GetRateLimit(ctx sdk.Context, port string, channel string, denom string) (types.RateLimit, bool) {
+	for _, rateLimit := range k.RateLimits(ctx) {
+		if rateLimit.Port == port && rateLimit.Channel == channel && rateLimit.Denom == denom {
+			return rateLimit, true
+		}
+	}
+	return types.RateLimit{}, false
+}
+
+// CurrentRateLimitQuota returns the quota of the current window, a new window starts
+// at the block time once the previous one has elapsed
+func (k Keeper) CurrentRateLimitQuota(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimitQuota {
+	quota, found := k.GetRateLimitQuota(ctx, rateLimit.Port, rateLimit.Channel, rateLimit.Denom)
+	if !found || !ctx.BlockTime().Before(quota.WindowStart.Add(rateLimit.Window)) {
+		return types.RateLimitQuota{
+			Port:        rateLimit.Port,
+			Channel:     rateLimit.Channel,
+			Denom:       rateLimit.Denom,
+			WindowStart: ctx.BlockTime(),
+		}
+	}
+	return quota
+}
+
+// 送出量をレート制限のクォータから差し引く(制限がない場合は何もしない)
+func (k Keeper) consumeOutflowQuota(ctx sdk.Context, port string, channel string, denom string, amount int64) error {
+	rateLimit, found := k.GetRateLimit(ctx, port, channel, denom)
+	if !found {
+		return nil
+	}
+
+	quota := k.CurrentRateLimitQuota(ctx, rateLimit)
+	if quota.Outflow+amount > rateLimit.MaxOutflow {
+		return sdkerrors.Wrapf(
+			types.ErrRateLimitExceeded,
+			"outflow of %d%s on %s/%s exceeds the remaining quota of %d",
+			amount, denom, port, channel, rateLimit.MaxOutflow-quota.Outflow,
+		)
+	}
+	quota.Outflow += amount
+	k.SetRateLimitQuota(ctx, quota)
+
+	return nil
+}
+
+// ResetRateLimitQuota drops the outflow tracked for a port, channel and denom
+func (k Keeper) ResetRateLimitQuota(ctx sdk.Context, port string, channel string, denom string) {
+	k.RemoveRateLimitQuota(ctx, port, channel, denom)
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/nullify"
+	"interchange/testutil/sample"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNRateLimitQuota(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.RateLimitQuota {
+	items := make([]types.RateLimitQuota, n)
+	for i := range items {
+		items[i].Port = "dex"
+		items[i].Channel = "channel-" + strconv.Itoa(i)
+		items[i].Denom = "stake"
+		items[i].WindowStart = time.Unix(0, 0).UTC()
+
+		keeper.SetRateLimitQuota(ctx, items[i])
+	}
+	return items
+}
+
+func TestRateLimitQuotaGet(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNRateLimitQuota(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetRateLimitQuota(ctx,
+			item.Port,
+			item.Channel,
+			item.Denom,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestRateLimitQuotaRemove(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNRateLimitQuota(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveRateLimitQuota(ctx,
+			item.Port,
+			item.Channel,
+			item.Denom,
+		)
+		_, found := keeper.GetRateLimitQuota(ctx,
+			item.Port,
+			item.Channel,
+			item.Denom,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestRateLimitQuotaGetAll(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNRateLimitQuota(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllRateLimitQuota(ctx)),
+	)
+}
+
+func TestCurrentRateLimitQuota(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	start := time.Unix(1000, 0).UTC()
+	rateLimit := types.RateLimit{Port: "dex", Channel: "channel-0", Denom: "stake", MaxOutflow: 100, Window: time.Hour}
+	k.SetRateLimitQuota(ctx, types.RateLimitQuota{
+		Port:        "dex",
+		Channel:     "channel-0",
+		Denom:       "stake",
+		Outflow:     60,
+		WindowStart: start,
+	})
+
+	quota := k.CurrentRateLimitQuota(ctx.WithBlockTime(start.Add(time.Minute)), rateLimit)
+	require.Equal(t, int64(60), quota.Outflow)
+	require.Equal(t, start, quota.WindowStart)
+
+	now := start.Add(time.Hour)
+	quota = k.CurrentRateLimitQuota(ctx.WithBlockTime(now), rateLimit)
+	require.Equal(t, int64(0), quota.Outflow)
+	require.Equal(t, now, quota.WindowStart)
+
+	k.ResetRateLimitQuota(ctx, "dex", "channel-0", "stake")
+	_, found := k.GetRateLimitQuota(ctx, "dex", "channel-0", "stake")
+	require.False(t, found)
+}
+
+func TestSafeMintRateLimit(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0).UTC())
+	params := types.DefaultParams()
+	params.RateLimits = []types.RateLimit{
+		{Port: "dex", Channel: "channel-0", Denom: "stake", MaxOutflow: 100, Window: time.Hour},
+	}
+	k.SetParams(ctx, params)
+	k.SetRateLimitQuota(ctx, types.RateLimitQuota{
+		Port:        "dex",
+		Channel:     "channel-0",
+		Denom:       "stake",
+		Outflow:     60,
+		WindowStart: ctx.BlockTime(),
+	})
+
+	receiver, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, err)
+	err = k.SafeMint(ctx, "dex", "channel-0", receiver, "stake", 41)
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+
+	quota, found := k.GetRateLimitQuota(ctx, "dex", "channel-0", "stake")
+	require.True(t, found)
+	require.Equal(t, int64(60), quota.Outflow)
+}
+
+func TestRefundRateLimit(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	srv := keeper.NewMsgServerImpl(*f.Keeper)
+	params := types.DefaultParams()
+	params.RateLimits = []types.RateLimit{
+		{Port: "dex", Channel: "channel-0", Denom: "stake", MaxOutflow: 10, Window: time.Hour},
+	}
+	f.Keeper.SetParams(f.Ctx, params)
+	creator := sample.AccAddress()
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	require.NoError(t, err)
+
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	book := types.NewSellOrderBook("stake", "token")
+	book.Index = pairIndex
+	id, err := book.AppendOrder(creator, 50, 2, 0)
+	require.NoError(t, err)
+	f.Keeper.SetSellOrderBook(f.Ctx, book)
+	f.FundEscrow("dex", "channel-0", sdk.NewInt64Coin("stake", 50))
+
+	msg := &types.MsgCancelSellOrder{
+		Creator:     creator,
+		Port:        "dex",
+		Channel:     "channel-0",
+		AmountDenom: "stake",
+		PriceDenom:  "token",
+		OrderID:     id,
+	}
+
+	// The escrow of a cancelled order is refunded within the outflow quota
+	cacheCtx, _ := f.Ctx.CacheContext()
+	_, err = srv.CancelSellOrder(sdk.WrapSDKContext(cacheCtx), msg)
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+	f.RequireEscrow("dex", "channel-0", "stake", 50)
+
+	params.RateLimits[0].MaxOutflow = 100
+	f.Keeper.SetParams(f.Ctx, params)
+	_, err = srv.CancelSellOrder(sdk.WrapSDKContext(f.Ctx), msg)
+	require.NoError(t, err)
+	f.RequireBalance(creatorAddr, "stake", 50)
+	quota, found := f.Keeper.GetRateLimitQuota(f.Ctx, "dex", "channel-0", "stake")
+	require.True(t, found)
+	require.Equal(t, int64(50), quota.Outflow)
+}
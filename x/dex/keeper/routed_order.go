@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	"interchange/x/dex/types"
+)
+
+// SetRoutedOrder set a specific routedOrder in the store from the packet of its current leg
+func (k Keeper) SetRoutedOrder(ctx sdk.Context, routedOrder types.RoutedOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RoutedOrderKeyPrefix))
+	b := k.cdc.MustMarshal(&routedOrder)
+	store.Set(types.RoutedOrderKey(
+		routedOrder.Port,
+		routedOrder.Channel,
+		routedOrder.Sequence,
+	), b)
+}
+
+// GetRoutedOrder returns a routedOrder from the packet of its current leg
+func (k Keeper) GetRoutedOrder(
+	ctx sdk.Context,
+	port string,
+	channel string,
+	sequence uint64,
+
+) (val types.RoutedOrder, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RoutedOrderKeyPrefix))
+
+	b := store.Get(types.RoutedOrderKey(
+		port,
+		channel,
+		sequence,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRoutedOrder removes a routedOrder from the store
+func (k Keeper) RemoveRoutedOrder(
+	ctx sdk.Context,
+	port string,
+	channel string,
+	sequence uint64,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RoutedOrderKeyPrefix))
+	store.Delete(types.RoutedOrderKey(
+		port,
+		channel,
+		sequence,
+	))
+}
+
+// GetAllRoutedOrder returns all routedOrder
+func (k Keeper) GetAllRoutedOrder(ctx sdk.Context) (list []types.RoutedOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RoutedOrderKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RoutedOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// takeRoutedOrder removes the routed order whose current leg was sent with the packet and returns it
+func (k Keeper) takeRoutedOrder(ctx sdk.Context, packet channeltypes.Packet) (types.RoutedOrder, bool) {
+	routedOrder, found := k.GetRoutedOrder(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if found {
+		k.RemoveRoutedOrder(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	}
+	return routedOrder, found
+}
+
+// routeLegSentDenom returns the denom of the tokens sent with a leg, the amount denom of a sell order
+// and the price denom of a buy order
+func routeLegSentDenom(leg types.RouteLeg) string {
+	if leg.OrderType == types.OrderTypeSell {
+		return LocalDenom(leg.AmountDenom)
+	}
+	return LocalDenom(leg.PriceDenom)
+}
+
+// routeLegReceivedDenom returns the denom of the tokens received from a leg, resolved like the gain of a sell order
+// and the purchase of a buy order when they are acknowledged
+func (k Keeper) routeLegReceivedDenom(ctx sdk.Context, leg types.RouteLeg) string {
+	if leg.OrderType == types.OrderTypeSell {
+		if denom, saved := k.OriginalDenom(ctx, leg.Port, leg.Channel, LocalDenom(leg.PriceDenom)); saved {
+			return denom
+		}
+		return VoucherDenom(leg.Port, leg.Channel, leg.PriceDenom)
+	}
+	if denom, saved := k.OriginalDenom(ctx, leg.Port, leg.Channel, LocalDenom(leg.AmountDenom)); saved {
+		return denom
+	}
+	return VoucherDenom(leg.Port, leg.Channel, leg.AmountDenom)
+}
+
+// checkRouteLegs checks the tokens received from each leg of a route are the tokens sent with the next leg
+func (k Keeper) checkRouteLegs(ctx sdk.Context, legs []types.RouteLeg) error {
+	for i := 1; i < len(legs); i++ {
+		received := k.routeLegReceivedDenom(ctx, legs[i-1])
+		sent := routeLegSentDenom(legs[i])
+		if received != sent {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "leg %d receives %s but leg %d sends %s", i-1, received, i, sent)
+		}
+	}
+	return nil
+}
+
+// sendRouteLeg escrows the tokens of the current leg of a routed order and sends its order,
+// the routed order is stored until the leg is acknowledged or times out
+func (k Keeper) sendRouteLeg(ctx sdk.Context, routedOrder types.RoutedOrder, tokens int64) error {
+	leg := routedOrder.Legs[routedOrder.Leg]
+	amount, err := leg.OrderAmount(tokens)
+	if err != nil {
+		return err
+	}
+
+	//区間の注文を通常の注文と同じように送信する
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + routedOrder.PacketTimeout
+	var sequence uint64
+	if leg.OrderType == types.OrderTypeSell {
+		sequence, err = k.sendSellOrder(ctx, leg.Port, leg.Channel, types.SellOrderPacketData{
+			AmountDenom: leg.AmountDenom,
+			Amount:      amount,
+			PriceDenom:  leg.PriceDenom,
+			Price:       leg.Price,
+			Seller:      routedOrder.Creator,
+		}, timeoutTimestamp)
+	} else {
+		sequence, err = k.sendBuyOrder(ctx, leg.Port, leg.Channel, types.BuyOrderPacketData{
+			AmountDenom: leg.AmountDenom,
+			Amount:      amount,
+			PriceDenom:  leg.PriceDenom,
+			Price:       leg.Price,
+			Buyer:       routedOrder.Creator,
+		}, timeoutTimestamp)
+	}
+	if err != nil {
+		return err
+	}
+
+	//確認応答で次の区間を送信できるように、パケットからルーティング注文を保存する
+	routedOrder.Port = leg.Port
+	routedOrder.Channel = leg.Channel
+	routedOrder.Sequence = sequence
+	k.SetRoutedOrder(ctx, routedOrder)
+	return nil
+}
+
+// continueRoutedOrder sends the next leg of a routed order with the tokens received from the acknowledged leg,
+// the tokens stay with the creator when the route ends or the next leg cannot be sent. The tokens received
+// from a leg cannot be traded back to the denom of the first leg, so a stopped route emits an
+// EventRoutedOrderStopped for the creator to know which tokens it holds.
+func (k Keeper) continueRoutedOrder(ctx sdk.Context, routedOrder types.RoutedOrder, received int64) {
+	routedOrder.Leg++
+	if int(routedOrder.Leg) >= len(routedOrder.Legs) || received <= 0 {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.sendRouteLeg(cacheCtx, routedOrder, received); err != nil {
+		k.Logger(ctx).Error("cannot send the next leg of a routed order", "creator", routedOrder.Creator, "leg", routedOrder.Leg, "error", err)
+		//受け取ったトークンは作成者に残る
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventRoutedOrderStopped{
+			Creator: routedOrder.Creator,
+			Leg:     routedOrder.Leg,
+			Denom:   k.routeLegReceivedDenom(ctx, routedOrder.Legs[routedOrder.Leg-1]),
+			Amount:  received,
+			Reason:  err.Error(),
+		}); err != nil {
+			k.Logger(ctx).Error("cannot emit the event of a stopped routed order", "error", err)
+		}
+		return
+	}
+	write()
+	//キャッシュコンテキストのイベントは破棄されるため、リレイヤーが次の区間のパケットを検出できるように発行し直す
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// settleRoutedSellLeg settles an acknowledged sell order leg of a routed order, the remaining amount is refunded
+// instead of resting in the sell order book and the gain is sent with the next leg
+func (k Keeper) settleRoutedSellLeg(ctx sdk.Context, packet channeltypes.Packet, data types.SellOrderPacketData, packetAck types.SellOrderPacketAck, deposit *sdk.Coin, routedOrder types.RoutedOrder) error {
+	if packetAck.RemainingAmount > 0 {
+		remaining := data
+		remaining.Amount = packetAck.RemainingAmount
+		if err := k.refundSellOrder(ctx, packet, remaining); err != nil {
+			return err
+		}
+	}
+	if err := k.settleSellOrder(ctx, packet, data, 0, packetAck.Gain, packetAck.PreventedAmount, deposit); err != nil {
+		return err
+	}
+
+	k.continueRoutedOrder(ctx, routedOrder, packetAck.Gain)
+	return nil
+}
+
+// settleRoutedBuyLeg settles an acknowledged buy order leg of a routed order, the remaining amount is refunded
+// instead of resting in the buy order book and the purchase is sent with the next leg
+func (k Keeper) settleRoutedBuyLeg(ctx sdk.Context, packet channeltypes.Packet, data types.BuyOrderPacketData, packetAck types.BuyOrderPacketAck, deposit *sdk.Coin, routedOrder types.RoutedOrder) error {
+	if packetAck.RemainingAmount > 0 {
+		remaining := data
+		remaining.Amount = packetAck.RemainingAmount
+		if err := k.refundBuyOrder(ctx, packet, remaining); err != nil {
+			return err
+		}
+	}
+	if err := k.settleBuyOrder(ctx, packet, data, 0, packetAck.Purchase, packetAck.PreventedAmount, packetAck.Refund, deposit); err != nil {
+		return err
+	}
+
+	k.continueRoutedOrder(ctx, routedOrder, int64(packetAck.Purchase))
+	return nil
+}
@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/nullify"
+	"interchange/testutil/sample"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+func createNRoutedOrder(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.RoutedOrder {
+	items := make([]types.RoutedOrder, n)
+	for i := range items {
+		items[i].Port = "dex"
+		items[i].Channel = "channel-0"
+		items[i].Sequence = uint64(i)
+		items[i].Legs = []types.RouteLeg{
+			{Port: "dex", Channel: "channel-0", OrderType: types.OrderTypeSell, AmountDenom: "stake", PriceDenom: "token", Price: 2},
+		}
+
+		keeper.SetRoutedOrder(ctx, items[i])
+	}
+	return items
+}
+
+func TestRoutedOrderGet(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNRoutedOrder(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetRoutedOrder(ctx,
+			item.Port,
+			item.Channel,
+			item.Sequence,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestRoutedOrderRemove(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNRoutedOrder(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveRoutedOrder(ctx,
+			item.Port,
+			item.Channel,
+			item.Sequence,
+		)
+		_, found := keeper.GetRoutedOrder(ctx,
+			item.Port,
+			item.Channel,
+			item.Sequence,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestRoutedOrderGetAll(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNRoutedOrder(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllRoutedOrder(ctx)),
+	)
+}
+
+func TestRoutedOrderAcknowledgement(t *testing.T) {
+	creator := sample.AccAddress()
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	require.NoError(t, err)
+	packet := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         "dex",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "dex",
+		DestinationChannel: "channel-0",
+	}
+	data := types.SellOrderPacketData{
+		AmountDenom: "marscoin",
+		Amount:      10,
+		PriceDenom:  "venuscoin",
+		Price:       5,
+		Seller:      creator,
+	}
+	routedOrder := types.RoutedOrder{
+		Port:     "dex",
+		Channel:  "channel-0",
+		Sequence: 1,
+		Creator:  creator,
+		Legs: []types.RouteLeg{
+			{Port: "dex", Channel: "channel-0", OrderType: types.OrderTypeSell, AmountDenom: "marscoin", PriceDenom: "venuscoin", Price: 5},
+			{Port: "dex", Channel: "channel-0", OrderType: types.OrderTypeBuy, AmountDenom: "venusgold", PriceDenom: "venuscoin", Price: 2},
+		},
+		PacketTimeout: 100,
+	}
+	voucher := keeper.VoucherDenom("dex", "channel-0", "venuscoin")
+
+	t.Run("NextLegNotSent", func(t *testing.T) {
+		f := keepertest.DexKeeperWithBank(t)
+		f.FundEscrow("dex", "channel-0", sdk.NewInt64Coin("marscoin", 10))
+		f.Keeper.SetRoutedOrder(f.Ctx, routedOrder)
+
+		// The remaining amount is refunded and the gain stays with the creator when the next leg cannot be sent
+		ack := channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(&types.SellOrderPacketAck{RemainingAmount: 2, Gain: 40}))
+		require.NoError(t, f.Keeper.OnAcknowledgementSellOrderPacket(f.Ctx, packet, data, ack))
+
+		_, found := f.Keeper.GetRoutedOrder(f.Ctx, "dex", "channel-0", 1)
+		require.False(t, found)
+		f.RequireBalance(creatorAddr, "marscoin", 2)
+		f.RequireBalance(creatorAddr, voucher, 40)
+
+		// The creator is notified of the tokens left by the stopped route
+		var stopped []*types.EventRoutedOrderStopped
+		for _, event := range f.Ctx.EventManager().ABCIEvents() {
+			if event.Type != proto.MessageName(&types.EventRoutedOrderStopped{}) {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(event)
+			require.NoError(t, err)
+			stopped = append(stopped, msg.(*types.EventRoutedOrderStopped))
+		}
+		require.Len(t, stopped, 1)
+		require.Equal(t, creator, stopped[0].Creator)
+		require.EqualValues(t, 1, stopped[0].Leg)
+		require.Equal(t, voucher, stopped[0].Denom)
+		require.EqualValues(t, 40, stopped[0].Amount)
+		require.Equal(t, "the pair doesn't exist", stopped[0].Reason)
+	})
+
+	t.Run("ErrorAcknowledgement", func(t *testing.T) {
+		f := keepertest.DexKeeperWithBank(t)
+		f.FundEscrow("dex", "channel-0", sdk.NewInt64Coin("marscoin", 10))
+		f.Keeper.SetRoutedOrder(f.Ctx, routedOrder)
+
+		// The leg is refunded and the route ends
+		require.NoError(t, f.Keeper.OnAcknowledgementSellOrderPacket(f.Ctx, packet, data, channeltypes.NewErrorAcknowledgement("failed")))
+
+		_, found := f.Keeper.GetRoutedOrder(f.Ctx, "dex", "channel-0", 1)
+		require.False(t, found)
+		f.RequireBalance(creatorAddr, "marscoin", 10)
+		f.RequireBalance(creatorAddr, voucher, 0)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		f := keepertest.DexKeeperWithBank(t)
+		f.FundEscrow("dex", "channel-0", sdk.NewInt64Coin("marscoin", 10))
+		f.Keeper.SetRoutedOrder(f.Ctx, routedOrder)
+
+		require.NoError(t, f.Keeper.OnTimeoutSellOrderPacket(f.Ctx, packet, data))
+
+		_, found := f.Keeper.GetRoutedOrder(f.Ctx, "dex", "channel-0", 1)
+		require.False(t, found)
+		f.RequireBalance(creatorAddr, "marscoin", 10)
+	})
+}
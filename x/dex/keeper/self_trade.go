@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"interchange/x/dex/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+)
+
+// refundSelfTradeOrders returns the escrow of the resting orders cancelled by the self-trade prevention
+// while matching a received order
+func (k Keeper) refundSelfTradeOrders(ctx sdk.Context, packet channeltypes.Packet, cancelled []types.Order, denom string, escrow func(types.Order) int64) error {
+	for _, order := range cancelled {
+		addr, err := sdk.AccAddressFromBech32(order.Creator)
+		if err != nil {
+			return err
+		}
+		//板上の注文はこのチェーンでエスクローされている
+		if err := k.SafeMint(
+			ctx,
+			packet.DestinationPort,
+			packet.DestinationChannel,
+			addr,
+			denom,
+			escrow(order),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/sample"
+	"interchange/x/dex/types"
+)
+
+func TestOnRecvOrderPacketSelfTradePrevention(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	creator := sample.AccAddress()
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	packet := channeltypes.Packet{SourcePort: "dex", SourceChannel: "channel-0"}
+
+	buyBook := types.NewBuyOrderBook("stake", "token")
+	buyBook.Index = pairIndex
+	_, err := buyBook.AppendOrder(creator, 10, 20, 0)
+	require.NoError(t, err)
+	k.SetBuyOrderBook(ctx, buyBook)
+
+	sellBook := types.NewSellOrderBook("stake", "token")
+	sellBook.Index = pairIndex
+	_, err = sellBook.AppendOrder(creator, 10, 20, 0)
+	require.NoError(t, err)
+	k.SetSellOrderBook(ctx, sellBook)
+
+	// The orders of the creator are kept and the incoming orders are cancelled
+	sellAck, err := k.OnRecvSellOrderPacket(ctx, packet, types.SellOrderPacketData{
+		AmountDenom:         "stake",
+		Amount:              15,
+		PriceDenom:          "token",
+		Price:               18,
+		Seller:              creator,
+		SelfTradePrevention: types.CancelNewest,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.SellOrderPacketAck{PreventedAmount: 15}, sellAck)
+
+	buyAck, err := k.OnRecvBuyOrderPacket(ctx, packet, types.BuyOrderPacketData{
+		AmountDenom:         "stake",
+		Amount:              15,
+		PriceDenom:          "token",
+		Price:               22,
+		Buyer:               creator,
+		SelfTradePrevention: types.CancelNewest,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.BuyOrderPacketAck{PreventedAmount: 15}, buyAck)
+
+	got, found := k.GetBuyOrderBook(ctx, pairIndex)
+	require.True(t, found)
+	require.Equal(t, buyBook, got)
+	gotSell, found := k.GetSellOrderBook(ctx, pairIndex)
+	require.True(t, found)
+	require.Equal(t, sellBook, gotSell)
+
+	_, err = k.OnRecvSellOrderPacket(ctx, packet, types.SellOrderPacketData{
+		AmountDenom:         "stake",
+		Amount:              15,
+		PriceDenom:          "token",
+		Price:               18,
+		Seller:              creator,
+		SelfTradePrevention: 4,
+	})
+	require.Error(t, err)
+}
+
+func TestOnAcknowledgementOrderPacketSettlement(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	creator := sample.AccAddress()
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	require.NoError(t, err)
+	packet := channeltypes.Packet{SourcePort: "dex", SourceChannel: "channel-0", DestinationPort: "dex", DestinationChannel: "channel-1"}
+	f.FundEscrow("dex", "channel-0", sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("token", 1000))
+
+	sellOrder := types.SellOrderPacketData{AmountDenom: "stake", Amount: 10, PriceDenom: "token", Price: 5, Seller: creator}
+	sellAck := func(ack types.SellOrderPacketAck) channeltypes.Acknowledgement {
+		return channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(&ack))
+	}
+	buyOrder := types.BuyOrderPacketData{AmountDenom: "stake", Amount: 10, PriceDenom: "token", Price: 5, Buyer: creator}
+	buyAck := func(ack types.BuyOrderPacketAck) channeltypes.Acknowledgement {
+		return channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(&ack))
+	}
+
+	// An acknowledgement refunding more than the order is rejected and the escrow is left untouched
+	require.Error(t, f.Keeper.OnAcknowledgementSellOrderPacket(f.Ctx, packet, sellOrder, sellAck(types.SellOrderPacketAck{PreventedAmount: math.MaxInt32})))
+	require.Error(t, f.Keeper.OnAcknowledgementBuyOrderPacket(f.Ctx, packet, buyOrder, buyAck(types.BuyOrderPacketAck{PreventedAmount: 5, Purchase: 6})))
+	require.Error(t, f.Keeper.OnAcknowledgementBuyOrderPacket(f.Ctx, packet, buyOrder, buyAck(types.BuyOrderPacketAck{Purchase: 1, Refund: 1000})))
+	f.RequireEscrow("dex", "channel-0", "stake", 1000)
+	f.RequireEscrow("dex", "channel-0", "token", 1000)
+
+	// The amounts cancelled by the self-trade prevention are refunded
+	require.NoError(t, f.Keeper.OnAcknowledgementSellOrderPacket(f.Ctx, packet, sellOrder, sellAck(types.SellOrderPacketAck{PreventedAmount: 10})))
+	require.NoError(t, f.Keeper.OnAcknowledgementBuyOrderPacket(f.Ctx, packet, buyOrder, buyAck(types.BuyOrderPacketAck{PreventedAmount: 10})))
+	f.RequireBalance(creatorAddr, "stake", 10)
+	f.RequireBalance(creatorAddr, "token", 50)
+}
@@ -6,64 +6,88 @@ import (
 	"interchange/x/dex/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
-	host "github.com/cosmos/ibc-go/v2/modules/core/24-host"
+	"github.com/gogo/protobuf/proto"
 )
 
-// TransmitSellOrderPacket transmits the packet over IBC with the specified source port and source channel
-func (k Keeper) TransmitSellOrderPacket(
-	ctx sdk.Context,
-	packetData types.SellOrderPacketData,
-	sourcePort,
-	sourceChannel string,
-	timeoutHeight clienttypes.Height,
-	timeoutTimestamp uint64,
-) error {
-
-	sourceChannelEnd, found := k.ChannelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
-	if !found {
-		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
-	}
+// sellOrderPacketHandler routes SellOrderPacketData to the keeper callbacks
+var sellOrderPacketHandler = PacketHandler{
+	OnTransmit: func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData) error {
+		return k.OnTransmitSellOrderPacket(ctx, packet, *data.(*types.SellOrderPacketData))
+	},
+	OnRecv: func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData) (proto.Message, error) {
+		packetAck, err := k.OnRecvSellOrderPacket(ctx, packet, *data.(*types.SellOrderPacketData))
+		return &packetAck, err
+	},
+	OnAcknowledgement: func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData, ack channeltypes.Acknowledgement) error {
+		return k.OnAcknowledgementSellOrderPacket(ctx, packet, *data.(*types.SellOrderPacketData), ack)
+	},
+	OnTimeout: func(k Keeper, ctx sdk.Context, packet channeltypes.Packet, data types.PacketData) error {
+		return k.OnTimeoutSellOrderPacket(ctx, packet, *data.(*types.SellOrderPacketData))
+	},
+}
 
-	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
-	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+// sendSellOrder escrows the tokens of a sell order and sends its packet to the chain of the buy order book,
+// the denoms of the packet are the full paths of the denoms of the pair
+func (k Keeper) sendSellOrder(ctx sdk.Context, port string, channel string, packet types.SellOrderPacketData, timeoutTimestamp uint64) (uint64, error) {
+	//指定されたdenomペアのオーダーブックが存在することを確認します。
+	pairIndex := types.OrderBookIndex(port, channel, packet.AmountDenom, packet.PriceDenom)
+	if _, found := k.GetSellOrderBook(ctx, pairIndex); !found {
+		return 0, errors.New("the pair doesn't exist")
+	}
+	//ガバナンスで一時停止されている場合
+	if k.IsPairPaused(ctx, pairIndex) {
+		return 0, errors.New("the pair is paused")
+	}
 
-	// get the next sequence
-	sequence, found := k.ChannelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
-	if !found {
-		return sdkerrors.Wrapf(
-			channeltypes.ErrSequenceSendNotFound,
-			"source port: %s, source channel: %s", sourcePort, sourceChannel,
-		)
+	//送信者のアドレスを取得する
+	sender, err := sdk.AccAddressFromBech32(packet.Seller)
+	if err != nil {
+		return 0, err
 	}
 
-	channelCap, ok := k.ScopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
-	if !ok {
-		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	//SafeBurnを使用して、新しいネイティブトークンが作成されないようにする
+	if err := k.SafeBurn(ctx, port, channel, sender, LocalDenom(packet.AmountDenom), int64(packet.Amount)); err != nil {
+		return 0, err
 	}
 
-	packetBytes, err := packetData.GetBytes()
-	if err != nil {
-		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
+	//ターゲットチェーンで受け取ったバウチャーを保存(後で元に戻すことができるようにする)
+	if err := k.SaveVoucherDenom(ctx, port, channel, packet.AmountDenom); err != nil {
+		return 0, err
 	}
 
-	packet := channeltypes.NewPacket(
-		packetBytes,
-		sequence,
-		sourcePort,
-		sourceChannel,
-		destinationPort,
-		destinationChannel,
-		timeoutHeight,
+	//IBCパケットをターゲットチェーンに送信
+	return k.TransmitPacket(
+		ctx,
+		&packet,
+		port,
+		channel,
+		clienttypes.ZeroHeight(),
 		timeoutTimestamp,
 	)
+}
 
-	if err := k.ChannelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+// OnTransmitSellOrderPacket records the order as pending until the packet is acknowledged or times out
+func (k Keeper) OnTransmitSellOrderPacket(ctx sdk.Context, packet channeltypes.Packet, data types.SellOrderPacketData) error {
+	//注文ごとのデポジットをエスクローする
+	deposit, err := k.escrowOrderDeposit(ctx, data.Seller, 1)
+	if err != nil {
 		return err
 	}
 
+	k.SetPendingOrder(ctx, types.PendingOrder{
+		Port:        packet.SourcePort,
+		Channel:     packet.SourceChannel,
+		Sequence:    packet.Sequence,
+		Owner:       data.Seller,
+		OrderType:   types.OrderTypeSell,
+		AmountDenom: data.AmountDenom,
+		Amount:      data.Amount,
+		PriceDenom:  data.PriceDenom,
+		Price:       data.Price,
+		Deposit:     deposit,
+	})
 	return nil
 }
 
@@ -82,23 +106,58 @@ func (k Keeper) OnRecvSellOrderPacket(ctx sdk.Context, packet channeltypes.Packe
 		//ペアは存在しません
 		return packetAck, errors.New("the pair doesn't exist")
 	}
+	//ガバナンスで一時停止されている場合
+	if k.IsPairPaused(ctx, pairIndex) {
+		return packetAck, errors.New("the pair is paused")
+	}
 
-	//売り注文約定(売りオーダーブックを更新する)
-	remaining, liquidated, gain, _ := book.FillSellOrder(types.Order{
-		Amount: data.Amount,
-		Price:  data.Price,
-	})
+	//売り注文を約定し、残高と利益を返す
+	packetAck.RemainingAmount, packetAck.Gain, packetAck.PreventedAmount, err = k.fillSellOrder(ctx, packet, &book, data)
+	if err != nil {
+		return packetAck, err
+	}
 
-	//残高と利益を返す
-	packetAck.RemainingAmount = remaining.Amount
-	packetAck.Gain = gain
+	//新しい買いオーダーブックを保存する
+	k.SetBuyOrderBook(ctx, book)
+
+	return packetAck, nil
+}
+
+// fillSellOrder matches a received sell order against the buy order book and the liquidity pool and sends the sold tokens to the buyers,
+// the amount cancelled by the self-trade prevention is returned to be refunded to the seller
+func (k Keeper) fillSellOrder(ctx sdk.Context, packet channeltypes.Packet, book *types.BuyOrderBook, data types.SellOrderPacketData) (remainingAmount int32, gain int64, prevented int32, err error) {
+	//売り注文約定(買いオーダーブックと流動性プールを更新する)
+	pool, hasPool := k.GetLiquidityPool(ctx, book.Index)
+	var poolRef *types.LiquidityPool
+	if hasPool {
+		poolRef = &pool
+	}
+	remaining, liquidated, gain, selfTrade, swap := book.RouteSellOrder(types.Order{
+		Creator: data.Seller,
+		Amount:  data.Amount,
+		Price:   data.Price,
+	}, data.SelfTradePrevention, poolRef, k.PoolSwapFee(ctx))
+
+	//自己取引防止で取り消された買い注文を返金する
+	if err := k.refundSelfTradeOrders(ctx, packet, selfTrade.Cancelled, LocalDenom(book.PriceDenom), func(order types.Order) int64 {
+		return int64(order.Amount) * int64(order.Price)
+	}); err != nil {
+		return 0, 0, 0, err
+	}
+
+	//板から削除された買い注文のデポジットを返金する
+	for _, orders := range [][]types.Order{liquidated, selfTrade.Cancelled} {
+		if err := k.releaseClosedOrderDeposits(ctx, types.OrderTypeBuy, book.Index, book.Book, orders); err != nil {
+			return 0, 0, 0, err
+		}
+	}
 
 	//売上を分配する前に、デノムを解決します
 	//まず、受け取ったデノムが元々このチェーンからのものかどうかを確認
-	finalAmountDenom, saved := k.OriginalDenom(ctx, packet.SourcePort, packet.SourceChannel, data.AmountDenom)
+	finalAmountDenom, saved := k.OriginalDenom(ctx, packet.SourcePort, packet.SourceChannel, LocalDenom(data.AmountDenom))
 	if !saved {
 		//このチェーンからのものではない場合、バウチャーをデノムとして使用
-		VoucherDenom(packet.SourcePort, packet.SourceChannel, data.AmountDenom)
+		finalAmountDenom = k.MintVoucherDenom(ctx, packet.DestinationPort, packet.DestinationChannel, data.AmountDenom)
 	}
 
 	//販売したトークンを購入者に配布
@@ -107,7 +166,7 @@ func (k Keeper) OnRecvSellOrderPacket(ctx sdk.Context, packet channeltypes.Packe
 		liquidation := liquidation
 		addr, err := sdk.AccAddressFromBech32(liquidation.Creator)
 		if err != nil {
-			return packetAck, err
+			return 0, 0, 0, err
 		}
 		if err = k.SafeMint(
 			ctx,
@@ -115,39 +174,54 @@ func (k Keeper) OnRecvSellOrderPacket(ctx sdk.Context, packet channeltypes.Packe
 			packet.DestinationChannel,
 			addr,
 			finalAmountDenom,
-			liquidation.Amount,
+			int64(liquidation.Amount),
 		); err != nil {
-			return packetAck, err
+			return 0, 0, 0, err
 		}
 	}
 
-	//新しい買いオーダーブックを保存する
-	k.SetBuyOrderBook(ctx, book)
+	//プールが購入した数量をプールに送り、プールが支払った代金をエスクローする
+	trades := liquidated
+	if swap.Amount > 0 {
+		if err := k.SafeMint(ctx, packet.DestinationPort, packet.DestinationChannel, types.LiquidityPoolAddress(), pool.AmountDenom, int64(swap.Amount)); err != nil {
+			return 0, 0, 0, err
+		}
+		if err := k.SafeBurn(ctx, packet.DestinationPort, packet.DestinationChannel, types.LiquidityPoolAddress(), pool.PriceDenom, swap.Total); err != nil {
+			return 0, 0, 0, err
+		}
+		k.SetLiquidityPool(ctx, pool)
+		trades = append([]types.Order{swap.Trade()}, liquidated...)
+	}
 
-	return packetAck, nil
+	//約定価格を記録する
+	k.recordTrades(ctx, book.Index, trades)
+
+	for _, liquidation := range liquidated {
+		k.AfterOrderFilled(ctx, types.OrderTypeBuy, book.Index, liquidation, data.Seller)
+	}
+	for _, order := range selfTrade.Cancelled {
+		k.AfterOrderCancelled(ctx, types.OrderTypeBuy, book.Index, order)
+	}
+
+	return remaining.Amount, gain, selfTrade.Prevented, nil
 }
 
 // IBCパケットがターゲットチェーンで処理された後、
 // 確認応答がソースチェーンに返された後に行う処理
 func (k Keeper) OnAcknowledgementSellOrderPacket(ctx sdk.Context, packet channeltypes.Packet, data types.SellOrderPacketData, ack channeltypes.Acknowledgement) error {
+	//注文は処理済みのため、保留中の注文を削除
+	deposit := k.pendingOrderDeposit(ctx, packet)
+	k.RemovePendingOrder(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	//ルーティング注文の区間の場合、確認応答で次の区間に進む
+	routedOrder, routed := k.takeRoutedOrder(ctx, packet)
+
 	switch dispatchedAck := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		//エラーが発生した場合、ネイティブトークンを元に戻す
-		receiver, err := sdk.AccAddressFromBech32(data.Seller)
-		if err != nil {
-			return err
-		}
-		if err := k.SafeMint(
-			ctx,
-			packet.SourcePort,
-			packet.SourceChannel,
-			receiver,
-			data.AmountDenom,
-			data.Amount,
-		); err != nil {
+		//エラーが発生した場合、ネイティブトークンとデポジットを元に戻す
+		if err := k.refundSellOrder(ctx, packet, data); err != nil {
 			return err
 		}
-		return nil
+		return k.refundOrderDeposit(ctx, data.Seller, deposit, 1)
 	case *channeltypes.Acknowledgement_Result:
 		//パケット確認応答をデコードする
 		var packetAck types.SellOrderPacketAck
@@ -155,55 +229,124 @@ func (k Keeper) OnAcknowledgementSellOrderPacket(ctx sdk.Context, packet channel
 			// The counter-party module doesn't implement the correct acknowledgment format
 			return errors.New("cannot unmarshal acknowledgment")
 		}
+		//相手チェーンの確認応答が注文を超えて返金させないことを確認する
+		if err := packetAck.ValidateSettlement(data); err != nil {
+			return err
+		}
+		//相手チェーンで約定した数量を平均価格で記録する
+		k.recordSellSettlement(ctx, packet, data, packetAck)
+
+		if routed {
+			return k.settleRoutedSellLeg(ctx, packet, data, packetAck, deposit, routedOrder)
+		}
+		return k.settleSellOrder(ctx, packet, data, packetAck.RemainingAmount, packetAck.Gain, packetAck.PreventedAmount, deposit)
+	default:
+		// 相手方モジュールが正しい確認応答形式を実装していません
+		return errors.New("invalid acknowledgment format")
+	}
+}
 
+// settleSellOrder stores the remaining amount of an acknowledged sell order in the sell order book
+// and sends the gain and the amount cancelled by the self-trade prevention to the seller
+func (k Keeper) settleSellOrder(ctx sdk.Context, packet channeltypes.Packet, data types.SellOrderPacketData, remainingAmount int32, gain int64, prevented int32, deposit *sdk.Coin) error {
+	//販売されたトークンを購入者に配布
+	//売り手に販売された金額の価格を分配
+	// 注文の残りの金額を追加する
+	if remainingAmount > 0 {
 		//残りの売り注文を、売り注文帳に保管
 		pairIndex := types.OrderBookIndex(packet.SourcePort, packet.SourceChannel, data.AmountDenom, data.PriceDenom)
+		rests := false
 		book, found := k.GetSellOrderBook(ctx, pairIndex)
-		if !found {
-			//"売り注文帳が存在する必要があります"
-			panic("sell order book must exist")
-		}
-
-		//販売されたトークンを購入者に配布
-		//売り手に販売された金額の価格を分配
-		// 注文の残りの金額を追加する
-		if packetAck.RemainingAmount > 0 {
-			_, err := book.AppendOrder(data.Seller, packetAck.RemainingAmount, data.Price)
-			if err != nil {
+		if found {
+			orderID, err := book.AppendOrder(data.Seller, remainingAmount, data.Price, k.MaxOpenOrders(ctx))
+			switch {
+			case err == nil:
+				// 新しいオーダーブックを保存する
+				k.SetSellOrderBook(ctx, book)
+				//デポジットを板に残る注文に紐付ける
+				k.lockOrderDeposit(ctx, packet, types.OrderTypeSell, pairIndex, orderID, data.Seller, deposit)
+				deposit = nil
+				rests = true
+				k.AfterOrderPlaced(ctx, types.OrderTypeSell, pairIndex, types.Order{
+					Id:      orderID,
+					Creator: data.Seller,
+					Amount:  remainingAmount,
+					Price:   data.Price,
+				})
+			case !errors.Is(err, types.ErrMaxOpenOrders):
 				return err
 			}
-			// 新しいオーダーブックを保存する
-			k.SetSellOrderBook(ctx, book)
 		}
-
-		//エラーが発生した場合、焼き付けられたトークンをミント
-		if packetAck.Gain > 0 {
-			receiver, err := sdk.AccAddressFromBech32(data.Seller)
-			if err != nil {
+		if !rests {
+			//パケットの送信中にペアが上場廃止された場合、または注文数の上限に達した場合、残りの金額を返金する
+			remaining := data
+			remaining.Amount = remainingAmount
+			if err := k.refundSellOrder(ctx, packet, remaining); err != nil {
 				return err
 			}
-			finalPriceDenom, saved := k.OriginalDenom(ctx, packet.SourcePort, packet.SourceChannel, data.PriceDenom)
-			if !saved {
-				// このチェーンからのものではない場合、バウチャーをデノムとして使用します
-				finalPriceDenom = VoucherDenom(packet.DestinationPort, packet.DestinationChannel, data.PriceDenom)
-			}
+		}
+	}
 
-			if err := k.SafeMint(ctx, packet.SourcePort, packet.SourceChannel, receiver, finalPriceDenom, packetAck.Gain); err != nil {
-				return err
-			}
+	//板に残らない注文のデポジットを返金する
+	if err := k.refundOrderDeposit(ctx, data.Seller, deposit, 1); err != nil {
+		return err
+	}
+
+	//自己取引防止で取り消された数量を返金する
+	if prevented > 0 {
+		cancelled := data
+		cancelled.Amount = prevented
+		if err := k.refundSellOrder(ctx, packet, cancelled); err != nil {
+			return err
 		}
+	}
 
-		return nil
-	default:
-		// 相手方モジュールが正しい確認応答形式を実装していません
-		return errors.New("invalid acknowledgment format")
+	//エラーが発生した場合、焼き付けられたトークンをミント
+	if gain > 0 {
+		receiver, err := sdk.AccAddressFromBech32(data.Seller)
+		if err != nil {
+			return err
+		}
+		finalPriceDenom, saved := k.OriginalDenom(ctx, packet.DestinationPort, packet.DestinationChannel, LocalDenom(data.PriceDenom))
+		if !saved {
+			// このチェーンからのものではない場合、バウチャーをデノムとして使用します
+			finalPriceDenom = k.MintVoucherDenom(ctx, packet.SourcePort, packet.SourceChannel, data.PriceDenom)
+		}
+
+		if err := k.SafeMint(ctx, packet.SourcePort, packet.SourceChannel, receiver, finalPriceDenom, gain); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
 // OnTimeoutSellOrderPacket responds to the case where a packet has not been transmitted because of a timeout
 func (k Keeper) OnTimeoutSellOrderPacket(ctx sdk.Context, packet channeltypes.Packet, data types.SellOrderPacketData) error {
+	//注文は処理されなかったため、保留中の注文を削除してトークンを元に戻す
+	deposit := k.pendingOrderDeposit(ctx, packet)
+	k.RemovePendingOrder(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	//ルーティング注文の区間の場合、ルートはここで終わる
+	k.takeRoutedOrder(ctx, packet)
 
-	// TODO: packet timeout logic
+	if err := k.refundSellOrder(ctx, packet, data); err != nil {
+		return err
+	}
+	return k.refundOrderDeposit(ctx, data.Seller, deposit, 1)
+}
 
-	return nil
+// refundSellOrder returns the tokens escrowed when the order was sent
+func (k Keeper) refundSellOrder(ctx sdk.Context, packet channeltypes.Packet, data types.SellOrderPacketData) error {
+	receiver, err := sdk.AccAddressFromBech32(data.Seller)
+	if err != nil {
+		return err
+	}
+	return k.SafeMint(
+		ctx,
+		packet.SourcePort,
+		packet.SourceChannel,
+		receiver,
+		LocalDenom(data.AmountDenom),
+		int64(data.Amount),
+	)
 }
@@ -8,11 +8,20 @@ import (
 
 // SetSellOrderBook set a specific sellOrderBook in the store from its index
 func (k Keeper) SetSellOrderBook(ctx sdk.Context, sellOrderBook types.SellOrderBook) {
+	previous, _ := k.GetSellOrderBook(ctx, sellOrderBook.Index)
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SellOrderBookKeyPrefix))
-	b := k.cdc.MustMarshal(&sellOrderBook)
-	store.Set(types.SellOrderBookKey(
+	key := types.SellOrderBookKey(
 		sellOrderBook.Index,
-	), b)
+	)
+	b := k.cdc.MustMarshal(&sellOrderBook)
+	store.Set(key, b)
+
+	// index the book by the owners of its orders
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SellOrderBookOwnerKeyPrefix))
+	updateOwnerIndex(ownerStore, types.SellOrderBookOwnerKey, key, previous.Book, sellOrderBook.Book)
+
+	k.afterOrderBookChanged(ctx, types.OrderTypeSell, sellOrderBook.Index, previous.Book, sellOrderBook.Book)
 }
 
 // GetSellOrderBook returns a sellOrderBook from its index
@@ -40,10 +49,23 @@ func (k Keeper) RemoveSellOrderBook(
 	index string,
 
 ) {
+	sellOrderBook, found := k.GetSellOrderBook(ctx, index)
+	if !found {
+		return
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SellOrderBookKeyPrefix))
-	store.Delete(types.SellOrderBookKey(
+	key := types.SellOrderBookKey(
 		index,
-	))
+	)
+	store.Delete(key)
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SellOrderBookOwnerKeyPrefix))
+	for _, owner := range sellOrderBook.Book.Owners() {
+		ownerStore.Delete(append(types.SellOrderBookOwnerKey(owner), key...))
+	}
+
+	k.afterOrderBookChanged(ctx, types.OrderTypeSell, index, sellOrderBook.Book, nil)
 }
 
 // GetAllSellOrderBook returns all sellOrderBook
@@ -61,3 +83,46 @@ func (k Keeper) GetAllSellOrderBook(ctx sdk.Context) (list []types.SellOrderBook
 
 	return
 }
+
+// GetSellOrderBooksByOwner returns all sellOrderBook with orders of an owner
+func (k Keeper) GetSellOrderBooksByOwner(ctx sdk.Context, owner string) (list []types.SellOrderBook) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SellOrderBookKeyPrefix))
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SellOrderBookOwnerKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(ownerStore, types.SellOrderBookOwnerKey(owner))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SellOrderBook
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// updateOwnerIndex indexes the book by the owners whose orders changed from the previous book,
+// adding the owners of new orders and dropping the owners left without orders
+func updateOwnerIndex(ownerStore prefix.Store, ownerKey func(string) []byte, key []byte, previous *types.OrderBook, book *types.OrderBook) {
+	changed := previous.ChangedOwners(book)
+	if len(changed) == 0 {
+		return
+	}
+
+	wasOwner := make(map[string]bool)
+	for _, owner := range previous.Owners() {
+		wasOwner[owner] = true
+	}
+	isOwner := make(map[string]bool)
+	for _, owner := range book.Owners() {
+		isOwner[owner] = true
+	}
+	for _, owner := range changed {
+		switch {
+		case isOwner[owner] && !wasOwner[owner]:
+			ownerStore.Set(append(ownerKey(owner), key...), key)
+		case !isOwner[owner] && wasOwner[owner]:
+			ownerStore.Delete(append(ownerKey(owner), key...))
+		}
+	}
+}
@@ -0,0 +1,318 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
+	"interchange/x/dex/types"
+)
+
+// GetTriggerOrderCount get the total number of triggerOrder
+func (k Keeper) GetTriggerOrderCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.TriggerOrderCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetTriggerOrderCount set the total number of triggerOrder
+func (k Keeper) SetTriggerOrderCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.TriggerOrderCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendTriggerOrder appends a triggerOrder in the store with a new id and update the count
+func (k Keeper) AppendTriggerOrder(
+	ctx sdk.Context,
+	triggerOrder types.TriggerOrder,
+) uint64 {
+	// Create the triggerOrder
+	count := k.GetTriggerOrderCount(ctx)
+
+	// Set the ID of the appended value
+	triggerOrder.Id = count
+
+	k.SetTriggerOrder(ctx, triggerOrder)
+
+	// Update triggerOrder count
+	k.SetTriggerOrderCount(ctx, count+1)
+
+	return count
+}
+
+// SetTriggerOrder set a specific triggerOrder in the store
+func (k Keeper) SetTriggerOrder(ctx sdk.Context, triggerOrder types.TriggerOrder) {
+	// drop the indexes of the record being replaced
+	k.RemoveTriggerOrder(ctx, triggerOrder.Id)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderKeyPrefix))
+	key := types.TriggerOrderKey(triggerOrder.Id)
+	b := k.cdc.MustMarshal(&triggerOrder)
+	store.Set(key, b)
+
+	// index the trigger order in its pair by trigger price
+	pairStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderPairKeyPrefix))
+	pairStore.Set(triggerOrderPairKey(triggerOrder), key)
+
+	k.setCreatorTriggerOrderCount(ctx, triggerOrder.Creator, k.GetCreatorTriggerOrderCount(ctx, triggerOrder.Creator)+1)
+}
+
+// GetTriggerOrder returns a triggerOrder from its id
+func (k Keeper) GetTriggerOrder(ctx sdk.Context, id uint64) (val types.TriggerOrder, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderKeyPrefix))
+	b := store.Get(types.TriggerOrderKey(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveTriggerOrder removes a triggerOrder from the store
+func (k Keeper) RemoveTriggerOrder(ctx sdk.Context, id uint64) {
+	triggerOrder, found := k.GetTriggerOrder(ctx, id)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderKeyPrefix))
+	store.Delete(types.TriggerOrderKey(id))
+
+	pairStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderPairKeyPrefix))
+	pairStore.Delete(triggerOrderPairKey(triggerOrder))
+
+	k.setCreatorTriggerOrderCount(ctx, triggerOrder.Creator, k.GetCreatorTriggerOrderCount(ctx, triggerOrder.Creator)-1)
+}
+
+// GetAllTriggerOrder returns all triggerOrder
+func (k Keeper) GetAllTriggerOrder(ctx sdk.Context) (list []types.TriggerOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TriggerOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetTriggerOrdersByPair returns the triggerOrder of a pair, by side and trigger price then in creation order
+func (k Keeper) GetTriggerOrdersByPair(ctx sdk.Context, pairIndex string) (list []types.TriggerOrder) {
+	pairStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderPairKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(pairStore, types.TriggerOrderPairKey(pairIndex))
+	return k.collectTriggerOrders(ctx, iterator, -1)
+}
+
+// GetTriggeredOrders returns at most limit triggerOrder of a pair reached by the last price,
+// only the orders crossed by the price are read from the pair index
+func (k Keeper) GetTriggeredOrders(ctx sdk.Context, pairIndex string, lastPrice int32, limit int) []types.TriggerOrder {
+	pairStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderPairKeyPrefix))
+
+	//価格が下落して発動する注文はトリガー価格が最終価格以上のもの
+	onFall := types.TriggerOrderSideKey(pairIndex, true)
+	list := k.collectTriggerOrders(ctx, pairStore.Iterator(
+		types.TriggerOrderPriceKey(pairIndex, true, lastPrice),
+		sdk.PrefixEndBytes(onFall),
+	), limit)
+
+	//価格が上昇して発動する注文はトリガー価格が最終価格以下のもの
+	if limit >= 0 {
+		limit -= len(list)
+	}
+	onRise := types.TriggerOrderSideKey(pairIndex, false)
+	return append(list, k.collectTriggerOrders(ctx, pairStore.Iterator(
+		onRise,
+		types.TriggerOrderPriceKey(pairIndex, false, lastPrice+1),
+	), limit)...)
+}
+
+// collectTriggerOrders reads at most limit triggerOrder from an iterator of the pair index,
+// a negative limit reads all of them
+func (k Keeper) collectTriggerOrders(ctx sdk.Context, iterator sdk.Iterator, limit int) (list []types.TriggerOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderKeyPrefix))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && limit != 0; iterator.Next() {
+		var val types.TriggerOrder
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &val)
+		list = append(list, val)
+		limit--
+	}
+
+	return
+}
+
+// GetCreatorTriggerOrderCount returns the number of open triggerOrder of a creator
+func (k Keeper) GetCreatorTriggerOrderCount(ctx sdk.Context, creator string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderCreatorKeyPrefix))
+	bz := store.Get(types.TriggerOrderCreatorKey(creator))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setCreatorTriggerOrderCount sets the number of open triggerOrder of a creator
+func (k Keeper) setCreatorTriggerOrderCount(ctx sdk.Context, creator string, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderCreatorKeyPrefix))
+	if count == 0 {
+		store.Delete(types.TriggerOrderCreatorKey(creator))
+		return
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.TriggerOrderCreatorKey(creator), bz)
+}
+
+// triggerOrderPairKey returns the key of a triggerOrder in the pair index
+func triggerOrderPairKey(triggerOrder types.TriggerOrder) []byte {
+	if triggerOrder.Failed {
+		return append(types.TriggerOrderFailedKey(triggerOrder.PairIndex), types.TriggerOrderKey(triggerOrder.Id)...)
+	}
+	key := types.TriggerOrderPriceKey(triggerOrder.PairIndex, triggerOrder.TriggersOnFall(), triggerOrder.TriggerPrice)
+	return append(key, types.TriggerOrderKey(triggerOrder.Id)...)
+}
+
+// ExecuteTriggerOrders sends the trigger orders reached by the last price of their pair,
+// it is called at the end of every block and sends at most MaxTriggerOrderExecutions orders,
+// the orders that can neither be sent nor refunded are marked as failed
+func (k Keeper) ExecuteTriggerOrders(ctx sdk.Context) {
+	remaining := types.MaxTriggerOrderExecutions
+	for _, lastPrice := range k.GetAllLastPrice(ctx) {
+		if remaining == 0 {
+			return
+		}
+
+		//一時停止中のペアの注文は再開されるまで保持する
+		if k.IsPairPaused(ctx, lastPrice.Index) {
+			continue
+		}
+
+		for _, order := range k.GetTriggeredOrders(ctx, lastPrice.Index, lastPrice.Price, remaining) {
+			remaining--
+
+			//通常の注文として送信する
+			cacheCtx, write := ctx.CacheContext()
+			k.RemoveTriggerOrder(cacheCtx, order.Id)
+			err := k.sendTriggerOrder(cacheCtx, order)
+			if err != nil {
+				k.Logger(ctx).Error("cannot send trigger order", "id", order.Id, "error", err)
+
+				//送信できない注文はエスクローを返金する
+				cacheCtx, write = ctx.CacheContext()
+				k.RemoveTriggerOrder(cacheCtx, order.Id)
+				err = k.refundTriggerOrder(cacheCtx, order)
+			}
+			if err != nil {
+				k.Logger(ctx).Error("cannot refund trigger order", "id", order.Id, "error", err)
+
+				//返金もできない注文は失敗として残し、発動の対象から外す
+				order.Failed = true
+				k.SetTriggerOrder(ctx, order)
+				if err := ctx.EventManager().EmitTypedEvent(&types.EventTriggerOrderFailed{
+					Id:      order.Id,
+					Creator: order.Creator,
+					Reason:  err.Error(),
+				}); err != nil {
+					k.Logger(ctx).Error("cannot emit the event of a failed trigger order", "error", err)
+				}
+				continue
+			}
+			write()
+		}
+	}
+}
+
+// RefundTriggerOrdersByPair removes the trigger orders of a pair and refunds their escrow
+func (k Keeper) RefundTriggerOrdersByPair(ctx sdk.Context, pairIndex string) error {
+	for _, order := range k.GetTriggerOrdersByPair(ctx, pairIndex) {
+		k.RemoveTriggerOrder(ctx, order.Id)
+		if err := k.refundTriggerOrder(ctx, order); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sendTriggerOrder transmits a triggered order, its tokens have been escrowed when it was placed
+func (k Keeper) sendTriggerOrder(ctx sdk.Context, order types.TriggerOrder) error {
+	var packet types.PacketData
+	if order.OrderType == types.OrderTypeSell {
+		//パケットではIBCバウチャーをフルパスのdenomで送る
+		amountDenom, err := k.FullDenomPath(ctx, order.AmountDenom)
+		if err != nil {
+			return err
+		}
+		packet = &types.SellOrderPacketData{
+			AmountDenom: amountDenom,
+			Amount:      order.Amount,
+			PriceDenom:  order.PriceDenom,
+			Price:       order.OrderPrice(),
+			Seller:      order.Creator,
+		}
+	} else {
+		//パケットではIBCバウチャーをフルパスのdenomで送る
+		priceDenom, err := k.FullDenomPath(ctx, order.PriceDenom)
+		if err != nil {
+			return err
+		}
+		packet = &types.BuyOrderPacketData{
+			AmountDenom: order.AmountDenom,
+			Amount:      order.Amount,
+			PriceDenom:  priceDenom,
+			Price:       order.OrderPrice(),
+			Buyer:       order.Creator,
+		}
+	}
+
+	_, err := k.TransmitPacket(
+		ctx,
+		packet,
+		order.Port,
+		order.Channel,
+		clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().UnixNano())+order.PacketTimeout,
+	)
+	return err
+}
+
+// escrowTriggerOrder escrows the tokens of a trigger order when it is placed
+func (k Keeper) escrowTriggerOrder(ctx sdk.Context, order types.TriggerOrder) error {
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return err
+	}
+	if order.OrderType == types.OrderTypeSell {
+		return k.SafeBurn(ctx, order.Port, order.Channel, creator, order.AmountDenom, int64(order.Amount))
+	}
+	return k.SafeBurn(ctx, order.Port, order.Channel, creator, order.PriceDenom, int64(order.Amount)*int64(order.OrderPrice()))
+}
+
+// refundTriggerOrder returns the tokens escrowed when the trigger order was placed
+func (k Keeper) refundTriggerOrder(ctx sdk.Context, order types.TriggerOrder) error {
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return err
+	}
+	if order.OrderType == types.OrderTypeSell {
+		return k.SafeMint(ctx, order.Port, order.Channel, creator, order.AmountDenom, int64(order.Amount))
+	}
+	return k.SafeMint(ctx, order.Port, order.Channel, creator, order.PriceDenom, int64(order.Amount)*int64(order.OrderPrice()))
+}
@@ -0,0 +1,271 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	keepertest "interchange/testutil/keeper"
+	"interchange/testutil/nullify"
+	"interchange/testutil/sample"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+func createNTriggerOrder(keeper *keeper.Keeper, ctx sdk.Context, pairIndex string, n int) []types.TriggerOrder {
+	items := make([]types.TriggerOrder, n)
+	for i := range items {
+		items[i] = types.TriggerOrder{
+			Creator:      sample.AccAddress(),
+			Port:         "dex",
+			Channel:      "channel-0",
+			OrderType:    types.OrderTypeSell,
+			TriggerType:  types.StopLimit,
+			PairIndex:    pairIndex,
+			AmountDenom:  "stake",
+			PriceDenom:   "token",
+			Amount:       10,
+			TriggerPrice: 20,
+			Price:        18,
+		}
+		items[i].Id = keeper.AppendTriggerOrder(ctx, items[i])
+	}
+	return items
+}
+
+func TestTriggerOrderGet(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNTriggerOrder(keeper, ctx, "pair", 10)
+	for _, item := range items {
+		got, found := keeper.GetTriggerOrder(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestTriggerOrderRemove(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNTriggerOrder(keeper, ctx, "pair", 10)
+	for _, item := range items {
+		keeper.RemoveTriggerOrder(ctx, item.Id)
+		_, found := keeper.GetTriggerOrder(ctx, item.Id)
+		require.False(t, found)
+	}
+	require.Empty(t, keeper.GetTriggerOrdersByPair(ctx, "pair"))
+}
+
+func TestTriggerOrderGetAll(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNTriggerOrder(keeper, ctx, "pair", 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllTriggerOrder(ctx)),
+	)
+}
+
+func TestTriggerOrderCount(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNTriggerOrder(keeper, ctx, "pair", 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetTriggerOrderCount(ctx))
+}
+
+func TestTriggerOrderGetByPair(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNTriggerOrder(keeper, ctx, "pair", 5)
+	createNTriggerOrder(keeper, ctx, "other", 5)
+
+	// The orders of a pair at the same trigger price are in creation order
+	require.Equal(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetTriggerOrdersByPair(ctx, "pair")),
+	)
+}
+
+func TestExecuteTriggerOrdersNotTriggered(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	items := createNTriggerOrder(k, ctx, pairIndex, 3)
+
+	// Without trade the orders are kept
+	k.ExecuteTriggerOrders(ctx)
+	require.Len(t, k.GetTriggerOrdersByPair(ctx, pairIndex), 3)
+
+	// The last price is above the stop price
+	k.SetLastPrice(ctx, types.LastPrice{Index: pairIndex, Price: 21})
+	k.ExecuteTriggerOrders(ctx)
+	require.Len(t, k.GetTriggerOrdersByPair(ctx, pairIndex), 3)
+
+	// The orders of a paused pair are kept until it is resumed
+	k.SetLastPrice(ctx, types.LastPrice{Index: pairIndex, Price: 20})
+	k.SetPairPaused(ctx, pairIndex, true)
+	k.ExecuteTriggerOrders(ctx)
+	require.Equal(t,
+		nullify.Fill(items),
+		nullify.Fill(k.GetTriggerOrdersByPair(ctx, pairIndex)),
+	)
+}
+
+func TestGetTriggeredOrders(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	var stops, takeProfits []types.TriggerOrder
+	for _, price := range []int32{30, 10, 20} {
+		order := types.TriggerOrder{
+			Creator:      sample.AccAddress(),
+			OrderType:    types.OrderTypeSell,
+			TriggerType:  types.StopLimit,
+			PairIndex:    pairIndex,
+			Amount:       10,
+			TriggerPrice: price,
+			Price:        price,
+		}
+		order.Id = k.AppendTriggerOrder(ctx, order)
+		stops = append(stops, order)
+		order.TriggerType = types.TakeProfit
+		order.Id = k.AppendTriggerOrder(ctx, order)
+		takeProfits = append(takeProfits, order)
+	}
+	createNTriggerOrder(k, ctx, "other", 3)
+
+	// Stop sell orders are reached when the price falls to their trigger price,
+	// take-profit sell orders when it rises to it
+	require.Equal(t,
+		nullify.Fill([]types.TriggerOrder{stops[2], stops[0], takeProfits[1], takeProfits[2]}),
+		nullify.Fill(k.GetTriggeredOrders(ctx, pairIndex, 20, -1)),
+	)
+	require.Equal(t,
+		nullify.Fill([]types.TriggerOrder{stops[1], stops[2], stops[0]}),
+		nullify.Fill(k.GetTriggeredOrders(ctx, pairIndex, 5, -1)),
+	)
+	require.Equal(t,
+		nullify.Fill([]types.TriggerOrder{stops[2], stops[0], takeProfits[1]}),
+		nullify.Fill(k.GetTriggeredOrders(ctx, pairIndex, 20, 3)),
+	)
+	require.Empty(t, k.GetTriggeredOrders(ctx, pairIndex, 20, 0))
+}
+
+func TestLastPrice(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	_, found := k.GetLastPrice(ctx, "pair")
+	require.False(t, found)
+
+	lastPrice := types.LastPrice{Index: "pair", Price: 15, Height: 3}
+	k.SetLastPrice(ctx, lastPrice)
+	got, found := k.GetLastPrice(ctx, "pair")
+	require.True(t, found)
+	require.Equal(t, lastPrice, got)
+	require.Equal(t, []types.LastPrice{lastPrice}, k.GetAllLastPrice(ctx))
+}
+
+func TestLastPriceSettlement(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	packet := channeltypes.Packet{SourcePort: "dex", SourceChannel: "channel-0", DestinationPort: "dex", DestinationChannel: "channel-1"}
+	f.FundEscrow("dex", "channel-0", sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("token", 1000))
+
+	// A sell order filled on the counterparty chain records the average price of its gain
+	sellAck := types.SellOrderPacketAck{RemainingAmount: 2, Gain: 44}
+	require.NoError(t, f.Keeper.OnAcknowledgementSellOrderPacket(f.Ctx, packet, types.SellOrderPacketData{
+		AmountDenom: "stake",
+		Amount:      10,
+		PriceDenom:  "token",
+		Price:       5,
+		Seller:      sample.AccAddress(),
+	}, channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(&sellAck))))
+	lastPrice, found := f.Keeper.GetLastPrice(f.Ctx, pairIndex)
+	require.True(t, found)
+	require.Equal(t, int32(6), lastPrice.Price)
+
+	// A buy order filled on the counterparty chain records the average price paid
+	buyAck := types.BuyOrderPacketAck{Purchase: 4, Refund: 4}
+	require.NoError(t, f.Keeper.OnAcknowledgementBuyOrderPacket(f.Ctx, packet, types.BuyOrderPacketData{
+		AmountDenom: "stake",
+		Amount:      4,
+		PriceDenom:  "token",
+		Price:       5,
+		Buyer:       sample.AccAddress(),
+	}, channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(&buyAck))))
+	lastPrice, found = f.Keeper.GetLastPrice(f.Ctx, pairIndex)
+	require.True(t, found)
+	require.Equal(t, int32(4), lastPrice.Price)
+}
+
+func TestExecuteTriggerOrdersFailed(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	srv := keeper.NewMsgServerImpl(*f.Keeper)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	items := createNTriggerOrder(f.Keeper, f.Ctx, pairIndex, 1)
+
+	// An order that can neither be sent nor refunded is marked as failed and no longer triggered
+	f.Keeper.SetLastPrice(f.Ctx, types.LastPrice{Index: pairIndex, Price: 20})
+	f.Keeper.ExecuteTriggerOrders(f.Ctx)
+	order, found := f.Keeper.GetTriggerOrder(f.Ctx, items[0].Id)
+	require.True(t, found)
+	require.True(t, order.Failed)
+	require.Empty(t, f.Keeper.GetTriggeredOrders(f.Ctx, pairIndex, 20, -1))
+	require.Len(t, f.Keeper.GetTriggerOrdersByPair(f.Ctx, pairIndex), 1)
+
+	var failed *types.EventTriggerOrderFailed
+	for _, event := range f.Ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(&types.EventTriggerOrderFailed{}) {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			failed = msg.(*types.EventTriggerOrderFailed)
+		}
+	}
+	require.NotNil(t, failed)
+	require.Equal(t, items[0].Id, failed.Id)
+	require.Equal(t, items[0].Creator, failed.Creator)
+
+	// The escrow of a failed order is refunded when it is cancelled
+	f.FundEscrow("dex", "channel-0", sdk.NewInt64Coin("stake", 10))
+	_, err := srv.CancelTriggerOrder(sdk.WrapSDKContext(f.Ctx), types.NewMsgCancelTriggerOrder(items[0].Creator, items[0].Id))
+	require.NoError(t, err)
+	creator, err := sdk.AccAddressFromBech32(items[0].Creator)
+	require.NoError(t, err)
+	f.RequireBalance(creator, "stake", 10)
+	require.Empty(t, f.Keeper.GetTriggerOrdersByPair(f.Ctx, pairIndex))
+}
+
+func TestMsgServerTriggerOrder(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := sample.AccAddress()
+
+	msg := types.NewMsgPlaceTriggerOrder(creator, "dex", "channel-0", 100, types.OrderTypeBuy, types.TakeProfit, "stake", "token", 10, 20, 20)
+	_, err := srv.PlaceTriggerOrder(wctx, msg)
+	require.EqualError(t, err, "the pair doesn't exist")
+
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	book := types.NewBuyOrderBook("stake", "token")
+	book.Index = pairIndex
+	k.SetBuyOrderBook(ctx, book)
+	k.SetPairPaused(ctx, pairIndex, true)
+	_, err = srv.PlaceTriggerOrder(wctx, msg)
+	require.EqualError(t, err, "the pair is paused")
+
+	items := createNTriggerOrder(k, ctx, pairIndex, 1)
+	_, err = srv.CancelTriggerOrder(wctx, types.NewMsgCancelTriggerOrder(creator, items[0].Id))
+	require.EqualError(t, err, "canceller must be creator")
+	_, err = srv.CancelTriggerOrder(wctx, types.NewMsgCancelTriggerOrder(creator, 10))
+	require.ErrorIs(t, err, types.ErrOrderNotFound)
+
+	// The number of open trigger orders of a creator is bounded
+	k.SetPairPaused(ctx, pairIndex, false)
+	for i := 0; i < types.MaxTriggerOrdersPerCreator; i++ {
+		k.AppendTriggerOrder(ctx, types.TriggerOrder{Creator: creator, PairIndex: pairIndex})
+	}
+	require.Equal(t, uint64(types.MaxTriggerOrdersPerCreator), k.GetCreatorTriggerOrderCount(ctx, creator))
+	_, err = srv.PlaceTriggerOrder(wctx, msg)
+	require.ErrorIs(t, err, types.ErrTooManyTriggerOrders)
+	k.RemoveTriggerOrder(ctx, items[0].Id+1)
+	require.Equal(t, uint64(types.MaxTriggerOrdersPerCreator-1), k.GetCreatorTriggerOrderCount(ctx, creator))
+}
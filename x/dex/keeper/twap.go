@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"interchange/x/dex/types"
+)
+
+var _ types.PriceOracle = Keeper{}
+
+// SetTWAPRecord set a specific twapRecord in the store from its index
+func (k Keeper) SetTWAPRecord(ctx sdk.Context, twapRecord types.TWAPRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TWAPRecordKeyPrefix))
+	b := k.cdc.MustMarshal(&twapRecord)
+	store.Set(types.TWAPRecordKey(
+		twapRecord.PairIndex,
+		twapRecord.Time,
+	), b)
+}
+
+// GetTWAPRecordAt returns the last twapRecord of a pair recorded at or before the time
+func (k Keeper) GetTWAPRecordAt(ctx sdk.Context, pairIndex string, t time.Time) (val types.TWAPRecord, found bool) {
+	store := k.twapPairStore(ctx, pairIndex)
+	iterator := store.ReverseIterator(nil, sdk.PrefixEndBytes(timeKey(t)))
+
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+	return val, true
+}
+
+// GetAllTWAPRecord returns all twapRecord
+func (k Keeper) GetAllTWAPRecord(ctx sdk.Context) (list []types.TWAPRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TWAPRecordKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TWAPRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetTWAP returns the time weighted average price of a pair between two times,
+// the end cannot be after the block time and the pair must have traded before the start
+func (k Keeper) GetTWAP(ctx sdk.Context, pairIndex string, start time.Time, end time.Time) (sdk.Dec, error) {
+	if !start.Before(end) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTWAPRange, "start %s must be before end %s", start, end)
+	}
+	if end.After(ctx.BlockTime()) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTWAPRange, "end %s cannot be after the block time %s", end, ctx.BlockTime())
+	}
+
+	//開始時刻と終了時刻の累積価格の差を期間で割る
+	startRecord, found := k.GetTWAPRecordAt(ctx, pairIndex, start)
+	if !found {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrTWAPUnavailable, "no trade of %s recorded before %s", pairIndex, start)
+	}
+	endRecord, _ := k.GetTWAPRecordAt(ctx, pairIndex, end)
+
+	cumulative := endRecord.CumulativePriceAt(end).Sub(startRecord.CumulativePriceAt(start))
+	return cumulative.Quo(types.DurationToDec(end.Sub(start))), nil
+}
+
+// updateTWAP accumulates the previous price of a pair for the time elapsed since the last update,
+// records the new price and prunes the records past the retention
+func (k Keeper) updateTWAP(ctx sdk.Context, index string, price int32) {
+	now := ctx.BlockTime()
+
+	record := types.TWAPRecord{
+		PairIndex:       index,
+		Time:            now,
+		Price:           price,
+		CumulativePrice: sdk.ZeroDec(),
+	}
+	if last, found := k.GetTWAPRecordAt(ctx, index, now); found {
+		record.CumulativePrice = last.CumulativePriceAt(now)
+	}
+	k.SetTWAPRecord(ctx, record)
+
+	//保持期間より前の記録は、保持期間の開始時刻の累積価格に必要な最後の記録を除いて削除する
+	store := k.twapPairStore(ctx, index)
+	iterator := store.Iterator(nil, timeKey(now.Add(-types.TWAPRetention)))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for i := 0; i < len(keys)-1; i++ {
+		store.Delete(keys[i])
+	}
+}
+
+func (k Keeper) twapPairStore(ctx sdk.Context, pairIndex string) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TWAPRecordKeyPrefix))
+	return prefix.NewStore(store, types.TWAPRecordPairKey(pairIndex))
+}
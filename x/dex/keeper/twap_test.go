@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/x/dex/types"
+)
+
+func TestUpdateTWAP(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+
+	// The price of the last trade of a block is recorded
+	matchTrades(t, f, pairIndex, candleBaseTime, types.Order{Amount: 1, Price: 10})
+	matchTrades(t, f, pairIndex, candleBaseTime, types.Order{Amount: 1, Price: 12})
+	matchTrades(t, f, pairIndex, candleBaseTime.Add(10*time.Second), types.Order{Amount: 1, Price: 20})
+	matchTrades(t, f, pairIndex, candleBaseTime.Add(40*time.Second), types.Order{Amount: 1, Price: 5})
+	require.Equal(t, []types.TWAPRecord{
+		{PairIndex: pairIndex, Time: candleBaseTime, Price: 12, CumulativePrice: sdk.ZeroDec()},
+		{PairIndex: pairIndex, Time: candleBaseTime.Add(10 * time.Second), Price: 20, CumulativePrice: sdk.NewDec(120)},
+		{PairIndex: pairIndex, Time: candleBaseTime.Add(40 * time.Second), Price: 5, CumulativePrice: sdk.NewDec(720)},
+	}, f.Keeper.GetAllTWAPRecord(f.Ctx))
+
+	ctx := f.Ctx.WithBlockTime(candleBaseTime.Add(time.Minute))
+	for _, tc := range []struct {
+		desc  string
+		start time.Duration
+		end   time.Duration
+		twap  sdk.Dec
+		err   error
+	}{
+		{
+			desc:  "between records",
+			start: 0,
+			end:   40 * time.Second,
+			twap:  sdk.NewDec(18),
+		},
+		{
+			desc:  "within records",
+			start: 5 * time.Second,
+			end:   20 * time.Second,
+			twap:  sdk.MustNewDecFromStr("17.333333333333333333"),
+		},
+		{
+			desc:  "after last record",
+			start: 40 * time.Second,
+			end:   time.Minute,
+			twap:  sdk.NewDec(5),
+		},
+		{
+			desc:  "before first record",
+			start: -time.Second,
+			end:   time.Minute,
+			err:   types.ErrTWAPUnavailable,
+		},
+		{
+			desc:  "empty range",
+			start: 10 * time.Second,
+			end:   10 * time.Second,
+			err:   types.ErrInvalidTWAPRange,
+		},
+		{
+			desc:  "end after block time",
+			start: 10 * time.Second,
+			end:   2 * time.Minute,
+			err:   types.ErrInvalidTWAPRange,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			twap, err := f.Keeper.GetTWAP(ctx, pairIndex, candleBaseTime.Add(tc.start), candleBaseTime.Add(tc.end))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tc.twap.Equal(twap), "expected %s, got %s", tc.twap, twap)
+		})
+	}
+}
+
+func TestUpdateTWAPRetention(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+
+	matchTrades(t, f, pairIndex, candleBaseTime, types.Order{Amount: 1, Price: 10})
+	matchTrades(t, f, pairIndex, candleBaseTime.Add(time.Hour), types.Order{Amount: 1, Price: 20})
+	matchTrades(t, f, pairIndex, candleBaseTime.Add(2*time.Hour), types.Order{Amount: 1, Price: 30})
+	end := candleBaseTime.Add(types.TWAPRetention + 90*time.Minute)
+	matchTrades(t, f, pairIndex, end, types.Order{Amount: 1, Price: 40})
+
+	// The last record before the retention is kept to compute the TWAP from the start of the retention
+	records := f.Keeper.GetAllTWAPRecord(f.Ctx)
+	require.Len(t, records, 3)
+	require.Equal(t, candleBaseTime.Add(time.Hour), records[0].Time)
+
+	ctx := f.Ctx.WithBlockTime(end)
+	twap, err := f.Keeper.GetTWAP(ctx, pairIndex, end.Add(-types.TWAPRetention), end)
+	require.NoError(t, err)
+	// 30 minutes at 20 then the rest at 30
+	expected := sdk.NewDec(20*30 + 30*(int64(types.TWAPRetention/time.Minute)-30)).QuoInt64(int64(types.TWAPRetention / time.Minute))
+	require.True(t, expected.Equal(twap), "expected %s, got %s", expected, twap)
+
+	_, err = f.Keeper.GetTWAP(ctx, pairIndex, candleBaseTime, end)
+	require.ErrorIs(t, err, types.ErrTWAPUnavailable)
+}
@@ -141,75 +141,33 @@ func (am AppModule) OnRecvPacket(
 
 	// this line is used by starport scaffolding # oracle/packet/module/recv
 
-	var modulePacketData types.DexPacketData
-	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
-		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error()).Error())
+	// Dispatch packet
+	packetData, handler, err := am.keeper.ResolvePacket(modulePacket.GetData())
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+	if err := am.keeper.CheckPacketEnabled(ctx, packetData.Type()); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err.Error())
 	}
 
-	// Dispatch packet
-	switch packet := modulePacketData.Packet.(type) {
-	case *types.DexPacketData_CreatePairPacket:
-		packetAck, err := am.keeper.OnRecvCreatePairPacket(ctx, modulePacket, *packet.CreatePairPacket)
-		if err != nil {
-			ack = channeltypes.NewErrorAcknowledgement(err.Error())
-		} else {
-			// Encode packet acknowledgment
-			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
-			if err != nil {
-				return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()).Error())
-			}
-			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
-		}
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeCreatePairPacket,
-				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err != nil)),
-			),
-		)
-	case *types.DexPacketData_SellOrderPacket:
-		packetAck, err := am.keeper.OnRecvSellOrderPacket(ctx, modulePacket, *packet.SellOrderPacket)
-		if err != nil {
-			ack = channeltypes.NewErrorAcknowledgement(err.Error())
-		} else {
-			// Encode packet acknowledgment
-			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
-			if err != nil {
-				return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()).Error())
-			}
-			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
-		}
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeSellOrderPacket,
-				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err != nil)),
-			),
-		)
-	case *types.DexPacketData_BuyOrderPacket:
-		packetAck, err := am.keeper.OnRecvBuyOrderPacket(ctx, modulePacket, *packet.BuyOrderPacket)
+	packetAck, err := handler.OnRecv(am.keeper, ctx, modulePacket, packetData)
+	if err != nil {
+		ack = channeltypes.NewErrorAcknowledgement(err.Error())
+	} else {
+		// Encode packet acknowledgment
+		packetAckBytes, err := types.ModuleCdc.MarshalJSON(packetAck)
 		if err != nil {
-			ack = channeltypes.NewErrorAcknowledgement(err.Error())
-		} else {
-			// Encode packet acknowledgment
-			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
-			if err != nil {
-				return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()).Error())
-			}
-			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+			return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()).Error())
 		}
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeBuyOrderPacket,
-				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err != nil)),
-			),
-		)
-		// this line is used by starport scaffolding # ibc/packet/module/recv
-	default:
-		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
-		return channeltypes.NewErrorAcknowledgement(errMsg)
+		ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
 	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			packetData.Type(),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err == nil)),
+		),
+	)
 
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
 	return ack
@@ -229,39 +187,18 @@ func (am AppModule) OnAcknowledgementPacket(
 
 	// this line is used by starport scaffolding # oracle/packet/module/ack
 
-	var modulePacketData types.DexPacketData
-	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	// Dispatch packet
+	packetData, handler, err := am.keeper.ResolvePacket(modulePacket.GetData())
+	if err != nil {
+		return err
 	}
 
-	var eventType string
-
-	// Dispatch packet
-	switch packet := modulePacketData.Packet.(type) {
-	case *types.DexPacketData_CreatePairPacket:
-		err := am.keeper.OnAcknowledgementCreatePairPacket(ctx, modulePacket, *packet.CreatePairPacket, ack)
-		if err != nil {
-			return err
-		}
-		eventType = types.EventTypeCreatePairPacket
-	case *types.DexPacketData_SellOrderPacket:
-		err := am.keeper.OnAcknowledgementSellOrderPacket(ctx, modulePacket, *packet.SellOrderPacket, ack)
-		if err != nil {
-			return err
-		}
-		eventType = types.EventTypeSellOrderPacket
-	case *types.DexPacketData_BuyOrderPacket:
-		err := am.keeper.OnAcknowledgementBuyOrderPacket(ctx, modulePacket, *packet.BuyOrderPacket, ack)
-		if err != nil {
-			return err
-		}
-		eventType = types.EventTypeBuyOrderPacket
-		// this line is used by starport scaffolding # ibc/packet/module/ack
-	default:
-		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
-		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+	if err := handler.OnAcknowledgement(am.keeper, ctx, modulePacket, packetData, ack); err != nil {
+		return err
 	}
 
+	eventType := packetData.Type()
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			eventType,
@@ -296,35 +233,13 @@ func (am AppModule) OnTimeoutPacket(
 	modulePacket channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	var modulePacketData types.DexPacketData
-	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
-	}
-
 	// Dispatch packet
-	switch packet := modulePacketData.Packet.(type) {
-	case *types.DexPacketData_CreatePairPacket:
-		err := am.keeper.OnTimeoutCreatePairPacket(ctx, modulePacket, *packet.CreatePairPacket)
-		if err != nil {
-			return err
-		}
-	case *types.DexPacketData_SellOrderPacket:
-		err := am.keeper.OnTimeoutSellOrderPacket(ctx, modulePacket, *packet.SellOrderPacket)
-		if err != nil {
-			return err
-		}
-	case *types.DexPacketData_BuyOrderPacket:
-		err := am.keeper.OnTimeoutBuyOrderPacket(ctx, modulePacket, *packet.BuyOrderPacket)
-		if err != nil {
-			return err
-		}
-		// this line is used by starport scaffolding # ibc/packet/module/timeout
-	default:
-		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
-		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+	packetData, handler, err := am.keeper.ResolvePacket(modulePacket.GetData())
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return handler.OnTimeout(am.keeper, ctx, modulePacket, packetData)
 }
 
 func (am AppModule) NegotiateAppVersion(
@@ -0,0 +1,378 @@
+package dex_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v2/testing"
+	"github.com/stretchr/testify/require"
+	"interchange/testutil/interchain"
+	"interchange/x/dex"
+	"interchange/x/dex/types"
+)
+
+const (
+	marsCoin  = "marscoin"
+	venusCoin = "venuscoin"
+	venusGold = "venusgold"
+)
+
+// setupPair funds the accounts like mars.yml and venus.yml and creates the marscoin/venuscoin pair from mars
+func setupPair(t *testing.T) (*interchain.Harness, string) {
+	h := interchain.New(t)
+	h.Fund(h.Mars, sdk.NewInt64Coin(marsCoin, 1000))
+	h.Fund(h.Venus, sdk.NewInt64Coin(venusCoin, 1000))
+
+	packets, err := h.Send(h.Mars, types.NewMsgSendCreatePair(
+		h.Address(h.Mars), types.PortID, h.Path.EndpointA.ChannelID, h.TimeoutTimestamp(), marsCoin, venusCoin,
+	))
+	require.NoError(t, err)
+	require.Len(t, packets, 1)
+	requireSuccess(t, h.RelayAll(h.Mars, packets)...)
+
+	return h, types.OrderBookIndex(types.PortID, h.Path.EndpointA.ChannelID, marsCoin, venusCoin)
+}
+
+func sendSellOrder(t *testing.T, h *interchain.Harness, amount, price int32) channeltypes.Packet {
+	packets, err := h.Send(h.Mars, types.NewMsgSendSellOrder(
+		h.Address(h.Mars), types.PortID, h.Path.EndpointA.ChannelID, h.TimeoutTimestamp(), marsCoin, amount, venusCoin, price,
+	))
+	require.NoError(t, err)
+	require.Len(t, packets, 1)
+	return packets[0]
+}
+
+func sendBuyOrder(t *testing.T, h *interchain.Harness, amount, price int32) channeltypes.Packet {
+	packets, err := h.Send(h.Venus, types.NewMsgSendBuyOrder(
+		h.Address(h.Venus), types.PortID, h.Path.EndpointB.ChannelID, h.TimeoutTimestamp(), marsCoin, amount, venusCoin, price,
+	))
+	require.NoError(t, err)
+	require.Len(t, packets, 1)
+	return packets[0]
+}
+
+func requireSuccess(t *testing.T, acks ...channeltypes.Acknowledgement) {
+	for _, ack := range acks {
+		require.True(t, ack.Success(), ack.GetError())
+	}
+}
+
+func sellOrderBook(t *testing.T, h *interchain.Harness, pairIndex string) types.SellOrderBook {
+	book, found := h.Keeper(h.Mars).GetSellOrderBook(h.Mars.GetContext(), pairIndex)
+	require.True(t, found)
+	return book
+}
+
+func buyOrderBook(t *testing.T, h *interchain.Harness, pairIndex string) types.BuyOrderBook {
+	book, found := h.Keeper(h.Venus).GetBuyOrderBook(h.Venus.GetContext(), pairIndex)
+	require.True(t, found)
+	return book
+}
+
+func TestIBCCreatePair(t *testing.T) {
+	h, pairIndex := setupPair(t)
+
+	// The sell order book is created on the source chain and the buy order book on the target chain
+	sellBook := sellOrderBook(t, h, pairIndex)
+	require.Equal(t, marsCoin, sellBook.AmountDenom)
+	require.Equal(t, venusCoin, sellBook.PriceDenom)
+	buyBook := buyOrderBook(t, h, pairIndex)
+	require.Equal(t, marsCoin, buyBook.AmountDenom)
+	require.Equal(t, venusCoin, buyBook.PriceDenom)
+}
+
+func TestIBCCrossingOrders(t *testing.T) {
+	h, pairIndex := setupPair(t)
+
+	// The buy order doesn't match the empty sell order book and rests on venus
+	requireSuccess(t, h.RelayAll(h.Venus, []channeltypes.Packet{sendBuyOrder(t, h, 10, 5)})...)
+	require.EqualValues(t, 950, h.Balance(h.Venus, venusCoin))
+	require.Len(t, buyOrderBook(t, h, pairIndex).Book.Orders, 1)
+
+	// The sell order crosses the resting buy order
+	requireSuccess(t, h.RelayAll(h.Mars, []channeltypes.Packet{sendSellOrder(t, h, 10, 5)})...)
+	require.Empty(t, buyOrderBook(t, h, pairIndex).Book.Orders)
+	require.Empty(t, sellOrderBook(t, h, pairIndex).Book.Orders)
+
+	// The seller receives the price in venuscoin vouchers and the buyer the marscoin vouchers
+	require.EqualValues(t, 990, h.Balance(h.Mars, marsCoin))
+	require.EqualValues(t, 50, h.Balance(h.Mars, h.VoucherDenom(h.Mars, venusCoin)))
+	require.EqualValues(t, 950, h.Balance(h.Venus, venusCoin))
+	require.EqualValues(t, 10, h.Balance(h.Venus, h.VoucherDenom(h.Venus, marsCoin)))
+
+	// Nothing is left pending on either chain
+	require.Empty(t, h.Keeper(h.Mars).GetAllPendingOrder(h.Mars.GetContext()))
+	require.Empty(t, h.Keeper(h.Venus).GetAllPendingOrder(h.Venus.GetContext()))
+}
+
+func TestIBCPartialFill(t *testing.T) {
+	h, pairIndex := setupPair(t)
+
+	// The sell order rests on mars
+	requireSuccess(t, h.RelayAll(h.Mars, []channeltypes.Packet{sendSellOrder(t, h, 10, 5)})...)
+	require.EqualValues(t, 990, h.Balance(h.Mars, marsCoin))
+
+	// The buy order fills a part of the resting sell order
+	packet := sendBuyOrder(t, h, 4, 6)
+	ack, err := h.Relay(h.Venus, packet)
+	require.NoError(t, err)
+	requireSuccess(t, ack)
+
+	var packetAck types.BuyOrderPacketAck
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(ack.GetResult(), &packetAck))
+	require.EqualValues(t, 0, packetAck.RemainingAmount)
+	require.EqualValues(t, 4, packetAck.Purchase)
+	require.EqualValues(t, 4, packetAck.Refund)
+
+	orders := sellOrderBook(t, h, pairIndex).Book.Orders
+	require.Len(t, orders, 1)
+	require.EqualValues(t, 6, orders[0].Amount)
+	require.Empty(t, buyOrderBook(t, h, pairIndex).Book.Orders)
+
+	// The seller is paid at the price of the resting order and the buyer is refunded the difference with its price
+	require.EqualValues(t, 20, h.Balance(h.Mars, h.VoucherDenom(h.Mars, venusCoin)))
+	require.EqualValues(t, 980, h.Balance(h.Venus, venusCoin))
+	require.EqualValues(t, 4, h.Balance(h.Venus, h.VoucherDenom(h.Venus, marsCoin)))
+
+	// Nothing of the price escrowed by the buyer is left beyond the vouchers paid to the seller
+	require.Zero(t, h.Escrow(h.Venus, venusCoin)-h.Balance(h.Mars, h.VoucherDenom(h.Mars, venusCoin)))
+}
+
+func TestIBCCancelOrders(t *testing.T) {
+	h, pairIndex := setupPair(t)
+
+	requireSuccess(t, h.RelayAll(h.Mars, []channeltypes.Packet{sendSellOrder(t, h, 10, 5)})...)
+	requireSuccess(t, h.RelayAll(h.Venus, []channeltypes.Packet{sendBuyOrder(t, h, 10, 4)})...)
+	require.EqualValues(t, 990, h.Balance(h.Mars, marsCoin))
+	require.EqualValues(t, 960, h.Balance(h.Venus, venusCoin))
+
+	sellOrderID := sellOrderBook(t, h, pairIndex).Book.Orders[0].Id
+	_, err := h.Send(h.Mars, types.NewMsgCancelSellOrder(
+		h.Address(h.Mars), types.PortID, h.Path.EndpointA.ChannelID, marsCoin, venusCoin, sellOrderID,
+	))
+	require.NoError(t, err)
+	require.Empty(t, sellOrderBook(t, h, pairIndex).Book.Orders)
+	require.EqualValues(t, 1000, h.Balance(h.Mars, marsCoin))
+
+	buyOrderID := buyOrderBook(t, h, pairIndex).Book.Orders[0].Id
+	_, err = h.Send(h.Venus, types.NewMsgCancelBuyOrder(
+		h.Address(h.Venus), types.PortID, h.Path.EndpointB.ChannelID, marsCoin, venusCoin, buyOrderID,
+	))
+	require.NoError(t, err)
+	require.Empty(t, buyOrderBook(t, h, pairIndex).Book.Orders)
+	require.EqualValues(t, 1000, h.Balance(h.Venus, venusCoin))
+}
+
+func TestIBCErrorAck(t *testing.T) {
+	h, pairIndex := setupPair(t)
+
+	// The pair is paused on venus only, so the order is sent but rejected on receipt
+	h.Keeper(h.Venus).SetPairPaused(h.Venus.GetContext(), pairIndex, true)
+	h.Coordinator.CommitBlock(h.Venus)
+	require.NoError(t, h.Path.EndpointA.UpdateClient())
+
+	packet := sendSellOrder(t, h, 10, 5)
+	require.EqualValues(t, 990, h.Balance(h.Mars, marsCoin))
+	require.Len(t, h.Keeper(h.Mars).GetAllPendingOrder(h.Mars.GetContext()), 1)
+
+	ack, err := h.Relay(h.Mars, packet)
+	require.NoError(t, err)
+	require.False(t, ack.Success())
+
+	// The seller is refunded and nothing rests in the order books
+	require.EqualValues(t, 1000, h.Balance(h.Mars, marsCoin))
+	require.Empty(t, h.Keeper(h.Mars).GetAllPendingOrder(h.Mars.GetContext()))
+	require.Empty(t, sellOrderBook(t, h, pairIndex).Book.Orders)
+}
+
+func TestIBCRecvPacketEvent(t *testing.T) {
+	h, _ := setupPair(t)
+	app := h.App(h.Venus)
+	module := dex.NewAppModule(app.AppCodec(), app.DexKeeper, app.AccountKeeper, app.BankKeeper)
+
+	recv := func(data types.SellOrderPacketData) string {
+		bz, err := data.GetBytes()
+		require.NoError(t, err)
+		ctx := h.Venus.GetContext().WithEventManager(sdk.NewEventManager())
+		module.OnRecvPacket(ctx, channeltypes.Packet{
+			Data:               bz,
+			SourcePort:         types.PortID,
+			SourceChannel:      h.Path.EndpointA.ChannelID,
+			DestinationPort:    types.PortID,
+			DestinationChannel: h.Path.EndpointB.ChannelID,
+		}, nil)
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeSellOrderPacket {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeKeyAckSuccess {
+					return string(attr.Value)
+				}
+			}
+		}
+		return ""
+	}
+
+	// The event reports whether the packet was handled successfully
+	order := types.SellOrderPacketData{AmountDenom: marsCoin, Amount: 10, PriceDenom: venusCoin, Price: 5, Seller: h.Address(h.Mars)}
+	require.Equal(t, "true", recv(order))
+	order.PriceDenom = venusGold
+	require.Equal(t, "false", recv(order))
+}
+
+func TestIBCTimeout(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		chain  func(h *interchain.Harness) *ibctesting.TestChain
+		send   func(t *testing.T, h *interchain.Harness) channeltypes.Packet
+		denom  string
+		locked int64
+	}{
+		{
+			desc:   "SellOrder",
+			chain:  func(h *interchain.Harness) *ibctesting.TestChain { return h.Mars },
+			send:   func(t *testing.T, h *interchain.Harness) channeltypes.Packet { return sendSellOrder(t, h, 10, 5) },
+			denom:  marsCoin,
+			locked: 10,
+		},
+		{
+			desc:   "BuyOrder",
+			chain:  func(h *interchain.Harness) *ibctesting.TestChain { return h.Venus },
+			send:   func(t *testing.T, h *interchain.Harness) channeltypes.Packet { return sendBuyOrder(t, h, 10, 5) },
+			denom:  venusCoin,
+			locked: 50,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			h, _ := setupPair(t)
+			chain := tc.chain(h)
+
+			packet := tc.send(t, h)
+			require.EqualValues(t, 1000-tc.locked, h.Balance(chain, tc.denom))
+
+			// The packet is never relayed and times out
+			require.NoError(t, h.Timeout(chain, packet))
+			require.EqualValues(t, 1000, h.Balance(chain, tc.denom))
+			require.Empty(t, h.Keeper(chain).GetAllPendingOrder(chain.GetContext()))
+		})
+	}
+}
+
+// setupVoucherPair lets venus trade venusgold for a voucher of venuscoin:
+// venus bids 10 marscoin at 5 venuscoin, then creates the venusgold pair priced in the voucher and asks 20 venusgold at 2.
+func setupVoucherPair(t *testing.T) *interchain.Harness {
+	h, _ := setupPair(t)
+	requireSuccess(t, h.RelayAll(h.Venus, []channeltypes.Packet{sendBuyOrder(t, h, 10, 5)})...)
+
+	h.Fund(h.Venus, sdk.NewInt64Coin(venusGold, 1000))
+	voucher := h.VoucherDenom(h.Mars, venusCoin)
+	packets, err := h.Send(h.Venus, types.NewMsgSendCreatePair(
+		h.Address(h.Venus), types.PortID, h.Path.EndpointB.ChannelID, h.TimeoutTimestamp(), venusGold, voucher,
+	))
+	require.NoError(t, err)
+	requireSuccess(t, h.RelayAll(h.Venus, packets)...)
+	// The pair is created on venus with the full denom path of the voucher resolved on mars
+	packets, err = h.Send(h.Venus, types.NewMsgSendSellOrder(
+		h.Address(h.Venus), types.PortID, h.Path.EndpointB.ChannelID, h.TimeoutTimestamp(), venusGold, 20, voucherPath(h), 2,
+	))
+	require.NoError(t, err)
+	requireSuccess(t, h.RelayAll(h.Venus, packets)...)
+	return h
+}
+
+// voucherPath returns the full denom path of the voucher of venuscoin on mars
+func voucherPath(h *interchain.Harness) string {
+	return types.PortID + "/" + h.Path.EndpointA.ChannelID + "/" + venusCoin
+}
+
+func TestIBCCreatePairVoucherTarget(t *testing.T) {
+	h := setupVoucherPair(t)
+
+	// Both books of the pair use the full denom path of the voucher, not its ibc/{hash} denom on mars
+	pairIndex := types.OrderBookIndex(types.PortID, h.Path.EndpointB.ChannelID, venusGold, voucherPath(h))
+	sellBook, found := h.Keeper(h.Venus).GetSellOrderBook(h.Venus.GetContext(), pairIndex)
+	require.True(t, found)
+	require.Equal(t, voucherPath(h), sellBook.PriceDenom)
+	buyBook, found := h.Keeper(h.Mars).GetBuyOrderBook(h.Mars.GetContext(), pairIndex)
+	require.True(t, found)
+	require.Equal(t, voucherPath(h), buyBook.PriceDenom)
+
+	// A buy order sent with the voucher escrows and refunds the voucher
+	requireSuccess(t, h.RelayAll(h.Mars, []channeltypes.Packet{sendSellOrder(t, h, 10, 5)})...)
+	voucher := h.VoucherDenom(h.Mars, venusCoin)
+	require.EqualValues(t, 50, h.Balance(h.Mars, voucher))
+	packets, err := h.Send(h.Mars, types.NewMsgSendBuyOrder(
+		h.Address(h.Mars), types.PortID, h.Path.EndpointA.ChannelID, h.TimeoutTimestamp(), venusGold, 5, voucher, 3,
+	))
+	require.NoError(t, err)
+	require.Len(t, packets, 1)
+	require.EqualValues(t, 35, h.Balance(h.Mars, voucher))
+	require.NoError(t, h.Timeout(h.Mars, packets[0]))
+	require.EqualValues(t, 50, h.Balance(h.Mars, voucher))
+}
+
+// setupRoute lets mars route marscoin to venusgold through the voucher pair of setupVoucherPair.
+// It returns the best route quoted on venus for 10 marscoin.
+func setupRoute(t *testing.T) (*interchain.Harness, types.RouteQuote) {
+	h := setupVoucherPair(t)
+	res, err := h.Keeper(h.Venus).Route(sdk.WrapSDKContext(h.Venus.GetContext()), &types.QueryRouteRequest{
+		FromDenom: marsCoin,
+		ToDenom:   h.VoucherDenom(h.Mars, venusGold),
+		Amount:    10,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Routes)
+	return h, res.Routes[0]
+}
+
+func TestIBCRoutedOrder(t *testing.T) {
+	h, route := setupRoute(t)
+
+	// 10 marscoin are sold at 5, then the 50 vouchers buy the 20 venusgold at 2
+	require.EqualValues(t, 20, route.AmountOut)
+	require.Equal(t, []types.RouteLeg{
+		{Port: types.PortID, Channel: h.Path.EndpointA.ChannelID, OrderType: types.OrderTypeSell, AmountDenom: marsCoin, PriceDenom: venusCoin, Price: 5},
+		{Port: types.PortID, Channel: h.Path.EndpointA.ChannelID, OrderType: types.OrderTypeBuy, AmountDenom: venusGold, PriceDenom: voucherPath(h), Price: 2},
+	}, route.Legs)
+
+	packets, err := h.Send(h.Mars, types.NewMsgRoutedOrder(h.Address(h.Mars), 10, route.Legs, uint64(interchain.DefaultTimeout)))
+	require.NoError(t, err)
+	require.Len(t, packets, 1)
+	require.EqualValues(t, 990, h.Balance(h.Mars, marsCoin))
+
+	// The acknowledgement of the first leg sends the second leg with the vouchers
+	ack, next, err := h.RelayNext(h.Mars, packets[0])
+	require.NoError(t, err)
+	requireSuccess(t, ack)
+	require.Len(t, next, 1)
+	require.Zero(t, h.Balance(h.Mars, h.VoucherDenom(h.Mars, venusCoin)))
+
+	ack, next, err = h.RelayNext(h.Mars, next[0])
+	require.NoError(t, err)
+	requireSuccess(t, ack)
+	require.Empty(t, next)
+
+	// The vouchers not spent by the second leg are refunded
+	require.EqualValues(t, 20, h.Balance(h.Mars, h.VoucherDenom(h.Mars, venusGold)))
+	require.EqualValues(t, 10, h.Balance(h.Mars, h.VoucherDenom(h.Mars, venusCoin)))
+	require.Empty(t, h.Keeper(h.Mars).GetAllRoutedOrder(h.Mars.GetContext()))
+	require.Empty(t, h.Keeper(h.Mars).GetAllPendingOrder(h.Mars.GetContext()))
+}
+
+func TestIBCRoutedOrderTimeout(t *testing.T) {
+	h, route := setupRoute(t)
+
+	packets, err := h.Send(h.Mars, types.NewMsgRoutedOrder(h.Address(h.Mars), 10, route.Legs, uint64(interchain.DefaultTimeout)))
+	require.NoError(t, err)
+	_, next, err := h.RelayNext(h.Mars, packets[0])
+	require.NoError(t, err)
+	require.Len(t, next, 1)
+
+	// The second leg times out, its vouchers are refunded and the route ends
+	require.NoError(t, h.Timeout(h.Mars, next[0]))
+	require.EqualValues(t, 50, h.Balance(h.Mars, h.VoucherDenom(h.Mars, venusCoin)))
+	require.Zero(t, h.Balance(h.Mars, h.VoucherDenom(h.Mars, venusGold)))
+	require.Empty(t, h.Keeper(h.Mars).GetAllRoutedOrder(h.Mars.GetContext()))
+}
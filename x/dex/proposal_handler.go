@@ -0,0 +1,71 @@
+package dex
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"interchange/x/dex/keeper"
+	"interchange/x/dex/types"
+)
+
+// NewProposalHandler returns the handler of the governance proposals of the module
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ResetRateLimitQuotaProposal:
+			return handleResetRateLimitQuotaProposal(ctx, k, c)
+		case *types.AllowPairCreationProposal:
+			return handleAllowPairCreationProposal(ctx, k, c)
+		case *types.PausePairProposal:
+			return handlePausePairProposal(ctx, k, c)
+		case *types.DelistPairProposal:
+			return handleDelistPairProposal(ctx, k, c)
+		case *types.CircuitBreakerProposal:
+			return handleCircuitBreakerProposal(ctx, k, c)
+		case *types.FundIncentivePoolProposal:
+			return handleFundIncentivePoolProposal(ctx, k, c)
+		// this line is used by starport scaffolding # proposal/handler
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s proposal content type: %T", types.ModuleName, c)
+			return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func handleResetRateLimitQuotaProposal(ctx sdk.Context, k keeper.Keeper, p *types.ResetRateLimitQuotaProposal) error {
+	if _, found := k.GetRateLimit(ctx, p.Port, p.Channel, p.Denom); !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no rate limit for %s on %s/%s", p.Denom, p.Port, p.Channel)
+	}
+	k.ResetRateLimitQuota(ctx, p.Port, p.Channel, p.Denom)
+	return nil
+}
+
+func handleAllowPairCreationProposal(ctx sdk.Context, k keeper.Keeper, p *types.AllowPairCreationProposal) error {
+	k.AllowPairCreation(ctx, p.PairIndex())
+	return nil
+}
+
+func handlePausePairProposal(ctx sdk.Context, k keeper.Keeper, p *types.PausePairProposal) error {
+	pairIndex := p.PairIndex()
+	_, sellFound := k.GetSellOrderBook(ctx, pairIndex)
+	_, buyFound := k.GetBuyOrderBook(ctx, pairIndex)
+	if !sellFound && !buyFound {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %s", pairIndex)
+	}
+	k.SetPairPaused(ctx, pairIndex, p.Paused)
+	return nil
+}
+
+func handleDelistPairProposal(ctx sdk.Context, k keeper.Keeper, p *types.DelistPairProposal) error {
+	return k.DelistPair(ctx, p.Port, p.Channel, p.SourceDenom, p.TargetDenom)
+}
+
+func handleCircuitBreakerProposal(ctx sdk.Context, k keeper.Keeper, p *types.CircuitBreakerProposal) error {
+	return k.SetCircuitBreaker(ctx, p.MsgTypes, p.PacketTypes, p.Tripped)
+}
+
+func handleFundIncentivePoolProposal(ctx sdk.Context, k keeper.Keeper, p *types.FundIncentivePoolProposal) error {
+	return k.FundIncentivePoolFromCommunityPool(ctx, p.PairIndex, p.Amount)
+}
@@ -0,0 +1,100 @@
+package dex_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange/testutil/keeper"
+	"interchange/x/dex"
+	"interchange/x/dex/types"
+)
+
+func TestResetRateLimitQuotaProposal(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	handler := dex.NewProposalHandler(*k)
+	params := types.DefaultParams()
+	params.RateLimits = []types.RateLimit{
+		{Port: "dex", Channel: "channel-0", Denom: "stake", MaxOutflow: 100, Window: time.Hour},
+	}
+	k.SetParams(ctx, params)
+	k.SetRateLimitQuota(ctx, types.RateLimitQuota{Port: "dex", Channel: "channel-0", Denom: "stake", Outflow: 100})
+
+	err := handler(ctx, types.NewResetRateLimitQuotaProposal("title", "description", "dex", "channel-0", "token"))
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	err = handler(ctx, types.NewResetRateLimitQuotaProposal("title", "description", "other", "channel-0", "stake"))
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	require.NoError(t, handler(ctx, types.NewResetRateLimitQuotaProposal("title", "description", "dex", "channel-0", "stake")))
+	_, found := k.GetRateLimitQuota(ctx, "dex", "channel-0", "stake")
+	require.False(t, found)
+}
+
+func TestPairProposals(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	handler := dex.NewProposalHandler(*k)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+
+	require.NoError(t, handler(ctx, types.NewAllowPairCreationProposal("title", "description", "dex", "channel-0", "stake", "token")))
+	params := types.DefaultParams()
+	params.RestrictPairCreation = true
+	k.SetParams(ctx, params)
+	require.True(t, k.IsPairCreationAllowed(ctx, pairIndex))
+
+	err := handler(ctx, types.NewPausePairProposal("title", "description", "dex", "channel-0", "stake", "token", true))
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	book := types.NewSellOrderBook("stake", "token")
+	book.Index = pairIndex
+	k.SetSellOrderBook(ctx, book)
+	require.NoError(t, handler(ctx, types.NewPausePairProposal("title", "description", "dex", "channel-0", "stake", "token", true)))
+	require.True(t, k.IsPairPaused(ctx, pairIndex))
+
+	require.NoError(t, handler(ctx, types.NewDelistPairProposal("title", "description", "dex", "channel-0", "stake", "token")))
+	_, found := k.GetSellOrderBook(ctx, pairIndex)
+	require.False(t, found)
+	require.False(t, k.IsPairPaused(ctx, pairIndex))
+}
+
+func TestCircuitBreakerProposal(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	handler := dex.NewProposalHandler(*k)
+	msgType := sdk.MsgTypeURL(&types.MsgSendSellOrder{})
+
+	err := handler(ctx, types.NewCircuitBreakerProposal("title", "description", nil, []string{"unknown"}, true))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	require.NoError(t, handler(ctx, types.NewCircuitBreakerProposal("title", "description", []string{msgType}, []string{types.EventTypeSellOrderPacket}, true)))
+	require.True(t, k.IsMsgTripped(ctx, msgType))
+	require.True(t, k.IsPacketTripped(ctx, types.EventTypeSellOrderPacket))
+
+	require.NoError(t, handler(ctx, types.NewCircuitBreakerProposal("title", "description", []string{msgType}, []string{types.EventTypeSellOrderPacket}, false)))
+	require.False(t, k.IsMsgTripped(ctx, msgType))
+	require.False(t, k.IsPacketTripped(ctx, types.EventTypeSellOrderPacket))
+}
+
+func TestFundIncentivePoolProposal(t *testing.T) {
+	f := keepertest.DexKeeperWithBank(t)
+	handler := dex.NewProposalHandler(*f.Keeper)
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "stake", "token")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	f.FundCommunityPool(sdk.NewInt64Coin("stake", 150))
+
+	err := handler(f.Ctx, types.NewFundIncentivePoolProposal("title", "description", pairIndex, amount))
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	book := types.NewBuyOrderBook("stake", "token")
+	book.Index = pairIndex
+	f.Keeper.SetBuyOrderBook(f.Ctx, book)
+	require.NoError(t, handler(f.Ctx, types.NewFundIncentivePoolProposal("title", "description", pairIndex, amount)))
+	pool, found := f.Keeper.GetIncentivePool(f.Ctx, pairIndex)
+	require.True(t, found)
+	require.Equal(t, amount, pool.Balance)
+	f.RequireBalance(types.IncentivePoolAddress(), "stake", 100)
+
+	// The community pool must hold the coins
+	err = handler(f.Ctx, types.NewFundIncentivePoolProposal("title", "description", pairIndex, amount))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+}
@@ -0,0 +1,337 @@
+package types
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// PoolShareDenomPrefix is the prefix of the denoms of the share tokens of the liquidity pools
+const PoolShareDenomPrefix = "amm"
+
+// LiquidityPoolAddress returns the address holding the reserves of the liquidity pools
+func LiquidityPoolAddress() sdk.AccAddress {
+	return address.Module(ModuleName, []byte(PoolShareDenomPrefix))
+}
+
+// PoolShareDenom returns the denom of the share tokens of the liquidity pool of a pair,
+// the index of the pair is hashed to keep the denom short
+func PoolShareDenom(pairIndex string) string {
+	return fmt.Sprintf("%s/%X", PoolShareDenomPrefix, sha256.Sum256([]byte(pairIndex)))
+}
+
+// NewLiquidityPool returns an empty liquidity pool
+func NewLiquidityPool(pairIndex string, amountDenom string, priceDenom string) LiquidityPool {
+	return LiquidityPool{
+		PairIndex:     pairIndex,
+		AmountDenom:   amountDenom,
+		PriceDenom:    priceDenom,
+		AmountReserve: sdk.ZeroInt(),
+		PriceReserve:  sdk.ZeroInt(),
+		TotalShares:   sdk.ZeroInt(),
+	}
+}
+
+// Validate checks the liquidity pool is well formed
+func (p LiquidityPool) Validate() error {
+	if p.PairIndex == "" {
+		return errors.New("liquidity pool pair index cannot be empty")
+	}
+	if err := sdk.ValidateDenom(p.AmountDenom); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.PriceDenom); err != nil {
+		return err
+	}
+	for _, i := range []sdk.Int{p.AmountReserve, p.PriceReserve, p.TotalShares} {
+		if i.IsNil() || i.IsNegative() {
+			return fmt.Errorf("invalid liquidity pool %s reserves: %s %s %s shares", p.PairIndex, p.AmountReserve, p.PriceReserve, p.TotalShares)
+		}
+	}
+	if p.TotalShares.IsZero() != (p.AmountReserve.IsZero() && p.PriceReserve.IsZero()) {
+		return fmt.Errorf("liquidity pool %s has reserves without shares or shares without reserves", p.PairIndex)
+	}
+	return nil
+}
+
+// IsEmpty returns true if the pool cannot trade
+func (p LiquidityPool) IsEmpty() bool {
+	return p.TotalShares.IsZero() || !p.AmountReserve.IsPositive() || !p.PriceReserve.IsPositive()
+}
+
+// Reserves returns the reserves of the pool as coins
+func (p LiquidityPool) Reserves() sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(p.AmountDenom, p.AmountReserve), sdk.NewCoin(p.PriceDenom, p.PriceReserve))
+}
+
+// Deposit returns the shares minted for deposits of at most the given amounts and the part of the deposits taken,
+// the first deposit sets the price of the pool and the next ones are taken at the ratio of the reserves
+func (p LiquidityPool) Deposit(amount sdk.Int, price sdk.Int) (shares sdk.Int, amountIn sdk.Int, priceIn sdk.Int) {
+	if p.TotalShares.IsZero() {
+		// 最初の預け入れは数量の幾何平均をシェアとする
+		shares = sdk.NewIntFromBigInt(new(big.Int).Sqrt(amount.Mul(price).BigInt()))
+		return shares, amount, price
+	}
+
+	// 準備金の比率に合う分だけ預け入れ、端数はプールに有利に切り上げる
+	shares = sdk.MinInt(
+		amount.Mul(p.TotalShares).Quo(p.AmountReserve),
+		price.Mul(p.TotalShares).Quo(p.PriceReserve),
+	)
+	amountIn = ceilQuo(shares.Mul(p.AmountReserve), p.TotalShares)
+	priceIn = ceilQuo(shares.Mul(p.PriceReserve), p.TotalShares)
+	return shares, amountIn, priceIn
+}
+
+// Withdraw returns the part of the reserves paid for shares, rounded down
+func (p LiquidityPool) Withdraw(shares sdk.Int) (amountOut sdk.Int, priceOut sdk.Int) {
+	if p.TotalShares.IsZero() {
+		return sdk.ZeroInt(), sdk.ZeroInt()
+	}
+	return shares.Mul(p.AmountReserve).Quo(p.TotalShares), shares.Mul(p.PriceReserve).Quo(p.TotalShares)
+}
+
+// SellOutput returns the amount of the price denom paid by the pool for an amount of the amount denom,
+// the fee is kept in the pool
+func (p LiquidityPool) SellOutput(amount int32, fee sdk.Dec) sdk.Int {
+	// out = Y * q(1-f) / (X + q(1-f))
+	in := sdk.NewInt(int64(amount)).Mul(feeless(fee))
+	return p.PriceReserve.Mul(in).Quo(p.AmountReserve.Mul(decPrecision()).Add(in))
+}
+
+// BuyCost returns the amount of the price denom paid to the pool for an amount of the amount denom,
+// false if the pool does not hold the amount
+func (p LiquidityPool) BuyCost(amount int32, fee sdk.Dec) (sdk.Int, bool) {
+	q := sdk.NewInt(int64(amount))
+	if q.GTE(p.AmountReserve) {
+		return sdk.ZeroInt(), false
+	}
+	// cost = Y * q / ((X - q)(1-f))
+	return ceilQuo(p.PriceReserve.Mul(q).Mul(decPrecision()), p.AmountReserve.Sub(q).Mul(feeless(fee))), true
+}
+
+// SellFee returns the part of the price denom the pool keeps as swap fee when it buys an amount
+func (p LiquidityPool) SellFee(amount int32, fee sdk.Dec) sdk.Int {
+	return p.SellOutput(amount, sdk.ZeroDec()).Sub(p.SellOutput(amount, fee))
+}
+
+// BuyFee returns the part of the price denom paid to the pool as swap fee when it sells an amount
+func (p LiquidityPool) BuyFee(amount int32, fee sdk.Dec) sdk.Int {
+	cost, ok := p.BuyCost(amount, fee)
+	if !ok {
+		return sdk.ZeroInt()
+	}
+	feeless, _ := p.BuyCost(amount, sdk.ZeroDec())
+	return cost.Sub(feeless)
+}
+
+// MaxSellAmount returns the largest amount, up to max, the pool buys without its price falling below the price,
+// and paying at most maxOutput
+func (p LiquidityPool) MaxSellAmount(max int32, price int32, fee sdk.Dec, maxOutput int64) int32 {
+	if p.IsEmpty() {
+		return 0
+	}
+	return searchAmount(max, func(amount int32) bool {
+		out := p.SellOutput(amount, fee)
+		if out.GT(sdk.NewInt(maxOutput)) {
+			return false
+		}
+		// 売却後のプールの限界価格 (Y-out)(1-f)/(X+q) が価格以上であること
+		return p.PriceReserve.Sub(out).Mul(feeless(fee)).GTE(
+			p.AmountReserve.AddRaw(int64(amount)).MulRaw(int64(price)).Mul(decPrecision()),
+		)
+	})
+}
+
+// MaxBuyAmount returns the largest amount, up to max, the pool sells without its price rising above the price
+func (p LiquidityPool) MaxBuyAmount(max int32, price int32, fee sdk.Dec) int32 {
+	if p.IsEmpty() {
+		return 0
+	}
+	return searchAmount(max, func(amount int32) bool {
+		cost, ok := p.BuyCost(amount, fee)
+		if !ok {
+			return false
+		}
+		// 購入後のプールの限界価格 (Y+cost)/((X-q)(1-f)) が価格以下であること
+		return p.PriceReserve.Add(cost).Mul(decPrecision()).LTE(
+			p.AmountReserve.SubRaw(int64(amount)).MulRaw(int64(price)).Mul(feeless(fee)),
+		)
+	})
+}
+
+// Sell trades an amount of the amount denom for the price denom with the pool and returns the amount paid by the pool
+func (p *LiquidityPool) Sell(amount int32, fee sdk.Dec) int64 {
+	out := p.SellOutput(amount, fee)
+	p.AmountReserve = p.AmountReserve.AddRaw(int64(amount))
+	p.PriceReserve = p.PriceReserve.Sub(out)
+	return out.Int64()
+}
+
+// Buy trades the price denom for an amount of the amount denom with the pool and returns the amount paid to the pool
+func (p *LiquidityPool) Buy(amount int32, fee sdk.Dec) int64 {
+	cost, _ := p.BuyCost(amount, fee)
+	p.AmountReserve = p.AmountReserve.SubRaw(int64(amount))
+	p.PriceReserve = p.PriceReserve.Add(cost)
+	return cost.Int64()
+}
+
+// PoolSwap is the part of an order filled by a liquidity pool
+type PoolSwap struct {
+	// amount of the amount denom sold to or bought from the pool
+	Amount int32
+	// amount of the price denom paid by or to the pool
+	Total int64
+	// amount of the price denom kept by the pool as swap fee, included in the total paid to the pool
+	// or deducted from the total paid by the pool
+	Fee int64
+}
+
+// Trade returns the swap as an order filled at its average price, rounded to the nearest valid price
+func (s PoolSwap) Trade() Order {
+	price := (s.Total + int64(s.Amount)/2) / int64(s.Amount)
+	switch {
+	case price < 1:
+		price = 1
+	case price > int64(MaxPrice):
+		price = int64(MaxPrice)
+	}
+	return Order{
+		Creator: LiquidityPoolAddress().String(),
+		Amount:  s.Amount,
+		Price:   int32(price),
+	}
+}
+
+// RouteSellOrder fills a sell order against the buy order book and the liquidity pool for the best execution:
+// the pool buys until its price falls to the highest bid, then the bids at that price are filled, and so on
+// until the order is filled or neither the book nor the pool pay the price of the order
+func (b *BuyOrderBook) RouteSellOrder(order Order, stp SelfTradePrevention, pool *LiquidityPool, fee sdk.Dec) (
+	remaining Order,
+	liquidated []Order,
+	gain int64,
+	selfTrade SelfTrade,
+	swap PoolSwap,
+) {
+	if pool == nil || pool.IsEmpty() {
+		remaining, liquidated, gain, _, selfTrade = b.FillSellOrder(order, stp)
+		return remaining, liquidated, gain, selfTrade, swap
+	}
+
+	remaining = order
+	for remaining.Amount > 0 {
+		// 最高入札額が注文の価格以上であれば、その価格までプールに売る
+		target := order.Price
+		orderCount := len(b.Book.Orders)
+		bookMatch := orderCount > 0 && b.Book.Orders[orderCount-1].Price >= order.Price
+		if bookMatch {
+			target = b.Book.Orders[orderCount-1].Price
+		}
+		if amount := pool.MaxSellAmount(remaining.Amount, target, fee, math.MaxInt64-gain); amount > 0 {
+			swap.Fee += pool.SellFee(amount, fee).Int64()
+			out := pool.Sell(amount, fee)
+			gain += out
+			swap.Amount += amount
+			swap.Total += out
+			remaining.Amount -= amount
+		}
+		if remaining.Amount == 0 || !bookMatch {
+			break
+		}
+
+		// 最高入札額の買い注文を約定させる
+		level := remaining
+		level.Price = target
+		levelRemaining, levelLiquidated, levelGain, _, levelSelfTrade := b.FillSellOrder(level, stp)
+		gain += levelGain
+		liquidated = append(liquidated, levelLiquidated...)
+		selfTrade.add(levelSelfTrade)
+		remaining.Amount = levelRemaining.Amount
+	}
+	return remaining, liquidated, gain, selfTrade, swap
+}
+
+// RouteBuyOrder fills a buy order against the sell order book and the liquidity pool for the best execution:
+// the pool sells until its price rises to the lowest ask, then the asks at that price are filled, and so on
+// until the order is filled or neither the book nor the pool sell at the price of the order
+func (s *SellOrderBook) RouteBuyOrder(order Order, stp SelfTradePrevention, pool *LiquidityPool, fee sdk.Dec) (
+	remaining Order,
+	liquidated []Order,
+	purchase int32,
+	selfTrade SelfTrade,
+	swap PoolSwap,
+) {
+	if pool == nil || pool.IsEmpty() {
+		remaining, liquidated, purchase, _, selfTrade = s.FillBuyOrder(order, stp)
+		return remaining, liquidated, purchase, selfTrade, swap
+	}
+
+	remaining = order
+	for remaining.Amount > 0 {
+		// 最安値の売り注文が注文の価格以下であれば、その価格までプールから買う
+		target := order.Price
+		orderCount := len(s.Book.Orders)
+		bookMatch := orderCount > 0 && s.Book.Orders[orderCount-1].Price <= order.Price
+		if bookMatch {
+			target = s.Book.Orders[orderCount-1].Price
+		}
+		if amount := pool.MaxBuyAmount(remaining.Amount, target, fee); amount > 0 {
+			swap.Fee += pool.BuyFee(amount, fee).Int64()
+			cost := pool.Buy(amount, fee)
+			purchase += amount
+			swap.Amount += amount
+			swap.Total += cost
+			remaining.Amount -= amount
+		}
+		if remaining.Amount == 0 || !bookMatch {
+			break
+		}
+
+		// 最安値の売り注文を約定させる
+		level := remaining
+		level.Price = target
+		levelRemaining, levelLiquidated, levelPurchase, _, levelSelfTrade := s.FillBuyOrder(level, stp)
+		purchase += levelPurchase
+		liquidated = append(liquidated, levelLiquidated...)
+		selfTrade.add(levelSelfTrade)
+		remaining.Amount = levelRemaining.Amount
+	}
+	return remaining, liquidated, purchase, selfTrade, swap
+}
+
+// searchAmount returns the largest amount up to max for which ok holds, ok must hold for the amounts below it
+func searchAmount(max int32, ok func(int32) bool) int32 {
+	lo, hi := int32(0), max
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		if ok(mid) {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return lo
+}
+
+// decPrecision returns the integer representation of one as a Dec
+func decPrecision() sdk.Int {
+	return sdk.NewIntFromBigInt(sdk.OneDec().BigInt())
+}
+
+// feeless returns the integer representation of the part of a trade left after the fee
+func feeless(fee sdk.Dec) sdk.Int {
+	if fee.IsNil() {
+		return decPrecision()
+	}
+	return sdk.NewIntFromBigInt(sdk.OneDec().Sub(fee).BigInt())
+}
+
+// ceilQuo divides rounding up
+func ceilQuo(x sdk.Int, y sdk.Int) sdk.Int {
+	return x.Add(y).SubRaw(1).Quo(y)
+}
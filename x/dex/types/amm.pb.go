@@ -0,0 +1,572 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/amm.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LiquidityPool is a constant product pool of a pair resting beside the order book
+// of the pair on this chain, the received orders are routed between the book and the pool.
+type LiquidityPool struct {
+	// index of the order book of the pair
+	PairIndex string `protobuf:"bytes,1,opt,name=pairIndex,proto3" json:"pairIndex,omitempty"`
+	// local denoms the reserves are held in
+	AmountDenom   string                                 `protobuf:"bytes,2,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
+	PriceDenom    string                                 `protobuf:"bytes,3,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
+	AmountReserve github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amountReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amountReserve"`
+	PriceReserve  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=priceReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"priceReserve"`
+	// total supply of the share tokens of the pool
+	TotalShares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=totalShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"totalShares"`
+}
+
+func (m *LiquidityPool) Reset()         { *m = LiquidityPool{} }
+func (m *LiquidityPool) String() string { return proto.CompactTextString(m) }
+func (*LiquidityPool) ProtoMessage()    {}
+func (*LiquidityPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65752dc62a003e25, []int{0}
+}
+func (m *LiquidityPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityPool.Merge(m, src)
+}
+func (m *LiquidityPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityPool proto.InternalMessageInfo
+
+func (m *LiquidityPool) GetPairIndex() string {
+	if m != nil {
+		return m.PairIndex
+	}
+	return ""
+}
+
+func (m *LiquidityPool) GetAmountDenom() string {
+	if m != nil {
+		return m.AmountDenom
+	}
+	return ""
+}
+
+func (m *LiquidityPool) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*LiquidityPool)(nil), "interchange.dex.LiquidityPool")
+}
+
+func init() { proto.RegisterFile("dex/amm.proto", fileDescriptor_65752dc62a003e25) }
+
+var fileDescriptor_65752dc62a003e25 = []byte{
+	// 284 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x31, 0x4b, 0x03, 0x31,
+	0x18, 0x86, 0xef, 0xaa, 0x16, 0x9a, 0x5a, 0x84, 0x20, 0x78, 0x88, 0xa4, 0xc5, 0x41, 0x5c, 0xbc,
+	0x20, 0xfe, 0x83, 0xe2, 0x52, 0x70, 0x28, 0xa7, 0x93, 0x5b, 0x7a, 0xf7, 0x71, 0x0d, 0x36, 0xf9,
+	0xce, 0x24, 0x95, 0xeb, 0xbf, 0xf0, 0x67, 0x75, 0xec, 0x28, 0x0e, 0x45, 0xda, 0xd1, 0x3f, 0x21,
+	0x97, 0x53, 0xbc, 0xae, 0x9d, 0x12, 0xde, 0xf7, 0xe1, 0xf9, 0x86, 0x97, 0xf4, 0x32, 0x28, 0xb9,
+	0x50, 0x2a, 0x2e, 0x0c, 0x3a, 0xa4, 0x27, 0x52, 0x3b, 0x30, 0xe9, 0x54, 0xe8, 0x1c, 0xe2, 0x0c,
+	0xca, 0xf3, 0xd3, 0x1c, 0x73, 0xf4, 0x1d, 0xaf, 0x7e, 0x35, 0x76, 0xf9, 0xdd, 0x22, 0xbd, 0x07,
+	0xf9, 0x3a, 0x97, 0x99, 0x74, 0x8b, 0x31, 0xe2, 0x8c, 0x5e, 0x90, 0x4e, 0x21, 0xa4, 0x19, 0xe9,
+	0x0c, 0xca, 0x28, 0x1c, 0x84, 0xd7, 0x9d, 0xe4, 0x3f, 0xa0, 0x03, 0xd2, 0x15, 0x0a, 0xe7, 0xda,
+	0xdd, 0x83, 0x46, 0x15, 0xb5, 0x7c, 0xdf, 0x8c, 0x28, 0x23, 0xa4, 0x30, 0x32, 0x85, 0x1a, 0x38,
+	0xf0, 0x40, 0x23, 0xa1, 0x4f, 0xa4, 0x57, 0xe3, 0x09, 0x58, 0x30, 0x6f, 0x10, 0x1d, 0x56, 0xc8,
+	0x30, 0x5e, 0xae, 0xfb, 0xc1, 0xe7, 0xba, 0x7f, 0x95, 0x4b, 0x37, 0x9d, 0x4f, 0xe2, 0x14, 0x15,
+	0x4f, 0xd1, 0x2a, 0xb4, 0xbf, 0xcf, 0x8d, 0xcd, 0x5e, 0xb8, 0x5b, 0x14, 0x60, 0xe3, 0x91, 0x76,
+	0xc9, 0xae, 0x84, 0x26, 0xe4, 0xd8, 0xdf, 0xf8, 0x93, 0x1e, 0xed, 0x25, 0xdd, 0x71, 0xd0, 0x31,
+	0xe9, 0x3a, 0x74, 0x62, 0xf6, 0x38, 0x15, 0x06, 0x6c, 0xd4, 0xde, 0x4b, 0xd9, 0x54, 0x0c, 0x6f,
+	0x97, 0x1b, 0x16, 0xae, 0x36, 0x2c, 0xfc, 0xda, 0xb0, 0xf0, 0x7d, 0xcb, 0x82, 0xd5, 0x96, 0x05,
+	0x1f, 0x5b, 0x16, 0x3c, 0x9f, 0x35, 0xe6, 0xe2, 0x25, 0xaf, 0xb6, 0xf4, 0x8e, 0x49, 0xdb, 0xef,
+	0x74, 0xf7, 0x33, 0x00, 0xc5, 0x9c, 0x96, 0xa4, 0xdf, 0x01, 0x00, 0x00,
+}
+
+func (m *LiquidityPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalShares.Size()
+		i -= size
+		if _, err := m.TotalShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAmm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PriceReserve.Size()
+		i -= size
+		if _, err := m.PriceReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAmm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AmountReserve.Size()
+		i -= size
+		if _, err := m.AmountReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAmm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintAmm(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AmountDenom) > 0 {
+		i -= len(m.AmountDenom)
+		copy(dAtA[i:], m.AmountDenom)
+		i = encodeVarintAmm(dAtA, i, uint64(len(m.AmountDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PairIndex) > 0 {
+		i -= len(m.PairIndex)
+		copy(dAtA[i:], m.PairIndex)
+		i = encodeVarintAmm(dAtA, i, uint64(len(m.PairIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAmm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAmm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LiquidityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairIndex)
+	if l > 0 {
+		n += 1 + l + sovAmm(uint64(l))
+	}
+	l = len(m.AmountDenom)
+	if l > 0 {
+		n += 1 + l + sovAmm(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovAmm(uint64(l))
+	}
+	l = m.AmountReserve.Size()
+	n += 1 + l + sovAmm(uint64(l))
+	l = m.PriceReserve.Size()
+	n += 1 + l + sovAmm(uint64(l))
+	l = m.TotalShares.Size()
+	n += 1 + l + sovAmm(uint64(l))
+	return n
+}
+
+func sovAmm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAmm(x uint64) (n int) {
+	return sovAmm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LiquidityPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAmm(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAmm
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAmm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAmm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAmm
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAmm
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAmm
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAmm        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAmm          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAmm = fmt.Errorf("proto: unexpected end of group")
+)
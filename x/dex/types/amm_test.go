@@ -0,0 +1,194 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"interchange/x/dex/types"
+)
+
+// newPool returns a liquidity pool of the stake/token pair with the reserves
+func newPool(amountReserve int64, priceReserve int64) types.LiquidityPool {
+	pool := types.NewLiquidityPool("dex-channel-0-stake-token", "stake", "token")
+	pool.AmountReserve = sdk.NewInt(amountReserve)
+	pool.PriceReserve = sdk.NewInt(priceReserve)
+	pool.TotalShares = sdk.NewInt(1)
+	return pool
+}
+
+func TestPoolShareDenom(t *testing.T) {
+	denom := types.PoolShareDenom(types.OrderBookIndex("dex", "channel-0", "transfer/channel-12/uatom", "stake"))
+	require.NoError(t, sdk.ValidateDenom(denom))
+	require.NotEqual(t, denom, types.PoolShareDenom(types.OrderBookIndex("dex", "channel-0", "stake", "transfer/channel-12/uatom")))
+}
+
+func TestParamsValidatePoolSwapFee(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		fee   sdk.Dec
+		valid bool
+	}{
+		{desc: "unset", fee: sdk.Dec{}, valid: true},
+		{desc: "zero", fee: sdk.ZeroDec(), valid: true},
+		{desc: "valid", fee: sdk.NewDecWithPrec(3, 3), valid: true},
+		{desc: "negative", fee: sdk.NewDecWithPrec(-1, 3)},
+		{desc: "whole trade", fee: sdk.OneDec()},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.PoolSwapFee = tc.fee
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestLiquidityPoolSwap(t *testing.T) {
+	fee := sdk.NewDecWithPrec(3, 3)
+
+	// The fee stays in the pool so the product of the reserves grows with the trades
+	pool := newPool(1000, 10000)
+	require.Equal(t, sdk.NewInt(99), pool.SellOutput(10, sdk.ZeroDec()))
+	require.Equal(t, sdk.NewInt(98), pool.SellOutput(10, fee))
+	out := pool.Sell(10, fee)
+	require.Equal(t, int64(98), out)
+	require.Equal(t, sdk.NewInt(1010), pool.AmountReserve)
+	require.Equal(t, sdk.NewInt(9902), pool.PriceReserve)
+
+	cost, ok := pool.BuyCost(10, fee)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt(100), cost)
+	require.Equal(t, int64(100), pool.Buy(10, fee))
+	require.True(t, pool.AmountReserve.Mul(pool.PriceReserve).GT(sdk.NewInt(1000*10000)))
+
+	// The pool cannot sell its whole reserve
+	_, ok = pool.BuyCost(1000, fee)
+	require.False(t, ok)
+	max := newPool(1000, 10000).MaxBuyAmount(1000, 100000, fee)
+	require.Less(t, max, int32(1000))
+	_, ok = newPool(1000, 10000).BuyCost(max, fee)
+	require.True(t, ok)
+}
+
+func TestLiquidityPoolMaxAmounts(t *testing.T) {
+	pool := newPool(1000, 10000)
+
+	// The pool trades until its marginal price reaches the price
+	require.Equal(t, int32(54), pool.MaxSellAmount(100, 9, sdk.ZeroDec(), 100000))
+	require.Equal(t, int32(0), pool.MaxSellAmount(100, 10, sdk.ZeroDec(), 100000))
+	require.Equal(t, int32(46), pool.MaxBuyAmount(100, 11, sdk.ZeroDec()))
+	require.Equal(t, int32(0), pool.MaxBuyAmount(100, 10, sdk.ZeroDec()))
+
+	// The amount is limited by the order and by the output
+	require.Equal(t, int32(20), pool.MaxSellAmount(20, 9, sdk.ZeroDec(), 100000))
+	require.Equal(t, int32(10), pool.MaxSellAmount(100, 9, sdk.ZeroDec(), 99))
+
+	// The fee lowers the price paid by the pool and raises the price asked by the pool
+	require.Less(t, pool.MaxSellAmount(100, 9, sdk.NewDecWithPrec(3, 3), 100000), int32(54))
+	require.Less(t, pool.MaxBuyAmount(100, 11, sdk.NewDecWithPrec(3, 3)), int32(46))
+
+	// An empty pool does not trade
+	require.Equal(t, int32(0), types.NewLiquidityPool("pair", "stake", "token").MaxSellAmount(100, 1, sdk.ZeroDec(), 100000))
+}
+
+func TestLiquidityPoolDeposit(t *testing.T) {
+	pool := types.NewLiquidityPool("pair", "stake", "token")
+	shares, amountIn, priceIn := pool.Deposit(sdk.NewInt(1000), sdk.NewInt(10000))
+	require.Equal(t, sdk.NewInt(3162), shares)
+	require.Equal(t, sdk.NewInt(1000), amountIn)
+	require.Equal(t, sdk.NewInt(10000), priceIn)
+
+	// The deposits are taken at the ratio of the reserves, rounded up
+	pool = newPool(1000, 10000)
+	pool.TotalShares = sdk.NewInt(3162)
+	shares, amountIn, priceIn = pool.Deposit(sdk.NewInt(100), sdk.NewInt(2000))
+	require.Equal(t, sdk.NewInt(316), shares)
+	require.Equal(t, sdk.NewInt(100), amountIn)
+	require.Equal(t, sdk.NewInt(1000), priceIn)
+
+	amountOut, priceOut := pool.Withdraw(sdk.NewInt(316))
+	require.Equal(t, sdk.NewInt(99), amountOut)
+	require.Equal(t, sdk.NewInt(999), priceOut)
+}
+
+func TestRouteSellOrder(t *testing.T) {
+	newBook := func() types.BuyOrderBook {
+		book := types.NewBuyOrderBook("stake", "token")
+		for _, order := range []types.Order{
+			{Creator: "alice", Amount: 10, Price: 9},
+			{Creator: "bob", Amount: 20, Price: 8},
+		} {
+			_, err := book.AppendOrder(order.Creator, order.Amount, order.Price, 0)
+			require.NoError(t, err)
+		}
+		return book
+	}
+	order := types.Order{Creator: "carol", Amount: 150, Price: 8}
+
+	// Without a pool the order is filled against the book only
+	book := newBook()
+	expected := newBook()
+	remaining, liquidated, gain, _, selfTrade := expected.FillSellOrder(order, types.CancelNewest)
+	gotRemaining, gotLiquidated, gotGain, gotSelfTrade, swap := book.RouteSellOrder(order, types.CancelNewest, nil, sdk.ZeroDec())
+	require.Equal(t, remaining, gotRemaining)
+	require.Equal(t, liquidated, gotLiquidated)
+	require.Equal(t, gain, gotGain)
+	require.Equal(t, selfTrade, gotSelfTrade)
+	require.Equal(t, types.PoolSwap{}, swap)
+	require.Equal(t, expected, book)
+
+	// The pool buys down to every price level of the book before the level is filled,
+	// and down to the price of the order once the book is exhausted
+	book = newBook()
+	pool := newPool(1000, 10000)
+	gotRemaining, gotLiquidated, gotGain, _, swap = book.RouteSellOrder(order, types.CancelNewest, &pool, sdk.ZeroDec())
+	require.Equal(t, []types.Order{
+		{Id: 0, Creator: "alice", Amount: 10, Price: 9},
+		{Id: 1, Creator: "bob", Amount: 20, Price: 8},
+	}, gotLiquidated)
+	require.Equal(t, types.Order{Creator: "carol", Amount: 2, Price: 8}, gotRemaining)
+	require.Equal(t, types.PoolSwap{Amount: 118, Total: 1055}, swap)
+	require.Equal(t, int64(1055+10*9+20*8), gotGain)
+	require.Equal(t, sdk.NewInt(1118), pool.AmountReserve)
+	require.Equal(t, sdk.NewInt(8945), pool.PriceReserve)
+	require.Empty(t, book.Book.Orders)
+}
+
+func TestRouteBuyOrder(t *testing.T) {
+	book := types.NewSellOrderBook("stake", "token")
+	_, err := book.AppendOrder("alice", 10, 11, 0)
+	require.NoError(t, err)
+	pool := newPool(1000, 10000)
+
+	// The pool sells up to the lowest ask, the ask is filled, and the pool no longer sells at the price
+	remaining, liquidated, purchase, _, swap := book.RouteBuyOrder(types.Order{Creator: "carol", Amount: 100, Price: 11}, types.CancelNewest, &pool, sdk.ZeroDec())
+	require.Equal(t, []types.Order{{Id: 0, Creator: "alice", Amount: 10, Price: 11}}, liquidated)
+	require.Equal(t, int32(44), remaining.Amount)
+	require.Equal(t, int32(56), purchase)
+	require.Equal(t, types.PoolSwap{Amount: 46, Total: 483}, swap)
+	require.Equal(t, types.Order{Creator: types.LiquidityPoolAddress().String(), Amount: 46, Price: 11}, swap.Trade())
+}
+
+func TestPoolSwapFee(t *testing.T) {
+	fee := sdk.NewDecWithPrec(3, 3)
+
+	// The fee is the difference with a swap without fee, in the price denom
+	pool := newPool(1000, 10000)
+	require.True(t, pool.SellFee(100, fee).Equal(pool.SellOutput(100, sdk.ZeroDec()).Sub(pool.SellOutput(100, fee))))
+	require.True(t, pool.SellFee(100, fee).IsPositive())
+	require.True(t, pool.SellFee(100, sdk.ZeroDec()).IsZero())
+	require.True(t, pool.BuyFee(100, fee).IsPositive())
+	require.True(t, pool.BuyFee(1000, fee).IsZero())
+
+	// The fees of every trade with the pool are summed
+	book := types.NewSellOrderBook("stake", "token")
+	_, _, _, _, swap := book.RouteBuyOrder(types.Order{Creator: "carol", Amount: 50, Price: 20}, types.CancelNewest, &pool, fee)
+	require.Equal(t, int32(50), swap.Amount)
+	require.Equal(t, newPool(1000, 10000).BuyFee(50, fee).Int64(), swap.Fee)
+}
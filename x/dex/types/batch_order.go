@@ -0,0 +1,27 @@
+package types
+
+import "fmt"
+
+// MaxBatchSize is the maximum number of new orders or cancellations in a batch
+const MaxBatchSize = 100
+
+// validateOrderType checks the order type is sell or buy
+func validateOrderType(orderType string) error {
+	if orderType != OrderTypeSell && orderType != OrderTypeBuy {
+		return fmt.Errorf("invalid order type %s, must be %s or %s", orderType, OrderTypeSell, OrderTypeBuy)
+	}
+	return nil
+}
+
+// validateBatchOrders checks the amount and price of the orders of a batch
+func validateBatchOrders(orders []BatchOrder) error {
+	if len(orders) > MaxBatchSize {
+		return fmt.Errorf("too many orders in the batch: %d > %d", len(orders), MaxBatchSize)
+	}
+	for i, order := range orders {
+		if err := checkAmountAndPrice(order.Amount, order.Price); err != nil {
+			return fmt.Errorf("order %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,639 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/batch_order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BatchOrder is a new order placed with a batch of orders.
+type BatchOrder struct {
+	Amount int32 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price  int32 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *BatchOrder) Reset()         { *m = BatchOrder{} }
+func (m *BatchOrder) String() string { return proto.CompactTextString(m) }
+func (*BatchOrder) ProtoMessage()    {}
+func (*BatchOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45c2aa877cf20085, []int{0}
+}
+func (m *BatchOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOrder.Merge(m, src)
+}
+func (m *BatchOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOrder proto.InternalMessageInfo
+
+func (m *BatchOrder) GetAmount() int32 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *BatchOrder) GetPrice() int32 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+// BatchOrderResult is the outcome of an order of a batch on the target chain.
+type BatchOrderResult struct {
+	RemainingAmount int32 `protobuf:"varint,1,opt,name=remainingAmount,proto3" json:"remainingAmount,omitempty"`
+	// amount of price denom received by a sell order
+	Gain int64 `protobuf:"varint,2,opt,name=gain,proto3" json:"gain,omitempty"`
+	// amount of amount denom received by a buy order
+	Purchase int32 `protobuf:"varint,3,opt,name=purchase,proto3" json:"purchase,omitempty"`
+	// part of the price not spent by the fills of a buy order below its price
+	Refund int64 `protobuf:"varint,4,opt,name=refund,proto3" json:"refund,omitempty"`
+	// amount of the order cancelled by the self-trade prevention
+	PreventedAmount int32 `protobuf:"varint,5,opt,name=preventedAmount,proto3" json:"preventedAmount,omitempty"`
+}
+
+func (m *BatchOrderResult) Reset()         { *m = BatchOrderResult{} }
+func (m *BatchOrderResult) String() string { return proto.CompactTextString(m) }
+func (*BatchOrderResult) ProtoMessage()    {}
+func (*BatchOrderResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45c2aa877cf20085, []int{1}
+}
+func (m *BatchOrderResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOrderResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOrderResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchOrderResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOrderResult.Merge(m, src)
+}
+func (m *BatchOrderResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOrderResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOrderResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOrderResult proto.InternalMessageInfo
+
+func (m *BatchOrderResult) GetRemainingAmount() int32 {
+	if m != nil {
+		return m.RemainingAmount
+	}
+	return 0
+}
+
+func (m *BatchOrderResult) GetGain() int64 {
+	if m != nil {
+		return m.Gain
+	}
+	return 0
+}
+
+func (m *BatchOrderResult) GetPurchase() int32 {
+	if m != nil {
+		return m.Purchase
+	}
+	return 0
+}
+
+func (m *BatchOrderResult) GetRefund() int64 {
+	if m != nil {
+		return m.Refund
+	}
+	return 0
+}
+
+func (m *BatchOrderResult) GetPreventedAmount() int32 {
+	if m != nil {
+		return m.PreventedAmount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*BatchOrder)(nil), "interchange.dex.BatchOrder")
+	proto.RegisterType((*BatchOrderResult)(nil), "interchange.dex.BatchOrderResult")
+}
+
+func init() { proto.RegisterFile("dex/batch_order.proto", fileDescriptor_45c2aa877cf20085) }
+
+var fileDescriptor_45c2aa877cf20085 = []byte{
+	// 246 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0x49, 0xad, 0xd0,
+	0x4f, 0x4a, 0x2c, 0x49, 0xce, 0x88, 0xcf, 0x2f, 0x4a, 0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f,
+	0xc9, 0x17, 0xe2, 0xcf, 0xcc, 0x2b, 0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0x4b, 0x4f, 0xd5, 0x4b,
+	0x49, 0xad, 0x50, 0xb2, 0xe2, 0xe2, 0x72, 0x02, 0xa9, 0xf2, 0x07, 0x29, 0x12, 0x12, 0xe3, 0x62,
+	0x4b, 0xcc, 0xcd, 0x2f, 0xcd, 0x2b, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x0d, 0x82, 0xf2, 0x84,
+	0x44, 0xb8, 0x58, 0x0b, 0x8a, 0x32, 0x93, 0x53, 0x25, 0x98, 0xc0, 0xc2, 0x10, 0x8e, 0xd2, 0x3a,
+	0x46, 0x2e, 0x01, 0x84, 0xe6, 0xa0, 0xd4, 0xe2, 0xd2, 0x9c, 0x12, 0x21, 0x0d, 0x2e, 0xfe, 0xa2,
+	0xd4, 0xdc, 0xc4, 0xcc, 0xbc, 0xcc, 0xbc, 0x74, 0x47, 0x64, 0xb3, 0xd0, 0x85, 0x85, 0x84, 0xb8,
+	0x58, 0xd2, 0x13, 0x33, 0xf3, 0xc0, 0x66, 0x32, 0x07, 0x81, 0xd9, 0x42, 0x52, 0x5c, 0x1c, 0x05,
+	0xa5, 0x20, 0xf7, 0x15, 0xa7, 0x4a, 0x30, 0x83, 0xb5, 0xc1, 0xf9, 0x20, 0xc7, 0x15, 0xa5, 0xa6,
+	0x95, 0xe6, 0xa5, 0x48, 0xb0, 0x80, 0x75, 0x40, 0x79, 0x20, 0x1b, 0x0b, 0x8a, 0x52, 0xcb, 0x52,
+	0xf3, 0x4a, 0x52, 0x53, 0xa0, 0x36, 0xb2, 0x42, 0x6c, 0x44, 0x13, 0x76, 0x32, 0x3c, 0xf1, 0x48,
+	0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0,
+	0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x71, 0xa4, 0x70, 0xd1, 0xaf, 0xd0, 0x07, 0x05,
+	0x5e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xdc, 0x8c, 0x01, 0x03, 0x00, 0xa3, 0x18,
+	0x5a, 0x81, 0x50, 0x01, 0x00, 0x00,
+}
+
+func (m *BatchOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Price != 0 {
+		i = encodeVarintBatchOrder(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Amount != 0 {
+		i = encodeVarintBatchOrder(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchOrderResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOrderResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOrderResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreventedAmount != 0 {
+		i = encodeVarintBatchOrder(dAtA, i, uint64(m.PreventedAmount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Refund != 0 {
+		i = encodeVarintBatchOrder(dAtA, i, uint64(m.Refund))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Purchase != 0 {
+		i = encodeVarintBatchOrder(dAtA, i, uint64(m.Purchase))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Gain != 0 {
+		i = encodeVarintBatchOrder(dAtA, i, uint64(m.Gain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RemainingAmount != 0 {
+		i = encodeVarintBatchOrder(dAtA, i, uint64(m.RemainingAmount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBatchOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBatchOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BatchOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovBatchOrder(uint64(m.Amount))
+	}
+	if m.Price != 0 {
+		n += 1 + sovBatchOrder(uint64(m.Price))
+	}
+	return n
+}
+
+func (m *BatchOrderResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemainingAmount != 0 {
+		n += 1 + sovBatchOrder(uint64(m.RemainingAmount))
+	}
+	if m.Gain != 0 {
+		n += 1 + sovBatchOrder(uint64(m.Gain))
+	}
+	if m.Purchase != 0 {
+		n += 1 + sovBatchOrder(uint64(m.Purchase))
+	}
+	if m.Refund != 0 {
+		n += 1 + sovBatchOrder(uint64(m.Refund))
+	}
+	if m.PreventedAmount != 0 {
+		n += 1 + sovBatchOrder(uint64(m.PreventedAmount))
+	}
+	return n
+}
+
+func sovBatchOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBatchOrder(x uint64) (n int) {
+	return sovBatchOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BatchOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatchOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatchOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatchOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchOrderResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatchOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchOrderResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchOrderResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			m.RemainingAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingAmount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gain", wireType)
+			}
+			m.Gain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gain |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchase", wireType)
+			}
+			m.Purchase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Purchase |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			m.Refund = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Refund |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreventedAmount", wireType)
+			}
+			m.PreventedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreventedAmount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatchOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatchOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBatchOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBatchOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBatchOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBatchOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBatchOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBatchOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBatchOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBatchOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBatchOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBatchOrder = fmt.Errorf("proto: unexpected end of group")
+)
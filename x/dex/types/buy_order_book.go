@@ -9,27 +9,36 @@ func NewBuyOrderBook(AmountDenom string, PriceDenom string) BuyOrderBook {
 	}
 }
 
-func (b *BuyOrderBook) AppendOrder(creator string, amount int32, price int32) (int32, error) {
-	return b.Book.appendOrder(creator, amount, price, Decreasing)
+func (b *BuyOrderBook) AppendOrder(creator string, amount int32, price int32, maxOpenOrders uint32) (int32, error) {
+	// 最高入札額が末尾になるように昇順で並べる
+	return b.Book.appendOrder(creator, amount, price, Increasing, maxOpenOrders)
+}
+
+// 買い注文の数量と価格を変更し、変更前の注文を返す
+func (b *BuyOrderBook) AmendOrder(id int32, amount int32, price int32) (Order, error) {
+	return b.Book.amendOrder(id, amount, price, Increasing)
 }
 
 // オーダーブックで買い注文を約定しようとし、すべての副作用を返します。
-func (b *BuyOrderBook) FillSellOrder(order Order) (
+func (b *BuyOrderBook) FillSellOrder(order Order, stp SelfTradePrevention) (
 	remainingSellOrder Order,
 	liquidated []Order,
-	gain int32,
+	gain int64,
 	filled bool,
+	selfTrade SelfTrade,
 ) {
 	var liquidatedList []Order
-	totalGain := int32(0)
+	totalGain := int64(0)
 	remainingSellOrder = order
 
 	// 一致している限り清算する
 	for {
 		var match bool
 		var liquidation Order
-		remainingSellOrder, liquidation, gain, match, filled = b.LiquidateFromSellOrder(
+		var prevented SelfTrade
+		remainingSellOrder, liquidation, gain, match, filled, prevented = b.LiquidateFromSellOrder(
 			remainingSellOrder,
+			stp,
 		)
 		if !match {
 			break
@@ -38,38 +47,48 @@ func (b *BuyOrderBook) FillSellOrder(order Order) (
 		// 利益を更新する
 		totalGain += gain
 
-		// 清算リスト
-		liquidatedList = append(liquidatedList, liquidation)
+		// 自己取引防止で取り消された注文は清算されない
+		selfTrade.add(prevented)
+		if liquidation.Amount > 0 {
+			// 清算リスト
+			liquidatedList = append(liquidatedList, liquidation)
+		}
 
 		if filled {
 			break
 		}
 	}
 
-	return remainingSellOrder, liquidatedList, totalGain, filled
+	return remainingSellOrder, liquidatedList, totalGain, filled, selfTrade
 }
 
 // 買い注文から最初の売り注文を清算
 // 一致するものが見つからない場合、もしくは、一致する場合はfalseを返す
-func (b *BuyOrderBook) LiquidateFromSellOrder(order Order) (
+func (b *BuyOrderBook) LiquidateFromSellOrder(order Order, stp SelfTradePrevention) (
 	remainingSellOrder Order,
 	liquidatedBuyOrder Order,
-	gain int32,
+	gain int64,
 	match bool,
 	filled bool,
+	selfTrade SelfTrade,
 ) {
 	remainingSellOrder = order
 
 	// 注文がない場合は一致しない
 	orderCount := len(b.Book.Orders)
 	if orderCount == 0 {
-		return order, liquidatedBuyOrder, gain, false, false
+		return order, liquidatedBuyOrder, gain, false, false, selfTrade
 	}
 
 	// Check if match
 	highestBid := b.Book.Orders[orderCount-1]
 	if order.Price > highestBid.Price {
-		return order, liquidatedBuyOrder, gain, false, false
+		return order, liquidatedBuyOrder, gain, false, false, selfTrade
+	}
+
+	// 同じ作成者の注文とは約定させない
+	if selfTrade, prevented := b.Book.preventSelfTrade(&remainingSellOrder, stp); prevented {
+		return remainingSellOrder, liquidatedBuyOrder, gain, true, remainingSellOrder.Amount == 0, selfTrade
 	}
 
 	liquidatedBuyOrder = *highestBid
@@ -78,7 +97,7 @@ func (b *BuyOrderBook) LiquidateFromSellOrder(order Order) (
 	if highestBid.Amount >= order.Amount {
 		remainingSellOrder.Amount = 0
 		liquidatedBuyOrder.Amount = order.Amount
-		gain = order.Amount * highestBid.Price
+		gain = int64(order.Amount) * int64(highestBid.Price)
 
 		// それが完全に清算された場合、最高入札額を削除します
 		highestBid.Amount -= order.Amount
@@ -88,13 +107,13 @@ func (b *BuyOrderBook) LiquidateFromSellOrder(order Order) (
 			b.Book.Orders[orderCount-1] = highestBid
 		}
 
-		return remainingSellOrder, liquidatedBuyOrder, gain, true, true
+		return remainingSellOrder, liquidatedBuyOrder, gain, true, true, selfTrade
 	}
 
 	// 完全に満たされていない
-	gain = highestBid.Amount * highestBid.Price
+	gain = int64(highestBid.Amount) * int64(highestBid.Price)
 	b.Book.Orders = b.Book.Orders[:orderCount-1]
 	remainingSellOrder.Amount -= highestBid.Amount
 
-	return remainingSellOrder, liquidatedBuyOrder, gain, true, false
+	return remainingSellOrder, liquidatedBuyOrder, gain, true, false, selfTrade
 }
@@ -27,6 +27,8 @@ type BuyOrderBook struct {
 	AmountDenom string     `protobuf:"bytes,2,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
 	PriceDenom  string     `protobuf:"bytes,3,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
 	Book        *OrderBook `protobuf:"bytes,4,opt,name=book,proto3" json:"book,omitempty"`
+	// metadata of the amount denom sent by the chain creating the pair
+	AmountDenomMetadata *DenomMetadata `protobuf:"bytes,5,opt,name=amountDenomMetadata,proto3" json:"amountDenomMetadata,omitempty"`
 }
 
 func (m *BuyOrderBook) Reset()         { *m = BuyOrderBook{} }
@@ -90,6 +92,13 @@ func (m *BuyOrderBook) GetBook() *OrderBook {
 	return nil
 }
 
+func (m *BuyOrderBook) GetAmountDenomMetadata() *DenomMetadata {
+	if m != nil {
+		return m.AmountDenomMetadata
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BuyOrderBook)(nil), "interchange.dex.BuyOrderBook")
 }
@@ -97,21 +106,23 @@ func init() {
 func init() { proto.RegisterFile("dex/buy_order_book.proto", fileDescriptor_4e7e0a35566635fd) }
 
 var fileDescriptor_4e7e0a35566635fd = []byte{
-	// 216 bytes of a gzipped FileDescriptorProto
+	// 252 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x48, 0x49, 0xad, 0xd0,
 	0x4f, 0x2a, 0xad, 0x8c, 0xcf, 0x2f, 0x4a, 0x49, 0x2d, 0x8a, 0x4f, 0xca, 0xcf, 0xcf, 0xd6, 0x2b,
 	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xcf, 0xcc, 0x2b, 0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0x4b,
-	0x4f, 0xd5, 0x4b, 0x49, 0xad, 0x90, 0xe2, 0x07, 0x29, 0x05, 0x2b, 0x83, 0xa8, 0x50, 0x9a, 0xc6,
-	0xc8, 0xc5, 0xe3, 0x54, 0x5a, 0xe9, 0x0f, 0x12, 0x72, 0xca, 0xcf, 0xcf, 0x16, 0x12, 0xe1, 0x62,
-	0xcd, 0xcc, 0x4b, 0x49, 0xad, 0x90, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x82, 0x70, 0x84, 0x14,
-	0xb8, 0xb8, 0x13, 0x73, 0xf3, 0x4b, 0xf3, 0x4a, 0x5c, 0x52, 0xf3, 0xf2, 0x73, 0x25, 0x98, 0xc0,
-	0x72, 0xc8, 0x42, 0x42, 0x72, 0x5c, 0x5c, 0x05, 0x45, 0x99, 0xc9, 0xa9, 0x10, 0x05, 0xcc, 0x60,
-	0x05, 0x48, 0x22, 0x42, 0x7a, 0x5c, 0x2c, 0x20, 0x87, 0x49, 0xb0, 0x28, 0x30, 0x6a, 0x70, 0x1b,
-	0x49, 0xe9, 0xa1, 0xb9, 0x4c, 0x0f, 0xee, 0x82, 0x20, 0xb0, 0x3a, 0x27, 0xc3, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x12, 0x47, 0xd2, 0xaa, 0x5f, 0xa1, 0x0f, 0xf2, 0x51,
-	0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x4b, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x34, 0xdb, 0xdc, 0x1d, 0x10, 0x01, 0x00, 0x00,
+	0x4f, 0xd5, 0x4b, 0x49, 0xad, 0x90, 0xe2, 0x07, 0x29, 0x05, 0x2b, 0x83, 0xa8, 0x90, 0x02, 0xeb,
+	0x4d, 0x49, 0xcd, 0xcb, 0xcf, 0x8d, 0xcf, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0x84, 0xc8,
+	0x28, 0xbd, 0x60, 0xe4, 0xe2, 0x71, 0x2a, 0xad, 0xf4, 0x07, 0x29, 0x76, 0xca, 0xcf, 0xcf, 0x16,
+	0x12, 0xe1, 0x62, 0xcd, 0xcc, 0x4b, 0x49, 0xad, 0x90, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x82,
+	0x70, 0x84, 0x14, 0xb8, 0xb8, 0x13, 0x73, 0xf3, 0x4b, 0xf3, 0x4a, 0x5c, 0x40, 0x86, 0x48, 0x30,
+	0x81, 0xe5, 0x90, 0x85, 0x84, 0xe4, 0xb8, 0xb8, 0x0a, 0x8a, 0x32, 0x93, 0x53, 0x21, 0x0a, 0x98,
+	0xc1, 0x0a, 0x90, 0x44, 0x84, 0xf4, 0xb8, 0x58, 0x40, 0x4e, 0x96, 0x60, 0x51, 0x60, 0xd4, 0xe0,
+	0x36, 0x92, 0xd2, 0x43, 0x73, 0xb3, 0x1e, 0xdc, 0x05, 0x41, 0x60, 0x75, 0x42, 0x01, 0x5c, 0xc2,
+	0x48, 0xc6, 0xfb, 0x42, 0x5d, 0x2d, 0xc1, 0x0a, 0xd6, 0x2e, 0x87, 0xa1, 0x1d, 0x45, 0x55, 0x10,
+	0x36, 0xad, 0x4e, 0x86, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c,
+	0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0x8e,
+	0x64, 0x9a, 0x3e, 0x28, 0xa0, 0x2a, 0xf4, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x81,
+	0x64, 0x0c, 0x18, 0x00, 0xec, 0xb9, 0xda, 0xa6, 0x7c, 0x01, 0x00, 0x00,
 }
 
 func (m *BuyOrderBook) Marshal() (dAtA []byte, err error) {
@@ -134,6 +145,18 @@ func (m *BuyOrderBook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AmountDenomMetadata != nil {
+		{
+			size, err := m.AmountDenomMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBuyOrderBook(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Book != nil {
 		{
 			size, err := m.Book.MarshalToSizedBuffer(dAtA[:i])
@@ -203,6 +226,10 @@ func (m *BuyOrderBook) Size() (n int) {
 		l = m.Book.Size()
 		n += 1 + l + sovBuyOrderBook(uint64(l))
 	}
+	if m.AmountDenomMetadata != nil {
+		l = m.AmountDenomMetadata.Size()
+		n += 1 + l + sovBuyOrderBook(uint64(l))
+	}
 	return n
 }
 
@@ -373,6 +400,42 @@ func (m *BuyOrderBook) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountDenomMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyOrderBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyOrderBook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyOrderBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AmountDenomMetadata == nil {
+				m.AmountDenomMetadata = &DenomMetadata{}
+			}
+			if err := m.AmountDenomMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBuyOrderBook(dAtA[iNdEx:])
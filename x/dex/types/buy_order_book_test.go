@@ -32,19 +32,27 @@ func TestAppendOrder(t *testing.T) {
 
 	// amount:ゼロを防ぐ
 	seller, amount, price := GenOrder()
-	_, err := buyBook.AppendOrder(seller, 0, price)
+	_, err := buyBook.AppendOrder(seller, 0, price, 0)
 	require.ErrorIs(t, err, types.ErrZeroAmount)
 
+	// amount:負の値を防ぐ
+	_, err = buyBook.AppendOrder(seller, -amount, price, 0)
+	require.ErrorIs(t, err, types.ErrNegativeAmount)
+
 	// amount:最大値以上を防ぐ
-	_, err = buyBook.AppendOrder(seller, types.MaxAmount+1, price)
+	_, err = buyBook.AppendOrder(seller, types.MaxAmount+1, price, 0)
 	require.ErrorIs(t, err, types.ErrMaxAmount)
 
 	// price:ゼロを防ぐ
-	_, err = buyBook.AppendOrder(seller, amount, 0)
+	_, err = buyBook.AppendOrder(seller, amount, 0, 0)
 	require.ErrorIs(t, err, types.ErrZeroPrice)
 
+	// price:負の値を防ぐ
+	_, err = buyBook.AppendOrder(seller, amount, -price, 0)
+	require.ErrorIs(t, err, types.ErrNegativePrice)
+
 	// price:最大値以上を防ぐ
-	_, err = buyBook.AppendOrder(seller, amount, types.MaxPrice+1)
+	_, err = buyBook.AppendOrder(seller, amount, types.MaxPrice+1, 0)
 	require.ErrorIs(t, err, types.ErrMaxPrice)
 
 	// 買い注文を追加できる
@@ -57,7 +65,7 @@ func TestAppendOrder(t *testing.T) {
 			Amount:  amount,
 			Price:   price,
 		}
-		orderID, err := buyBook.AppendOrder(creator, amount, price)
+		orderID, err := buyBook.AppendOrder(creator, amount, price, 0)
 
 		// Checks
 		require.NoError(t, err)
@@ -75,7 +83,7 @@ type liquidateSellRes struct {
 	Book       []types.Order
 	Remaining  types.Order
 	Liquidated types.Order
-	Gain       int32
+	Gain       int64
 	Match      bool
 	Filled     bool
 }
@@ -96,7 +104,7 @@ func simulateLiquidateFromSellOrder(
 		return expectedBook.Book.Orders[i].Price < expectedBook.Book.Orders[j].Price
 	}))
 
-	remaining, liquidated, gain, match, filled := book.LiquidateFromSellOrder(inputOrder)
+	remaining, liquidated, gain, match, filled, _ := book.LiquidateFromSellOrder(inputOrder, types.SelfTradeAllowed)
 
 	require.Equal(t, expectedBook, book)
 	require.Equal(t, expected.Remaining, remaining)
@@ -110,7 +118,7 @@ func TestLiquidateFromSellOrder(t *testing.T) {
 	// No match for empty book
 	inputOrder := types.Order{Id: 10, Creator: MockAccount("1"), Amount: 100, Price: 30}
 	book := OrderListToBuyOrderBook([]types.Order{})
-	_, _, _, match, _ := book.LiquidateFromSellOrder(inputOrder)
+	_, _, _, match, _, _ := book.LiquidateFromSellOrder(inputOrder, types.SelfTradeAllowed)
 	require.False(t, match)
 
 	// Buy book
@@ -122,7 +130,7 @@ func TestLiquidateFromSellOrder(t *testing.T) {
 
 	// Test no match if highest bid too low (25 < 30)
 	book = OrderListToBuyOrderBook(inputBook)
-	_, _, _, match, _ = book.LiquidateFromSellOrder(inputOrder)
+	_, _, _, match, _, _ = book.LiquidateFromSellOrder(inputOrder, types.SelfTradeAllowed)
 	require.False(t, match)
 
 	// Entirely filled (30 < 50)
@@ -135,7 +143,7 @@ func TestLiquidateFromSellOrder(t *testing.T) {
 		},
 		Remaining:  types.Order{Id: 10, Creator: MockAccount("1"), Amount: 0, Price: 22},
 		Liquidated: types.Order{Id: 0, Creator: MockAccount("0"), Amount: 30, Price: 25},
-		Gain:       int32(30 * 25),
+		Gain:       int64(30 * 25),
 		Match:      true,
 		Filled:     true,
 	}
@@ -150,7 +158,7 @@ func TestLiquidateFromSellOrder(t *testing.T) {
 		},
 		Remaining:  types.Order{Id: 10, Creator: MockAccount("1"), Amount: 0, Price: 15},
 		Liquidated: types.Order{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 25},
-		Gain:       int32(50 * 25),
+		Gain:       int64(50 * 25),
 		Match:      true,
 		Filled:     true,
 	}
@@ -165,7 +173,7 @@ func TestLiquidateFromSellOrder(t *testing.T) {
 		},
 		Remaining:  types.Order{Id: 10, Creator: MockAccount("1"), Amount: 10, Price: 10},
 		Liquidated: types.Order{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 25},
-		Gain:       int32(50 * 25),
+		Gain:       int64(50 * 25),
 		Match:      true,
 		Filled:     false,
 	}
@@ -176,7 +184,7 @@ type fillSellRes struct {
 	Book       []types.Order
 	Remaining  types.Order
 	Liquidated []types.Order
-	Gain       int32
+	Gain       int64
 	Filled     bool
 }
 
@@ -196,7 +204,7 @@ func simulateFillSellOrder(
 		return expectedBook.Book.Orders[i].Price < expectedBook.Book.Orders[j].Price
 	}))
 
-	remaining, liquidated, gain, filled := book.FillSellOrder(inputOrder)
+	remaining, liquidated, gain, filled, _ := book.FillSellOrder(inputOrder, types.SelfTradeAllowed)
 
 	require.Equal(t, expectedBook, book)
 	require.Equal(t, expected.Remaining, remaining)
@@ -214,7 +222,7 @@ func TestFillSellOrder(t *testing.T) {
 		Book:       []types.Order{},
 		Remaining:  inputOrder,
 		Liquidated: []types.Order(nil),
-		Gain:       int32(0),
+		Gain:       int64(0),
 		Filled:     false,
 	}
 	simulateFillSellOrder(t, inputBook, inputOrder, expected)
@@ -229,7 +237,7 @@ func TestFillSellOrder(t *testing.T) {
 		Book:       inputBook,
 		Remaining:  inputOrder,
 		Liquidated: []types.Order(nil),
-		Gain:       int32(0),
+		Gain:       int64(0),
 		Filled:     false,
 	}
 	simulateFillSellOrder(t, inputBook, inputOrder, expected)
@@ -245,7 +253,7 @@ func TestFillSellOrder(t *testing.T) {
 		Liquidated: []types.Order{
 			{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 25},
 		},
-		Gain:   int32(50 * 25),
+		Gain:   int64(50 * 25),
 		Filled: false,
 	}
 	simulateFillSellOrder(t, inputBook, inputOrder, expected)
@@ -262,7 +270,7 @@ func TestFillSellOrder(t *testing.T) {
 			{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 25},
 			{Id: 1, Creator: MockAccount("1"), Amount: 10, Price: 20},
 		},
-		Gain:   int32(50*25 + 10*20),
+		Gain:   int64(50*25 + 10*20),
 		Filled: true,
 	}
 	simulateFillSellOrder(t, inputBook, inputOrder, expected)
@@ -277,8 +285,88 @@ func TestFillSellOrder(t *testing.T) {
 			{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
 			{Id: 2, Creator: MockAccount("2"), Amount: 30, Price: 15},
 		},
-		Gain:   int32(50*25 + 200*20 + 30*15),
+		Gain:   int64(50*25 + 200*20 + 30*15),
 		Filled: false,
 	}
 	simulateFillSellOrder(t, inputBook, inputOrder, expected)
 }
+
+func TestFillSellOrderSelfTradePrevention(t *testing.T) {
+	inputBook := []types.Order{
+		{Id: 2, Creator: MockAccount("2"), Amount: 30, Price: 15},
+		{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
+		{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 25},
+	}
+	inputOrder := types.Order{Id: 10, Creator: MockAccount("1"), Amount: 60, Price: 18}
+	liquidated := []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 25},
+	}
+
+	for _, tc := range []struct {
+		desc      string
+		stp       types.SelfTradePrevention
+		expected  fillSellRes
+		selfTrade types.SelfTrade
+	}{
+		{
+			desc: "cancel newest",
+			stp:  types.CancelNewest,
+			expected: fillSellRes{
+				Book: []types.Order{
+					{Id: 2, Creator: MockAccount("2"), Amount: 30, Price: 15},
+					{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
+				},
+				Remaining:  types.Order{Id: 10, Creator: MockAccount("1"), Amount: 0, Price: 18},
+				Liquidated: liquidated,
+				Gain:       int64(50 * 25),
+				Filled:     true,
+			},
+			selfTrade: types.SelfTrade{Prevented: 10},
+		},
+		{
+			desc: "cancel oldest",
+			stp:  types.CancelOldest,
+			expected: fillSellRes{
+				Book: []types.Order{
+					{Id: 2, Creator: MockAccount("2"), Amount: 30, Price: 15},
+				},
+				Remaining:  types.Order{Id: 10, Creator: MockAccount("1"), Amount: 10, Price: 18},
+				Liquidated: liquidated,
+				Gain:       int64(50 * 25),
+				Filled:     false,
+			},
+			selfTrade: types.SelfTrade{Cancelled: []types.Order{
+				{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
+			}},
+		},
+		{
+			desc: "decrement both",
+			stp:  types.DecrementBoth,
+			expected: fillSellRes{
+				Book: []types.Order{
+					{Id: 2, Creator: MockAccount("2"), Amount: 30, Price: 15},
+					{Id: 1, Creator: MockAccount("1"), Amount: 190, Price: 20},
+				},
+				Remaining:  types.Order{Id: 10, Creator: MockAccount("1"), Amount: 0, Price: 18},
+				Liquidated: liquidated,
+				Gain:       int64(50 * 25),
+				Filled:     true,
+			},
+			selfTrade: types.SelfTrade{Prevented: 10, Cancelled: []types.Order{
+				{Id: 1, Creator: MockAccount("1"), Amount: 10, Price: 20},
+			}},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			book := OrderListToBuyOrderBook(inputBook)
+			remaining, liquidated, gain, filled, selfTrade := book.FillSellOrder(inputOrder, tc.stp)
+
+			require.Equal(t, OrderListToBuyOrderBook(tc.expected.Book), book)
+			require.Equal(t, tc.expected.Remaining, remaining)
+			require.Equal(t, tc.expected.Liquidated, liquidated)
+			require.Equal(t, tc.expected.Gain, gain)
+			require.Equal(t, tc.expected.Filled, filled)
+			require.Equal(t, tc.selfTrade, selfTrade)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TickerWindow is the period covered by the ticker of a pair
+	TickerWindow = 24 * time.Hour
+
+	// TickerBucketInterval is the interval of the candles the ticker is computed from,
+	// the window of the ticker is rounded to whole buckets
+	TickerBucketInterval = time.Hour
+)
+
+// NewCandle returns the candle of the interval containing the time, opened by a trade
+func NewCandle(pairIndex string, interval time.Duration, t time.Time, price int32) Candle {
+	return Candle{
+		PairIndex: pairIndex,
+		Interval:  interval,
+		OpenTime:  t.UTC().Truncate(interval),
+		Open:      price,
+		High:      price,
+		Low:       price,
+		Close:     price,
+	}
+}
+
+// AddTrade aggregates a trade in the candle
+func (c *Candle) AddTrade(amount int32, price int32) {
+	if price > c.High {
+		c.High = price
+	}
+	if price < c.Low {
+		c.Low = price
+	}
+	c.Close = price
+	c.Volume += int64(amount)
+	c.Trades++
+}
+
+// CloseTime returns the end of the interval of the candle
+func (c Candle) CloseTime() time.Time {
+	return c.OpenTime.Add(c.Interval)
+}
+
+// Validate checks the candle is well formed
+func (c Candle) Validate() error {
+	if c.Interval <= 0 {
+		return fmt.Errorf("candle interval must be positive: %s", c.Interval)
+	}
+	if !c.OpenTime.Equal(c.OpenTime.Truncate(c.Interval)) {
+		return fmt.Errorf("candle open time %s is not aligned on its interval %s", c.OpenTime, c.Interval)
+	}
+	if c.Low > c.High || c.Open < c.Low || c.Open > c.High || c.Close < c.Low || c.Close > c.High {
+		return fmt.Errorf("invalid candle prices: open %d, high %d, low %d, close %d", c.Open, c.High, c.Low, c.Close)
+	}
+	if c.Volume < 0 {
+		return fmt.Errorf("candle volume cannot be negative: %d", c.Volume)
+	}
+	return nil
+}
+
+// NewTicker computes the ticker of a pair from the last price and the candles of the window, oldest first
+func NewTicker(pairIndex string, lastPrice int32, candles []Candle) Ticker {
+	ticker := Ticker{
+		PairIndex:  pairIndex,
+		LastPrice:  lastPrice,
+		Open:       lastPrice,
+		High:       lastPrice,
+		Low:        lastPrice,
+		ChangeRate: sdk.ZeroDec(),
+	}
+	if len(candles) == 0 {
+		return ticker
+	}
+
+	ticker.Open = candles[0].Open
+	ticker.High = candles[0].High
+	ticker.Low = candles[0].Low
+	for _, candle := range candles {
+		if candle.High > ticker.High {
+			ticker.High = candle.High
+		}
+		if candle.Low < ticker.Low {
+			ticker.Low = candle.Low
+		}
+		ticker.Volume += candle.Volume
+		ticker.Trades += candle.Trades
+	}
+	ticker.Change = ticker.LastPrice - ticker.Open
+	if ticker.Open != 0 {
+		ticker.ChangeRate = sdk.NewDec(int64(ticker.Change)).QuoInt64(int64(ticker.Open))
+	}
+	return ticker
+}
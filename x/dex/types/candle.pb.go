@@ -0,0 +1,1123 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/candle.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Candle aggregates the trades matched in an order book during an interval.
+type Candle struct {
+	// index of the order book of the pair
+	PairIndex string        `protobuf:"bytes,1,opt,name=pairIndex,proto3" json:"pairIndex,omitempty"`
+	Interval  time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval"`
+	// start of the interval, aligned on the interval
+	OpenTime time.Time `protobuf:"bytes,3,opt,name=openTime,proto3,stdtime" json:"openTime"`
+	Open     int32     `protobuf:"varint,4,opt,name=open,proto3" json:"open,omitempty"`
+	High     int32     `protobuf:"varint,5,opt,name=high,proto3" json:"high,omitempty"`
+	Low      int32     `protobuf:"varint,6,opt,name=low,proto3" json:"low,omitempty"`
+	Close    int32     `protobuf:"varint,7,opt,name=close,proto3" json:"close,omitempty"`
+	// amount traded, in the amount denom
+	Volume int64 `protobuf:"varint,8,opt,name=volume,proto3" json:"volume,omitempty"`
+	// number of orders filled
+	Trades uint64 `protobuf:"varint,9,opt,name=trades,proto3" json:"trades,omitempty"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf37d12114793e49, []int{0}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetPairIndex() string {
+	if m != nil {
+		return m.PairIndex
+	}
+	return ""
+}
+
+func (m *Candle) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *Candle) GetOpenTime() time.Time {
+	if m != nil {
+		return m.OpenTime
+	}
+	return time.Time{}
+}
+
+func (m *Candle) GetOpen() int32 {
+	if m != nil {
+		return m.Open
+	}
+	return 0
+}
+
+func (m *Candle) GetHigh() int32 {
+	if m != nil {
+		return m.High
+	}
+	return 0
+}
+
+func (m *Candle) GetLow() int32 {
+	if m != nil {
+		return m.Low
+	}
+	return 0
+}
+
+func (m *Candle) GetClose() int32 {
+	if m != nil {
+		return m.Close
+	}
+	return 0
+}
+
+func (m *Candle) GetVolume() int64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+func (m *Candle) GetTrades() uint64 {
+	if m != nil {
+		return m.Trades
+	}
+	return 0
+}
+
+// Ticker is the market stats of an order book over the last 24 hours.
+type Ticker struct {
+	PairIndex string `protobuf:"bytes,1,opt,name=pairIndex,proto3" json:"pairIndex,omitempty"`
+	// price of the last trade, even if older than 24 hours
+	LastPrice int32 `protobuf:"varint,2,opt,name=lastPrice,proto3" json:"lastPrice,omitempty"`
+	// price of the first trade of the window
+	Open   int32  `protobuf:"varint,3,opt,name=open,proto3" json:"open,omitempty"`
+	High   int32  `protobuf:"varint,4,opt,name=high,proto3" json:"high,omitempty"`
+	Low    int32  `protobuf:"varint,5,opt,name=low,proto3" json:"low,omitempty"`
+	Volume int64  `protobuf:"varint,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	Trades uint64 `protobuf:"varint,7,opt,name=trades,proto3" json:"trades,omitempty"`
+	// last price minus open price
+	Change int32 `protobuf:"varint,8,opt,name=change,proto3" json:"change,omitempty"`
+	// change relative to the open price
+	ChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=changeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"changeRate"`
+}
+
+func (m *Ticker) Reset()         { *m = Ticker{} }
+func (m *Ticker) String() string { return proto.CompactTextString(m) }
+func (*Ticker) ProtoMessage()    {}
+func (*Ticker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf37d12114793e49, []int{1}
+}
+func (m *Ticker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Ticker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Ticker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Ticker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ticker.Merge(m, src)
+}
+func (m *Ticker) XXX_Size() int {
+	return m.Size()
+}
+func (m *Ticker) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ticker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ticker proto.InternalMessageInfo
+
+func (m *Ticker) GetPairIndex() string {
+	if m != nil {
+		return m.PairIndex
+	}
+	return ""
+}
+
+func (m *Ticker) GetLastPrice() int32 {
+	if m != nil {
+		return m.LastPrice
+	}
+	return 0
+}
+
+func (m *Ticker) GetOpen() int32 {
+	if m != nil {
+		return m.Open
+	}
+	return 0
+}
+
+func (m *Ticker) GetHigh() int32 {
+	if m != nil {
+		return m.High
+	}
+	return 0
+}
+
+func (m *Ticker) GetLow() int32 {
+	if m != nil {
+		return m.Low
+	}
+	return 0
+}
+
+func (m *Ticker) GetVolume() int64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+func (m *Ticker) GetTrades() uint64 {
+	if m != nil {
+		return m.Trades
+	}
+	return 0
+}
+
+func (m *Ticker) GetChange() int32 {
+	if m != nil {
+		return m.Change
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Candle)(nil), "interchange.dex.Candle")
+	proto.RegisterType((*Ticker)(nil), "interchange.dex.Ticker")
+}
+
+func init() { proto.RegisterFile("dex/candle.proto", fileDescriptor_bf37d12114793e49) }
+
+var fileDescriptor_bf37d12114793e49 = []byte{
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x41, 0x8e, 0xd3, 0x30,
+	0x14, 0xad, 0xdb, 0x26, 0xd3, 0x98, 0x05, 0x23, 0x6b, 0x04, 0xa6, 0x1a, 0xa5, 0xd1, 0x2c, 0x50,
+	0x36, 0x24, 0x02, 0x0e, 0x00, 0x2a, 0xb3, 0x61, 0x83, 0x90, 0xd5, 0x15, 0x3b, 0x37, 0xf9, 0xa4,
+	0xd6, 0x24, 0x71, 0x14, 0xbb, 0x43, 0xb9, 0xc5, 0xac, 0x10, 0x57, 0xe0, 0x26, 0xb3, 0x9c, 0x25,
+	0x62, 0x31, 0xa0, 0xf6, 0x22, 0xc8, 0x76, 0x3a, 0xa9, 0x18, 0x10, 0xab, 0xbc, 0xff, 0xbe, 0xfd,
+	0xfc, 0xde, 0xcf, 0xc7, 0xc7, 0x39, 0x6c, 0xd2, 0x8c, 0xd7, 0x79, 0x09, 0x49, 0xd3, 0x4a, 0x2d,
+	0xc9, 0x43, 0x51, 0x6b, 0x68, 0xb3, 0x15, 0xaf, 0x0b, 0x48, 0x72, 0xd8, 0x4c, 0x4f, 0x0a, 0x59,
+	0x48, 0xdb, 0x4b, 0x0d, 0x72, 0xc7, 0xa6, 0x61, 0x21, 0x65, 0x51, 0x42, 0x6a, 0xab, 0xe5, 0xfa,
+	0x63, 0x9a, 0xaf, 0x5b, 0xae, 0x85, 0xac, 0xbb, 0xfe, 0xec, 0xcf, 0xbe, 0x16, 0x15, 0x28, 0xcd,
+	0xab, 0xc6, 0x1d, 0x38, 0xfb, 0x36, 0xc4, 0xfe, 0x1b, 0xfb, 0x30, 0x39, 0xc5, 0x41, 0xc3, 0x45,
+	0xfb, 0xb6, 0xce, 0x61, 0x43, 0x51, 0x84, 0xe2, 0x80, 0xf5, 0x04, 0x79, 0x85, 0x27, 0xd6, 0xd2,
+	0x25, 0x2f, 0xe9, 0x30, 0x42, 0xf1, 0x83, 0x17, 0x4f, 0x12, 0x27, 0x9e, 0xec, 0xc5, 0x93, 0xf3,
+	0xee, 0xf1, 0xf9, 0xe4, 0xfa, 0x76, 0x36, 0xf8, 0xfa, 0x73, 0x86, 0xd8, 0xdd, 0x25, 0xf2, 0x1a,
+	0x4f, 0x64, 0x03, 0xf5, 0x42, 0x54, 0x40, 0x47, 0x56, 0x60, 0x7a, 0x4f, 0x60, 0xb1, 0x77, 0xe7,
+	0x14, 0xae, 0xac, 0xc2, 0xfe, 0x16, 0x21, 0x78, 0x6c, 0x30, 0x1d, 0x47, 0x28, 0xf6, 0x98, 0xc5,
+	0x86, 0x5b, 0x89, 0x62, 0x45, 0x3d, 0xc7, 0x19, 0x4c, 0x8e, 0xf1, 0xa8, 0x94, 0x9f, 0xa8, 0x6f,
+	0x29, 0x03, 0xc9, 0x09, 0xf6, 0xb2, 0x52, 0x2a, 0xa0, 0x47, 0x96, 0x73, 0x05, 0x79, 0x84, 0xfd,
+	0x4b, 0x59, 0xae, 0x2b, 0xa0, 0x93, 0x08, 0xc5, 0x23, 0xd6, 0x55, 0x86, 0xd7, 0x2d, 0xcf, 0x41,
+	0xd1, 0x20, 0x42, 0xf1, 0x98, 0x75, 0xd5, 0xd9, 0x97, 0x21, 0xf6, 0x17, 0x22, 0xbb, 0x80, 0xf6,
+	0x3f, 0xb3, 0x3a, 0xc5, 0x41, 0xc9, 0x95, 0x7e, 0xdf, 0x8a, 0x0c, 0xec, 0xb0, 0x3c, 0xd6, 0x13,
+	0x77, 0x31, 0x46, 0x7f, 0x89, 0x31, 0xbe, 0x1f, 0xc3, 0xeb, 0x63, 0xf4, 0x86, 0xfd, 0x7f, 0x18,
+	0x3e, 0x3a, 0x34, 0x6c, 0x78, 0xb7, 0x41, 0x36, 0xa0, 0xc7, 0xba, 0x8a, 0xbc, 0xc3, 0xd8, 0x21,
+	0xc6, 0x35, 0xd8, 0x90, 0xc1, 0x3c, 0x31, 0x03, 0xff, 0x71, 0x3b, 0x7b, 0x5a, 0x08, 0xbd, 0x5a,
+	0x2f, 0x93, 0x4c, 0x56, 0x69, 0x26, 0x55, 0x25, 0x55, 0xf7, 0x79, 0xa6, 0xf2, 0x8b, 0x54, 0x7f,
+	0x6e, 0x40, 0x25, 0xe7, 0x90, 0xb1, 0x03, 0x85, 0xf9, 0xf3, 0xeb, 0x6d, 0x88, 0x6e, 0xb6, 0x21,
+	0xfa, 0xb5, 0x0d, 0xd1, 0xd5, 0x2e, 0x1c, 0xdc, 0xec, 0xc2, 0xc1, 0xf7, 0x5d, 0x38, 0xf8, 0xf0,
+	0xf8, 0x60, 0x8d, 0xd3, 0x4d, 0x6a, 0xd6, 0xdc, 0x4a, 0x2c, 0x7d, 0xfb, 0xcf, 0x5f, 0xfe, 0x1e,
+	0x00, 0x0b, 0xf3, 0xb7, 0x9e, 0xfa, 0x02, 0x00, 0x00,
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Trades != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.Trades))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Volume != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Close != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.Close))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Low != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.Low))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.High != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.High))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Open != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.Open))
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.OpenTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.OpenTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCandle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCandle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.PairIndex) > 0 {
+		i -= len(m.PairIndex)
+		copy(dAtA[i:], m.PairIndex)
+		i = encodeVarintCandle(dAtA, i, uint64(len(m.PairIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Ticker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Ticker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ticker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ChangeRate.Size()
+		i -= size
+		if _, err := m.ChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Change != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.Change))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Trades != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.Trades))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Volume != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Low != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.Low))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.High != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.High))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Open != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.Open))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastPrice != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.LastPrice))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PairIndex) > 0 {
+		i -= len(m.PairIndex)
+		copy(dAtA[i:], m.PairIndex)
+		i = encodeVarintCandle(dAtA, i, uint64(len(m.PairIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCandle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCandle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairIndex)
+	if l > 0 {
+		n += 1 + l + sovCandle(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovCandle(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.OpenTime)
+	n += 1 + l + sovCandle(uint64(l))
+	if m.Open != 0 {
+		n += 1 + sovCandle(uint64(m.Open))
+	}
+	if m.High != 0 {
+		n += 1 + sovCandle(uint64(m.High))
+	}
+	if m.Low != 0 {
+		n += 1 + sovCandle(uint64(m.Low))
+	}
+	if m.Close != 0 {
+		n += 1 + sovCandle(uint64(m.Close))
+	}
+	if m.Volume != 0 {
+		n += 1 + sovCandle(uint64(m.Volume))
+	}
+	if m.Trades != 0 {
+		n += 1 + sovCandle(uint64(m.Trades))
+	}
+	return n
+}
+
+func (m *Ticker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairIndex)
+	if l > 0 {
+		n += 1 + l + sovCandle(uint64(l))
+	}
+	if m.LastPrice != 0 {
+		n += 1 + sovCandle(uint64(m.LastPrice))
+	}
+	if m.Open != 0 {
+		n += 1 + sovCandle(uint64(m.Open))
+	}
+	if m.High != 0 {
+		n += 1 + sovCandle(uint64(m.High))
+	}
+	if m.Low != 0 {
+		n += 1 + sovCandle(uint64(m.Low))
+	}
+	if m.Volume != 0 {
+		n += 1 + sovCandle(uint64(m.Volume))
+	}
+	if m.Trades != 0 {
+		n += 1 + sovCandle(uint64(m.Trades))
+	}
+	if m.Change != 0 {
+		n += 1 + sovCandle(uint64(m.Change))
+	}
+	l = m.ChangeRate.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	return n
+}
+
+func sovCandle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCandle(x uint64) (n int) {
+	return sovCandle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Candle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCandle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.OpenTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			m.Open = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Open |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			m.High = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.High |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			m.Low = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Low |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+			}
+			m.Close = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Close |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			m.Trades = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Trades |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCandle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Ticker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCandle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ticker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ticker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			m.LastPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPrice |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			m.Open = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Open |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			m.High = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.High |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			m.Low = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Low |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			m.Trades = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Trades |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+			}
+			m.Change = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Change |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCandle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCandle(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCandle
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCandle
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCandle
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCandle
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCandle        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCandle          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCandle = fmt.Errorf("proto: unexpected end of group")
+)
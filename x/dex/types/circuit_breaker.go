@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// msgTypeURLPrefix is the prefix of the type URL of the module messages
+const msgTypeURLPrefix = "/interchange.dex.Msg"
+
+// ValidateCircuitBreakerTypes checks the message and packet types given to the circuit breaker.
+// The message setting the circuit breaker cannot be disabled, otherwise the authority could lock itself out.
+func ValidateCircuitBreakerTypes(msgTypes, packetTypes []string) error {
+	if len(msgTypes) == 0 && len(packetTypes) == 0 {
+		return fmt.Errorf("at least one message or packet type must be provided")
+	}
+
+	seen := make(map[string]struct{})
+	for _, msgType := range msgTypes {
+		if !strings.HasPrefix(msgType, msgTypeURLPrefix) {
+			return fmt.Errorf("%s is not a %s message type", msgType, ModuleName)
+		}
+		if msgType == sdk.MsgTypeURL(&MsgSetCircuitBreaker{}) {
+			return fmt.Errorf("%s cannot be disabled", msgType)
+		}
+		if _, ok := seen[msgType]; ok {
+			return fmt.Errorf("duplicated message type %s", msgType)
+		}
+		seen[msgType] = struct{}{}
+	}
+	for _, packetType := range packetTypes {
+		if strings.TrimSpace(packetType) == "" {
+			return fmt.Errorf("packet type cannot be blank")
+		}
+		if _, ok := seen[packetType]; ok {
+			return fmt.Errorf("duplicated packet type %s", packetType)
+		}
+		seen[packetType] = struct{}{}
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -13,6 +14,23 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSendBuyOrder{}, "dex/SendBuyOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelSellOrder{}, "dex/CancelSellOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelBuyOrder{}, "dex/CancelBuyOrder", nil)
+	cdc.RegisterConcrete(&ResetRateLimitQuotaProposal{}, "dex/ResetRateLimitQuotaProposal", nil)
+	cdc.RegisterConcrete(&AllowPairCreationProposal{}, "dex/AllowPairCreationProposal", nil)
+	cdc.RegisterConcrete(&PausePairProposal{}, "dex/PausePairProposal", nil)
+	cdc.RegisterConcrete(&DelistPairProposal{}, "dex/DelistPairProposal", nil)
+	cdc.RegisterConcrete(&MsgSetCircuitBreaker{}, "dex/SetCircuitBreaker", nil)
+	cdc.RegisterConcrete(&CircuitBreakerProposal{}, "dex/CircuitBreakerProposal", nil)
+	cdc.RegisterConcrete(&MsgBatchOrders{}, "dex/BatchOrders", nil)
+	cdc.RegisterConcrete(&MsgAmendOrder{}, "dex/AmendOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelAllOrders{}, "dex/CancelAllOrders", nil)
+	cdc.RegisterConcrete(&MsgPlaceTriggerOrder{}, "dex/PlaceTriggerOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelTriggerOrder{}, "dex/CancelTriggerOrder", nil)
+	cdc.RegisterConcrete(&MsgFundIncentivePool{}, "dex/FundIncentivePool", nil)
+	cdc.RegisterConcrete(&MsgClaimRewards{}, "dex/ClaimRewards", nil)
+	cdc.RegisterConcrete(&FundIncentivePoolProposal{}, "dex/FundIncentivePoolProposal", nil)
+	cdc.RegisterConcrete(&MsgAddLiquidity{}, "dex/AddLiquidity", nil)
+	cdc.RegisterConcrete(&MsgRemoveLiquidity{}, "dex/RemoveLiquidity", nil)
+	cdc.RegisterConcrete(&MsgRoutedOrder{}, "dex/RoutedOrder", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -32,6 +50,47 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCancelBuyOrder{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetCircuitBreaker{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBatchOrders{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAmendOrder{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelAllOrders{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPlaceTriggerOrder{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelTriggerOrder{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFundIncentivePool{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgClaimRewards{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddLiquidity{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRemoveLiquidity{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRoutedOrder{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&ResetRateLimitQuotaProposal{},
+		&AllowPairCreationProposal{},
+		&PausePairProposal{},
+		&DelistPairProposal{},
+		&CircuitBreakerProposal{},
+		&FundIncentivePoolProposal{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
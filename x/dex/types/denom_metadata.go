@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DenomMetadataFromBank returns the display metadata of a denom from its bank metadata
+func DenomMetadataFromBank(metadata banktypes.Metadata) DenomMetadata {
+	if metadata.Display == "" {
+		return DenomMetadata{Display: metadata.Base}
+	}
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return DenomMetadata{Display: metadata.Display, Decimals: unit.Exponent}
+		}
+		for _, alias := range unit.Aliases {
+			if alias == metadata.Display {
+				return DenomMetadata{Display: metadata.Display, Decimals: unit.Exponent}
+			}
+		}
+	}
+	return DenomMetadata{Display: metadata.Display}
+}
+
+// Validate checks the denom metadata is well formed
+func (m DenomMetadata) Validate() error {
+	if err := sdk.ValidateDenom(m.Display); err != nil {
+		return err
+	}
+	if m.Decimals > sdk.Precision {
+		return fmt.Errorf("decimals of %s cannot be greater than %d: %d", m.Display, sdk.Precision, m.Decimals)
+	}
+	return nil
+}
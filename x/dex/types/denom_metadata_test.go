@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"interchange/x/dex/types"
+)
+
+func TestDenomMetadataFromBank(t *testing.T) {
+	units := []*banktypes.DenomUnit{
+		{Denom: "umars", Exponent: 0},
+		{Denom: "mmars", Exponent: 3, Aliases: []string{"millimars"}},
+		{Denom: "mars", Exponent: 6},
+	}
+	for _, tc := range []struct {
+		desc     string
+		metadata banktypes.Metadata
+		expected types.DenomMetadata
+	}{
+		{
+			desc:     "display unit",
+			metadata: banktypes.Metadata{Base: "umars", Display: "mars", DenomUnits: units},
+			expected: types.DenomMetadata{Display: "mars", Decimals: 6},
+		},
+		{
+			desc:     "display alias",
+			metadata: banktypes.Metadata{Base: "umars", Display: "millimars", DenomUnits: units},
+			expected: types.DenomMetadata{Display: "millimars", Decimals: 3},
+		},
+		{
+			desc:     "no display",
+			metadata: banktypes.Metadata{Base: "umars", DenomUnits: units},
+			expected: types.DenomMetadata{Display: "umars"},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.DenomMetadataFromBank(tc.metadata))
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"strings"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+)
+
+// NewDenomTrace returns the trace of the voucher minted on the other end of a channel,
+// port and channel are the counterparty identifiers, denom the full denom path on this chain
+func NewDenomTrace(port, channel, denom, origin string) DenomTrace {
+	trace := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetDenomPrefix(port, channel) + denom)
+	return DenomTrace{
+		Index:     trace.IBCDenom(),
+		Port:      port,
+		Channel:   channel,
+		Origin:    origin,
+		Path:      trace.Path,
+		BaseDenom: trace.BaseDenom,
+	}
+}
+
+// GetFullDenomPath returns the full denom path of the voucher
+func (dt DenomTrace) GetFullDenomPath() string {
+	return ibctransfertypes.DenomTrace{Path: dt.Path, BaseDenom: dt.BaseDenom}.GetFullDenomPath()
+}
+
+// OriginDenomPath returns the full denom path with the current hop removed,
+// which is the denom path of the token on the chain that sent it
+func (dt DenomTrace) OriginDenomPath() string {
+	path := strings.TrimPrefix(dt.Path, dt.Port+"/"+dt.Channel)
+	path = strings.TrimPrefix(path, "/")
+	return ibctransfertypes.DenomTrace{Path: path, BaseDenom: dt.BaseDenom}.GetFullDenomPath()
+}
+
+// HasCurrentHop checks that the path of the voucher starts with the given port and channel
+func (dt DenomTrace) HasCurrentHop(port, channel string) bool {
+	return dt.Port == port && dt.Channel == channel &&
+		strings.HasPrefix(dt.Path+"/", ibctransfertypes.GetDenomPrefix(port, channel))
+}
+
+// DenomTraceFromTransfer converts the trace of a voucher received through the transfer module
+func DenomTraceFromTransfer(trace ibctransfertypes.DenomTrace) DenomTrace {
+	dt := DenomTrace{
+		Index:     trace.IBCDenom(),
+		Path:      trace.Path,
+		BaseDenom: trace.BaseDenom,
+	}
+	if hops := strings.SplitN(trace.Path, "/", 3); len(hops) >= 2 {
+		dt.Port, dt.Channel = hops[0], hops[1]
+	}
+	return dt
+}
@@ -22,11 +22,20 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DenomTrace records a voucher minted on the counterparty chain for a token
+// sent by this chain. Like the ICS-20 denom trace, path holds every hop of the
+// voucher and base_denom the denom on the chain it originates from.
 type DenomTrace struct {
-	Index   string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// ibc/{hash} voucher of the token on the counterparty chain
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// port and channel of the current hop, on the counterparty chain
 	Port    string `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
 	Channel string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
-	Origin  string `protobuf:"bytes,4,opt,name=origin,proto3" json:"origin,omitempty"`
+	// denom of the token on this chain
+	Origin string `protobuf:"bytes,4,opt,name=origin,proto3" json:"origin,omitempty"`
+	// path of the voucher, {port}/{channel} pairs starting with the current hop
+	Path      string `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	BaseDenom string `protobuf:"bytes,6,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
 }
 
 func (m *DenomTrace) Reset()         { *m = DenomTrace{} }
@@ -90,6 +99,20 @@ func (m *DenomTrace) GetOrigin() string {
 	return ""
 }
 
+func (m *DenomTrace) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *DenomTrace) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DenomTrace)(nil), "interchange.dex.DenomTrace")
 }
@@ -97,19 +120,21 @@ func init() {
 func init() { proto.RegisterFile("dex/denom_trace.proto", fileDescriptor_4117a8f24e41d505) }
 
 var fileDescriptor_4117a8f24e41d505 = []byte{
-	// 183 bytes of a gzipped FileDescriptorProto
+	// 214 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0x49, 0xad, 0xd0,
 	0x4f, 0x49, 0xcd, 0xcb, 0xcf, 0x8d, 0x2f, 0x29, 0x4a, 0x4c, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0xe2, 0xcf, 0xcc, 0x2b, 0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0x4b, 0x4f, 0xd5, 0x4b,
-	0x49, 0xad, 0x50, 0xca, 0xe0, 0xe2, 0x72, 0x01, 0xa9, 0x0a, 0x01, 0x29, 0x12, 0x12, 0xe1, 0x62,
-	0xcd, 0xcc, 0x4b, 0x49, 0xad, 0x90, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x82, 0x70, 0x84, 0x84,
-	0xb8, 0x58, 0x0a, 0xf2, 0x8b, 0x4a, 0x24, 0x98, 0xc0, 0x82, 0x60, 0xb6, 0x90, 0x04, 0x17, 0x3b,
-	0xc8, 0x94, 0xbc, 0xd4, 0x1c, 0x09, 0x66, 0xb0, 0x30, 0x8c, 0x2b, 0x24, 0xc6, 0xc5, 0x96, 0x5f,
-	0x94, 0x99, 0x9e, 0x99, 0x27, 0xc1, 0x02, 0x96, 0x80, 0xf2, 0x9c, 0x0c, 0x4f, 0x3c, 0x92, 0x63,
-	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
-	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x1c, 0xc9, 0x51, 0xfa, 0x20, 0x57, 0x57, 0xe8, 0x97,
-	0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x1d, 0x6d, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x03,
-	0x8e, 0x18, 0xac, 0xcd, 0x00, 0x00, 0x00,
+	0x49, 0xad, 0x50, 0x9a, 0xcd, 0xc8, 0xc5, 0xe5, 0x02, 0x52, 0x16, 0x02, 0x52, 0x25, 0x24, 0xc2,
+	0xc5, 0x9a, 0x99, 0x97, 0x92, 0x5a, 0x21, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0x04, 0xe1, 0x08,
+	0x09, 0x71, 0xb1, 0x14, 0xe4, 0x17, 0x95, 0x48, 0x30, 0x81, 0x05, 0xc1, 0x6c, 0x21, 0x09, 0x2e,
+	0x76, 0x90, 0x31, 0x79, 0xa9, 0x39, 0x12, 0xcc, 0x60, 0x61, 0x18, 0x57, 0x48, 0x8c, 0x8b, 0x2d,
+	0xbf, 0x28, 0x33, 0x3d, 0x33, 0x4f, 0x82, 0x05, 0x2c, 0x01, 0xe5, 0x81, 0x4d, 0x49, 0x2c, 0xc9,
+	0x90, 0x60, 0x85, 0x9a, 0x92, 0x58, 0x92, 0x21, 0x24, 0xcb, 0xc5, 0x95, 0x94, 0x58, 0x9c, 0x1a,
+	0x0f, 0x76, 0xa9, 0x04, 0x1b, 0x58, 0x86, 0x13, 0x24, 0x02, 0x76, 0x93, 0x93, 0xe1, 0x89, 0x47,
+	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
+	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x89, 0x23, 0x79, 0x44, 0x1f, 0xe4, 0xd3, 0x0a,
+	0xfd, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x47, 0x8d, 0x01, 0x03, 0x00, 0x45, 0x98,
+	0xca, 0x84, 0x01, 0x01, 0x00, 0x00,
 }
 
 func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
@@ -132,6 +157,20 @@ func (m *DenomTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintDenomTrace(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintDenomTrace(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Origin) > 0 {
 		i -= len(m.Origin)
 		copy(dAtA[i:], m.Origin)
@@ -196,6 +235,14 @@ func (m *DenomTrace) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDenomTrace(uint64(l))
 	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovDenomTrace(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovDenomTrace(uint64(l))
+	}
 	return n
 }
 
@@ -362,6 +409,70 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 			}
 			m.Origin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomTrace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomTrace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomTrace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomTrace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDenomTrace(dAtA[iNdEx:])
@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	"interchange/x/dex/types"
+)
+
+func TestNewDenomTrace(t *testing.T) {
+	for _, tc := range []struct {
+		desc       string
+		denom      string
+		path       string
+		baseDenom  string
+		originPath string
+	}{
+		{
+			desc:       "Native",
+			denom:      "stake",
+			path:       "dex/channel-0",
+			baseDenom:  "stake",
+			originPath: "stake",
+		},
+		{
+			desc:       "OneHop",
+			denom:      "transfer/channel-1/uatom",
+			path:       "dex/channel-0/transfer/channel-1",
+			baseDenom:  "uatom",
+			originPath: "transfer/channel-1/uatom",
+		},
+		{
+			desc:       "TwoHops",
+			denom:      "dex/channel-2/transfer/channel-1/uatom",
+			path:       "dex/channel-0/dex/channel-2/transfer/channel-1",
+			baseDenom:  "uatom",
+			originPath: "dex/channel-2/transfer/channel-1/uatom",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			trace := types.NewDenomTrace("dex", "channel-0", tc.denom, "")
+			require.Equal(t, tc.path, trace.Path)
+			require.Equal(t, tc.baseDenom, trace.BaseDenom)
+			require.Equal(t, ibctransfertypes.ParseDenomTrace(trace.GetFullDenomPath()).IBCDenom(), trace.Index)
+			require.Equal(t, tc.originPath, trace.OriginDenomPath())
+			require.True(t, trace.HasCurrentHop("dex", "channel-0"))
+			require.False(t, trace.HasCurrentHop("dex", "channel-2"))
+		})
+	}
+}
+
+func TestDenomTraceFromTransfer(t *testing.T) {
+	transferTrace := ibctransfertypes.ParseDenomTrace("transfer/channel-1/dex/channel-0/stake")
+	trace := types.DenomTraceFromTransfer(transferTrace)
+	require.Equal(t, transferTrace.IBCDenom(), trace.Index)
+	require.Equal(t, "transfer", trace.Port)
+	require.Equal(t, "channel-1", trace.Channel)
+	require.Equal(t, "dex/channel-0/stake", trace.OriginDenomPath())
+}
@@ -8,7 +8,12 @@ import (
 
 // x/dex module sentinel errors
 var (
-	ErrSample               = sdkerrors.Register(ModuleName, 1100, "sample error")
-	ErrInvalidPacketTimeout = sdkerrors.Register(ModuleName, 1500, "invalid packet timeout")
-	ErrInvalidVersion       = sdkerrors.Register(ModuleName, 1501, "invalid version")
+	ErrSample                = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrInvalidPacketTimeout  = sdkerrors.Register(ModuleName, 1500, "invalid packet timeout")
+	ErrInvalidVersion        = sdkerrors.Register(ModuleName, 1501, "invalid version")
+	ErrRateLimitExceeded     = sdkerrors.Register(ModuleName, 1502, "rate limit exceeded")
+	ErrCircuitBreakerTripped = sdkerrors.Register(ModuleName, 1503, "circuit breaker tripped")
+	ErrTooManyTriggerOrders  = sdkerrors.Register(ModuleName, 1504, "too many trigger orders")
+	ErrInvalidTWAPRange      = sdkerrors.Register(ModuleName, 1505, "invalid twap range")
+	ErrTWAPUnavailable       = sdkerrors.Register(ModuleName, 1506, "twap unavailable")
 )
@@ -6,6 +6,7 @@ const (
 	EventTypeCreatePairPacket = "createPair_packet"
 	EventTypeSellOrderPacket  = "sellOrder_packet"
 	EventTypeBuyOrderPacket   = "buyOrder_packet"
+	EventTypeBatchOrderPacket = "batchOrder_packet"
 	// this line is used by starport scaffolding # ibc/packet/event
 
 	AttributeKeyAckSuccess = "success"
@@ -3,6 +3,9 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -23,4 +26,30 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	//MintCoinsはどこからともなく新しいコインを作成し、それをモジュールアカウントに追加
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	//HasSupplyはdenomの供給量が存在するかを確認
+	HasSupply(ctx sdk.Context, denom string) bool
+	//GetDenomMetaDataはdenomのメタデータを取得
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+}
+
+// TransferKeeper defines the expected interface needed to share denom traces with the ibc-transfer module.
+type TransferKeeper interface {
+	GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool)
+	HasDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) bool
+	SetDenomTrace(ctx sdk.Context, denomTrace ibctransfertypes.DenomTrace)
+}
+
+// DistributionKeeper defines the expected interface needed to fund the incentive pools from the community pool
+// and to return their rewards to it.
+type DistributionKeeper interface {
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// DexHooks event hooks for the order books of the dex (noalias)
+type DexHooks interface {
+	AfterPairCreated(ctx sdk.Context, pairIndex string, amountDenom string, priceDenom string)       // Must be called when the order book of a pair is created on this chain
+	AfterOrderPlaced(ctx sdk.Context, orderType string, pairIndex string, order Order)               // Must be called when an order rests in an order book
+	AfterOrderFilled(ctx sdk.Context, orderType string, pairIndex string, order Order, taker string) // Must be called when a resting order is filled, with the filled amount
+	AfterOrderCancelled(ctx sdk.Context, orderType string, pairIndex string, order Order)            // Must be called when a resting order is removed before being filled
 }
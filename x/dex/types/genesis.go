@@ -11,10 +11,26 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		PortId:            PortID,
-		SellOrderBookList: []SellOrderBook{},
-		BuyOrderBookList:  []BuyOrderBook{},
-		DenomTraceList:    []DenomTrace{},
+		PortId:             PortID,
+		SellOrderBookList:  []SellOrderBook{},
+		BuyOrderBookList:   []BuyOrderBook{},
+		DenomTraceList:     []DenomTrace{},
+		PendingOrderList:   []PendingOrder{},
+		RateLimitQuotaList: []RateLimitQuota{},
+		PairStatusList:     []PairStatus{},
+		TrippedMsgTypes:    []string{},
+		TrippedPacketTypes: []string{},
+		TriggerOrderList:   []TriggerOrder{},
+		LastPriceList:      []LastPrice{},
+		OrderDepositList:   []OrderDeposit{},
+		CandleList:         []Candle{},
+		TickerBucketList:   []Candle{},
+		TwapRecordList:     []TWAPRecord{},
+		IncentivePoolList:  []IncentivePool{},
+		MakerRewardList:    []MakerReward{},
+		RewardStakeList:    []RewardStake{},
+		LiquidityPoolList:  []LiquidityPool{},
+		RoutedOrderList:    []RoutedOrder{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -56,6 +72,190 @@ func (gs GenesisState) Validate() error {
 		}
 		denomTraceIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated index in pendingOrder
+	pendingOrderIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PendingOrderList {
+		index := string(PendingOrderKey(elem.Port, elem.Channel, elem.Sequence))
+		if _, ok := pendingOrderIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for pendingOrder")
+		}
+		pendingOrderIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in rateLimitQuota
+	rateLimitQuotaIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RateLimitQuotaList {
+		index := string(RateLimitQuotaKey(elem.Port, elem.Channel, elem.Denom))
+		if _, ok := rateLimitQuotaIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for rateLimitQuota")
+		}
+		rateLimitQuotaIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in pairStatus
+	pairStatusIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PairStatusList {
+		index := string(PairStatusKey(elem.Index))
+		if _, ok := pairStatusIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for pairStatus")
+		}
+		pairStatusIndexMap[index] = struct{}{}
+	}
+	// Check the tripped circuit breaker types
+	if len(gs.TrippedMsgTypes) > 0 || len(gs.TrippedPacketTypes) > 0 {
+		if err := ValidateCircuitBreakerTypes(gs.TrippedMsgTypes, gs.TrippedPacketTypes); err != nil {
+			return err
+		}
+	}
+	// Check for duplicated ID in triggerOrder
+	triggerOrderIdMap := make(map[uint64]bool)
+	triggerOrderCount := gs.GetTriggerOrderCount()
+	for _, elem := range gs.TriggerOrderList {
+		if _, ok := triggerOrderIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for triggerOrder")
+		}
+		if elem.Id >= triggerOrderCount {
+			return fmt.Errorf("triggerOrder id should be lower or equal than the last id")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		triggerOrderIdMap[elem.Id] = true
+	}
+	// Check for duplicated index in lastPrice
+	lastPriceIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.LastPriceList {
+		index := string(LastPriceKey(elem.Index))
+		if _, ok := lastPriceIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for lastPrice")
+		}
+		lastPriceIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in orderDeposit
+	orderDepositIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.OrderDepositList {
+		index := string(OrderDepositKey(elem.OrderType, elem.Index, elem.OrderID))
+		if _, ok := orderDepositIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for orderDeposit")
+		}
+		if err := validateOrderType(elem.OrderType); err != nil {
+			return err
+		}
+		if !elem.Deposit.IsValid() {
+			return fmt.Errorf("invalid deposit for orderDeposit: %s", elem.Deposit)
+		}
+		orderDepositIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in candle
+	candleIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.CandleList {
+		index := string(CandleKey(elem.PairIndex, elem.Interval, elem.OpenTime))
+		if _, ok := candleIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for candle")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		candleIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in tickerBucket
+	tickerBucketIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.TickerBucketList {
+		index := string(CandleKey(elem.PairIndex, elem.Interval, elem.OpenTime))
+		if _, ok := tickerBucketIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for tickerBucket")
+		}
+		if elem.Interval != TickerBucketInterval {
+			return fmt.Errorf("tickerBucket interval must be %s: %s", TickerBucketInterval, elem.Interval)
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		tickerBucketIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in twapRecord
+	twapRecordIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.TwapRecordList {
+		index := string(TWAPRecordKey(elem.PairIndex, elem.Time))
+		if _, ok := twapRecordIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for twapRecord")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		twapRecordIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in incentivePool
+	incentivePoolIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.IncentivePoolList {
+		index := string(IncentivePoolKey(elem.PairIndex))
+		if _, ok := incentivePoolIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for incentivePool")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		incentivePoolIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in makerReward
+	makerRewardIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.MakerRewardList {
+		index := string(MakerRewardKey(elem.Address))
+		if _, ok := makerRewardIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for makerReward")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		makerRewardIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in rewardStake
+	rewardStakeIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RewardStakeList {
+		index := string(RewardStakeKey(elem.Address, elem.PairIndex, elem.OrderType))
+		if _, ok := rewardStakeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for rewardStake")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		rewardStakeIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in liquidityPool
+	liquidityPoolIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.LiquidityPoolList {
+		index := string(LiquidityPoolKey(elem.PairIndex))
+		if _, ok := liquidityPoolIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for liquidityPool")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		liquidityPoolIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in routedOrder
+	routedOrderIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RoutedOrderList {
+		index := string(RoutedOrderKey(elem.Port, elem.Channel, elem.Sequence))
+		if _, ok := routedOrderIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for routedOrder")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		routedOrderIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -25,11 +25,29 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the dex module's genesis state.
 type GenesisState struct {
-	Params            Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PortId            string          `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	SellOrderBookList []SellOrderBook `protobuf:"bytes,3,rep,name=sellOrderBookList,proto3" json:"sellOrderBookList"`
-	BuyOrderBookList  []BuyOrderBook  `protobuf:"bytes,4,rep,name=buyOrderBookList,proto3" json:"buyOrderBookList"`
-	DenomTraceList    []DenomTrace    `protobuf:"bytes,5,rep,name=denomTraceList,proto3" json:"denomTraceList"`
+	Params             Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PortId             string           `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	SellOrderBookList  []SellOrderBook  `protobuf:"bytes,3,rep,name=sellOrderBookList,proto3" json:"sellOrderBookList"`
+	BuyOrderBookList   []BuyOrderBook   `protobuf:"bytes,4,rep,name=buyOrderBookList,proto3" json:"buyOrderBookList"`
+	DenomTraceList     []DenomTrace     `protobuf:"bytes,5,rep,name=denomTraceList,proto3" json:"denomTraceList"`
+	PendingOrderList   []PendingOrder   `protobuf:"bytes,6,rep,name=pendingOrderList,proto3" json:"pendingOrderList"`
+	RateLimitQuotaList []RateLimitQuota `protobuf:"bytes,7,rep,name=rateLimitQuotaList,proto3" json:"rateLimitQuotaList"`
+	PairStatusList     []PairStatus     `protobuf:"bytes,8,rep,name=pairStatusList,proto3" json:"pairStatusList"`
+	TrippedMsgTypes    []string         `protobuf:"bytes,9,rep,name=trippedMsgTypes,proto3" json:"trippedMsgTypes,omitempty"`
+	TrippedPacketTypes []string         `protobuf:"bytes,10,rep,name=trippedPacketTypes,proto3" json:"trippedPacketTypes,omitempty"`
+	TriggerOrderList   []TriggerOrder   `protobuf:"bytes,11,rep,name=triggerOrderList,proto3" json:"triggerOrderList"`
+	TriggerOrderCount  uint64           `protobuf:"varint,12,opt,name=triggerOrderCount,proto3" json:"triggerOrderCount,omitempty"`
+	LastPriceList      []LastPrice      `protobuf:"bytes,13,rep,name=lastPriceList,proto3" json:"lastPriceList"`
+	OrderDepositList   []OrderDeposit   `protobuf:"bytes,14,rep,name=orderDepositList,proto3" json:"orderDepositList"`
+	CandleList         []Candle         `protobuf:"bytes,15,rep,name=candleList,proto3" json:"candleList"`
+	// hourly candles the ticker is computed from
+	TickerBucketList  []Candle        `protobuf:"bytes,16,rep,name=tickerBucketList,proto3" json:"tickerBucketList"`
+	TwapRecordList    []TWAPRecord    `protobuf:"bytes,17,rep,name=twapRecordList,proto3" json:"twapRecordList"`
+	IncentivePoolList []IncentivePool `protobuf:"bytes,18,rep,name=incentivePoolList,proto3" json:"incentivePoolList"`
+	MakerRewardList   []MakerReward   `protobuf:"bytes,19,rep,name=makerRewardList,proto3" json:"makerRewardList"`
+	RewardStakeList   []RewardStake   `protobuf:"bytes,20,rep,name=rewardStakeList,proto3" json:"rewardStakeList"`
+	LiquidityPoolList []LiquidityPool `protobuf:"bytes,21,rep,name=liquidityPoolList,proto3" json:"liquidityPoolList"`
+	RoutedOrderList   []RoutedOrder   `protobuf:"bytes,22,rep,name=routedOrderList,proto3" json:"routedOrderList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +118,125 @@ func (m *GenesisState) GetDenomTraceList() []DenomTrace {
 	return nil
 }
 
+func (m *GenesisState) GetPendingOrderList() []PendingOrder {
+	if m != nil {
+		return m.PendingOrderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitQuotaList() []RateLimitQuota {
+	if m != nil {
+		return m.RateLimitQuotaList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPairStatusList() []PairStatus {
+	if m != nil {
+		return m.PairStatusList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTrippedMsgTypes() []string {
+	if m != nil {
+		return m.TrippedMsgTypes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTrippedPacketTypes() []string {
+	if m != nil {
+		return m.TrippedPacketTypes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTriggerOrderList() []TriggerOrder {
+	if m != nil {
+		return m.TriggerOrderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTriggerOrderCount() uint64 {
+	if m != nil {
+		return m.TriggerOrderCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastPriceList() []LastPrice {
+	if m != nil {
+		return m.LastPriceList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOrderDepositList() []OrderDeposit {
+	if m != nil {
+		return m.OrderDepositList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCandleList() []Candle {
+	if m != nil {
+		return m.CandleList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTickerBucketList() []Candle {
+	if m != nil {
+		return m.TickerBucketList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTwapRecordList() []TWAPRecord {
+	if m != nil {
+		return m.TwapRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIncentivePoolList() []IncentivePool {
+	if m != nil {
+		return m.IncentivePoolList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMakerRewardList() []MakerReward {
+	if m != nil {
+		return m.MakerRewardList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardStakeList() []RewardStake {
+	if m != nil {
+		return m.RewardStakeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidityPoolList() []LiquidityPool {
+	if m != nil {
+		return m.LiquidityPoolList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoutedOrderList() []RoutedOrder {
+	if m != nil {
+		return m.RoutedOrderList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "interchange.dex.GenesisState")
 }
@@ -107,28 +244,54 @@ func init() {
 func init() { proto.RegisterFile("dex/genesis.proto", fileDescriptor_a803aaabd08db59d) }
 
 var fileDescriptor_a803aaabd08db59d = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x33, 0x6d, 0xff, 0xfe, 0x38, 0x15, 0x6d, 0x07, 0xa5, 0xb1, 0xe2, 0x58, 0x5c, 0x75,
-	0x95, 0x60, 0xc5, 0x17, 0x08, 0x82, 0x14, 0x84, 0x4a, 0xeb, 0xca, 0x4d, 0x48, 0x3a, 0x97, 0x18,
-	0xda, 0x66, 0xc2, 0x64, 0x0a, 0xed, 0x5b, 0xf8, 0x58, 0x5d, 0x49, 0x97, 0xae, 0x44, 0xda, 0x17,
-	0x91, 0x99, 0x0c, 0x52, 0x3b, 0xbb, 0x64, 0xce, 0x39, 0xdf, 0x3d, 0xdc, 0x8b, 0x5b, 0x0c, 0x96,
-	0x7e, 0x02, 0x19, 0x14, 0x69, 0xe1, 0xe5, 0x82, 0x4b, 0x4e, 0x4e, 0xd3, 0x4c, 0x82, 0x98, 0xbc,
-	0x45, 0x59, 0x02, 0x1e, 0x83, 0x65, 0xe7, 0x2c, 0xe1, 0x09, 0xd7, 0x9a, 0xaf, 0xbe, 0x4a, 0x5b,
-	0xa7, 0xa9, 0x92, 0x79, 0x24, 0xa2, 0xb9, 0x09, 0x76, 0x2e, 0xd4, 0x4b, 0x01, 0xb3, 0x59, 0xc8,
-	0x05, 0x03, 0x11, 0xc6, 0x9c, 0x4f, 0x8d, 0xe4, 0x2a, 0x29, 0x5e, 0xac, 0x6c, 0xe5, 0x5c, 0x29,
-	0x0c, 0x32, 0x3e, 0x0f, 0xa5, 0x88, 0x26, 0x50, 0x3e, 0xdf, 0x7c, 0x54, 0xf0, 0xf1, 0x63, 0x59,
-	0x6b, 0x2c, 0x23, 0x09, 0xe4, 0x1e, 0xd7, 0xcb, 0x61, 0x2e, 0xea, 0xa2, 0x5e, 0xa3, 0xdf, 0xf6,
-	0x0e, 0x6a, 0x7a, 0xcf, 0x5a, 0x0e, 0x6a, 0xeb, 0xaf, 0x6b, 0x67, 0x64, 0xcc, 0xa4, 0x8d, 0xff,
-	0xe7, 0x5c, 0xc8, 0x30, 0x65, 0x6e, 0xa5, 0x8b, 0x7a, 0x47, 0xa3, 0xba, 0xfa, 0x1d, 0x30, 0x32,
-	0xc2, 0x2d, 0x55, 0x75, 0xa8, 0xfa, 0x04, 0x9c, 0x4f, 0x9f, 0xd2, 0x42, 0xba, 0xd5, 0x6e, 0xb5,
-	0xd7, 0xe8, 0x53, 0x0b, 0x3d, 0xde, 0x77, 0x9a, 0x09, 0x76, 0x9c, 0x0c, 0x71, 0x33, 0x5e, 0xac,
-	0xfe, 0x22, 0x6b, 0x1a, 0x79, 0x65, 0x21, 0x83, 0x3d, 0xa3, 0x21, 0x5a, 0x61, 0x32, 0xc0, 0x27,
-	0x7a, 0x35, 0x2f, 0x6a, 0x33, 0x1a, 0xf7, 0x4f, 0xe3, 0x2e, 0x2d, 0xdc, 0xc3, 0xaf, 0xcd, 0xc0,
-	0x0e, 0x82, 0xc1, 0xed, 0x7a, 0x4b, 0xd1, 0x66, 0x4b, 0xd1, 0xf7, 0x96, 0xa2, 0xf7, 0x1d, 0x75,
-	0x36, 0x3b, 0xea, 0x7c, 0xee, 0xa8, 0xf3, 0xda, 0xde, 0x63, 0xf9, 0xea, 0x16, 0x4b, 0x5f, 0xae,
-	0x72, 0x28, 0xe2, 0xba, 0x3e, 0xc5, 0xdd, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xd0, 0xbe,
-	0xa4, 0x24, 0x02, 0x00, 0x00,
+	// 750 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0x4f, 0x6f, 0xda, 0x4c,
+	0x10, 0xc6, 0xe1, 0x25, 0x2f, 0x69, 0x36, 0x7f, 0x80, 0x4d, 0x52, 0x28, 0x6d, 0x09, 0xea, 0x89,
+	0x43, 0x05, 0x6a, 0xaa, 0x1e, 0x7b, 0x28, 0x89, 0x5a, 0x21, 0x11, 0x85, 0x1a, 0xaa, 0x4a, 0xbd,
+	0xa0, 0x05, 0xaf, 0xdc, 0x15, 0xe0, 0x75, 0xd7, 0xeb, 0x26, 0x7c, 0x8b, 0x9e, 0xfb, 0x89, 0x72,
+	0xcc, 0xb1, 0xa7, 0xaa, 0x4a, 0xbe, 0x48, 0x35, 0xe3, 0x35, 0x18, 0x3b, 0x96, 0x7a, 0x63, 0x77,
+	0x9e, 0xe7, 0xb7, 0x33, 0xc3, 0xec, 0x9a, 0x54, 0x6c, 0x7e, 0xdd, 0x71, 0xb8, 0xcb, 0x7d, 0xe1,
+	0xb7, 0x3d, 0x25, 0xb5, 0xa4, 0x25, 0xe1, 0x6a, 0xae, 0xa6, 0x5f, 0x99, 0xeb, 0xf0, 0xb6, 0xcd,
+	0xaf, 0xeb, 0x47, 0x8e, 0x74, 0x24, 0xc6, 0x3a, 0xf0, 0x2b, 0x94, 0xd5, 0xcb, 0xe0, 0xf4, 0x98,
+	0x62, 0x0b, 0x63, 0xac, 0x3f, 0x81, 0x1d, 0x9f, 0xcf, 0xe7, 0x63, 0xa9, 0x6c, 0xae, 0xc6, 0x13,
+	0x29, 0x67, 0x26, 0x54, 0x83, 0xd0, 0x24, 0x58, 0xa6, 0x23, 0xc7, 0x10, 0xb1, 0xb9, 0x2b, 0x17,
+	0x63, 0xad, 0xd8, 0x94, 0x9b, 0xed, 0x2a, 0xd2, 0xb9, 0x6b, 0x0b, 0xd7, 0x09, 0x4d, 0x26, 0x70,
+	0x04, 0x01, 0xc5, 0x34, 0x1f, 0xcf, 0xc5, 0x42, 0xe8, 0x38, 0xc5, 0x63, 0x42, 0x8d, 0x7d, 0xcd,
+	0x74, 0xe0, 0xc7, 0x29, 0x5a, 0x09, 0xc7, 0xe1, 0x6a, 0x83, 0x82, 0x81, 0x30, 0x17, 0x9b, 0x7b,
+	0xd2, 0x17, 0x3a, 0x5e, 0xd5, 0x94, 0xb9, 0xf6, 0x3c, 0xca, 0xe4, 0x00, 0x19, 0x57, 0xcc, 0x33,
+	0xeb, 0x43, 0x58, 0x0b, 0x77, 0xca, 0x5d, 0x2d, 0xbe, 0x47, 0xa2, 0x7d, 0xd8, 0x64, 0x8b, 0x85,
+	0x59, 0x96, 0x60, 0xa9, 0x64, 0xa0, 0x4d, 0xfc, 0xc5, 0xcf, 0x3d, 0xb2, 0xf7, 0x21, 0xec, 0xf2,
+	0x50, 0x33, 0xcd, 0xe9, 0x1b, 0x52, 0x0c, 0x7b, 0x57, 0xcb, 0x37, 0xf3, 0xad, 0xdd, 0xd3, 0x6a,
+	0x3b, 0xd1, 0xf5, 0xf6, 0x00, 0xc3, 0xdd, 0xad, 0x9b, 0xdf, 0x27, 0x39, 0xcb, 0x88, 0x69, 0x95,
+	0x6c, 0x7b, 0x52, 0xe9, 0xb1, 0xb0, 0x6b, 0xff, 0x35, 0xf3, 0xad, 0x1d, 0xab, 0x08, 0xcb, 0x9e,
+	0x4d, 0x2d, 0x52, 0x81, 0xce, 0x5f, 0x42, 0x49, 0x5d, 0x29, 0x67, 0x7d, 0xe1, 0xeb, 0x5a, 0xa1,
+	0x59, 0x68, 0xed, 0x9e, 0x36, 0x52, 0xe8, 0x61, 0x5c, 0x69, 0x4e, 0x48, 0xdb, 0xe9, 0x25, 0x29,
+	0x4f, 0x82, 0xe5, 0x26, 0x72, 0x0b, 0x91, 0xcf, 0x53, 0xc8, 0x6e, 0xb0, 0x4c, 0x12, 0x53, 0x66,
+	0xda, 0x23, 0x07, 0xf8, 0x4f, 0x8f, 0xe0, 0x8f, 0x46, 0xdc, 0xff, 0x88, 0x7b, 0x9a, 0xc2, 0x9d,
+	0xaf, 0x64, 0x06, 0x96, 0x30, 0x42, 0x6e, 0x66, 0x3a, 0xf0, 0x08, 0x84, 0x15, 0x33, 0x72, 0x1b,
+	0xc4, 0x84, 0x51, 0x6e, 0x49, 0x33, 0xfd, 0x44, 0x28, 0x4c, 0x55, 0x1f, 0x86, 0xea, 0x63, 0x20,
+	0x35, 0x43, 0xe4, 0x36, 0x22, 0x4f, 0x52, 0x48, 0x6b, 0x43, 0x6a, 0xa0, 0x0f, 0x00, 0xa0, 0x64,
+	0x18, 0xcb, 0x21, 0x4e, 0x25, 0x22, 0x1f, 0x65, 0x94, 0x3c, 0x58, 0xc9, 0xa2, 0x92, 0x37, 0x8d,
+	0xb4, 0x45, 0x4a, 0x5a, 0x09, 0xcf, 0xe3, 0xf6, 0x85, 0xef, 0x8c, 0x96, 0x1e, 0xf7, 0x6b, 0x3b,
+	0xcd, 0x42, 0x6b, 0xc7, 0x4a, 0x6e, 0xd3, 0x36, 0xa1, 0x66, 0x6b, 0xc0, 0xa6, 0x33, 0xae, 0x43,
+	0x31, 0x41, 0xf1, 0x03, 0x11, 0x68, 0xa6, 0xb9, 0x24, 0xeb, 0x66, 0xee, 0x66, 0x34, 0x73, 0x14,
+	0x13, 0x46, 0xcd, 0x4c, 0x9a, 0xe9, 0x4b, 0x52, 0x89, 0xef, 0x9d, 0xc9, 0xc0, 0xd5, 0xb5, 0xbd,
+	0x66, 0xbe, 0xb5, 0x65, 0xa5, 0x03, 0xf4, 0x3d, 0xd9, 0x9f, 0x33, 0x5f, 0x0f, 0x94, 0x30, 0x53,
+	0xb1, 0x8f, 0x67, 0xd7, 0x53, 0x67, 0xf7, 0x23, 0x95, 0x39, 0x78, 0xd3, 0x06, 0x65, 0xe0, 0x95,
+	0x3e, 0x0f, 0x6f, 0x34, 0xa2, 0x0e, 0x32, 0xca, 0xb8, 0x8c, 0x09, 0xa3, 0x32, 0x92, 0x66, 0xfa,
+	0x96, 0x90, 0xf0, 0x29, 0x40, 0x54, 0xa9, 0x59, 0x78, 0xf0, 0xa2, 0x9e, 0xa1, 0xc4, 0x40, 0x62,
+	0x06, 0xda, 0x23, 0x65, 0x2d, 0xa6, 0x33, 0xae, 0xba, 0x01, 0xf4, 0x1a, 0x21, 0xe5, 0x7f, 0x81,
+	0xa4, 0x6c, 0x30, 0x46, 0xf0, 0x04, 0x59, 0x7c, 0x2a, 0x95, 0x8d, 0xa0, 0x4a, 0xc6, 0x18, 0x8d,
+	0x3e, 0xbf, 0x1b, 0x84, 0xb2, 0x68, 0x8c, 0x36, 0x8d, 0xf0, 0x52, 0xac, 0x5e, 0xaf, 0x81, 0x94,
+	0x73, 0xa4, 0xd1, 0x8c, 0x97, 0xa2, 0x17, 0x57, 0x46, 0x2f, 0x45, 0xca, 0x4e, 0xfb, 0xa4, 0xb4,
+	0x60, 0x33, 0xae, 0x2c, 0x7e, 0xc5, 0x4c, 0x7e, 0x87, 0x48, 0x7c, 0x96, 0x22, 0x5e, 0xac, 0x75,
+	0x86, 0x97, 0xb4, 0x02, 0x4d, 0xe1, 0x6a, 0xa8, 0xd9, 0x2c, 0xec, 0xfd, 0x51, 0x06, 0xcd, 0x5a,
+	0xeb, 0x22, 0x5a, 0xc2, 0x0a, 0xf5, 0xce, 0xc5, 0xb7, 0x40, 0xd8, 0x42, 0x2f, 0x57, 0xf5, 0x1e,
+	0x67, 0xd4, 0xdb, 0x8f, 0x2b, 0xa3, 0x7a, 0x53, 0x76, 0xcc, 0x10, 0x5e, 0x77, 0x7b, 0x7d, 0x5f,
+	0x1e, 0x67, 0x65, 0xb8, 0xd6, 0xad, 0x32, 0xdc, 0xb4, 0x76, 0x5f, 0xdd, 0xdc, 0x35, 0xf2, 0xb7,
+	0x77, 0x8d, 0xfc, 0x9f, 0xbb, 0x46, 0xfe, 0xc7, 0x7d, 0x23, 0x77, 0x7b, 0xdf, 0xc8, 0xfd, 0xba,
+	0x6f, 0xe4, 0xbe, 0x54, 0x63, 0xb4, 0x0e, 0x7c, 0x26, 0xaf, 0x3b, 0x1a, 0x6e, 0xec, 0xa4, 0x88,
+	0x9f, 0x95, 0xd7, 0x7f, 0x07, 0x00, 0x79, 0x2e, 0xa5, 0x29, 0xbf, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -151,6 +314,239 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RoutedOrderList) > 0 {
+		for iNdEx := len(m.RoutedOrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoutedOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.LiquidityPoolList) > 0 {
+		for iNdEx := len(m.LiquidityPoolList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityPoolList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.RewardStakeList) > 0 {
+		for iNdEx := len(m.RewardStakeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardStakeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.MakerRewardList) > 0 {
+		for iNdEx := len(m.MakerRewardList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MakerRewardList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.IncentivePoolList) > 0 {
+		for iNdEx := len(m.IncentivePoolList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentivePoolList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.TwapRecordList) > 0 {
+		for iNdEx := len(m.TwapRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TwapRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.TickerBucketList) > 0 {
+		for iNdEx := len(m.TickerBucketList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TickerBucketList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.CandleList) > 0 {
+		for iNdEx := len(m.CandleList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CandleList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.OrderDepositList) > 0 {
+		for iNdEx := len(m.OrderDepositList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderDepositList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.LastPriceList) > 0 {
+		for iNdEx := len(m.LastPriceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastPriceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.TriggerOrderCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TriggerOrderCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.TriggerOrderList) > 0 {
+		for iNdEx := len(m.TriggerOrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TriggerOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TrippedPacketTypes) > 0 {
+		for iNdEx := len(m.TrippedPacketTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TrippedPacketTypes[iNdEx])
+			copy(dAtA[i:], m.TrippedPacketTypes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.TrippedPacketTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.TrippedMsgTypes) > 0 {
+		for iNdEx := len(m.TrippedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TrippedMsgTypes[iNdEx])
+			copy(dAtA[i:], m.TrippedMsgTypes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.TrippedMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PairStatusList) > 0 {
+		for iNdEx := len(m.PairStatusList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairStatusList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RateLimitQuotaList) > 0 {
+		for iNdEx := len(m.RateLimitQuotaList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitQuotaList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PendingOrderList) > 0 {
+		for iNdEx := len(m.PendingOrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.DenomTraceList) > 0 {
 		for iNdEx := len(m.DenomTraceList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -158,143 +554,764 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BuyOrderBookList) > 0 {
+		for iNdEx := len(m.BuyOrderBookList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyOrderBookList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SellOrderBookList) > 0 {
+		for iNdEx := len(m.SellOrderBookList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SellOrderBookList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.SellOrderBookList) > 0 {
+		for _, e := range m.SellOrderBookList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BuyOrderBookList) > 0 {
+		for _, e := range m.BuyOrderBookList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomTraceList) > 0 {
+		for _, e := range m.DenomTraceList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingOrderList) > 0 {
+		for _, e := range m.PendingOrderList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitQuotaList) > 0 {
+		for _, e := range m.RateLimitQuotaList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PairStatusList) > 0 {
+		for _, e := range m.PairStatusList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TrippedMsgTypes) > 0 {
+		for _, s := range m.TrippedMsgTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TrippedPacketTypes) > 0 {
+		for _, s := range m.TrippedPacketTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TriggerOrderList) > 0 {
+		for _, e := range m.TriggerOrderList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TriggerOrderCount != 0 {
+		n += 1 + sovGenesis(uint64(m.TriggerOrderCount))
+	}
+	if len(m.LastPriceList) > 0 {
+		for _, e := range m.LastPriceList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OrderDepositList) > 0 {
+		for _, e := range m.OrderDepositList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CandleList) > 0 {
+		for _, e := range m.CandleList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TickerBucketList) > 0 {
+		for _, e := range m.TickerBucketList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TwapRecordList) > 0 {
+		for _, e := range m.TwapRecordList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IncentivePoolList) > 0 {
+		for _, e := range m.IncentivePoolList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MakerRewardList) > 0 {
+		for _, e := range m.MakerRewardList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardStakeList) > 0 {
+		for _, e := range m.RewardStakeList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidityPoolList) > 0 {
+		for _, e := range m.LiquidityPoolList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RoutedOrderList) > 0 {
+		for _, e := range m.RoutedOrderList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderBookList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellOrderBookList = append(m.SellOrderBookList, SellOrderBook{})
+			if err := m.SellOrderBookList[len(m.SellOrderBookList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrderBookList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyOrderBookList = append(m.BuyOrderBookList, BuyOrderBook{})
+			if err := m.BuyOrderBookList[len(m.BuyOrderBookList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTraceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTraceList = append(m.DenomTraceList, DenomTrace{})
+			if err := m.DenomTraceList[len(m.DenomTraceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOrderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOrderList = append(m.PendingOrderList, PendingOrder{})
+			if err := m.PendingOrderList[len(m.PendingOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitQuotaList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitQuotaList = append(m.RateLimitQuotaList, RateLimitQuota{})
+			if err := m.RateLimitQuotaList[len(m.RateLimitQuotaList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairStatusList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairStatusList = append(m.PairStatusList, PairStatus{})
+			if err := m.PairStatusList[len(m.PairStatusList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrippedMsgTypes = append(m.TrippedMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedPacketTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrippedPacketTypes = append(m.TrippedPacketTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerOrderList = append(m.TriggerOrderList, TriggerOrder{})
+			if err := m.TriggerOrderList[len(m.TriggerOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrderCount", wireType)
+			}
+			m.TriggerOrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerOrderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPriceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastPriceList = append(m.LastPriceList, LastPrice{})
+			if err := m.LastPriceList[len(m.LastPriceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderDepositList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.BuyOrderBookList) > 0 {
-		for iNdEx := len(m.BuyOrderBookList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BuyOrderBookList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderDepositList = append(m.OrderDepositList, OrderDeposit{})
+			if err := m.OrderDepositList[len(m.OrderDepositList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.SellOrderBookList) > 0 {
-		for iNdEx := len(m.SellOrderBookList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SellOrderBookList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CandleList = append(m.CandleList, Candle{})
+			if err := m.CandleList[len(m.CandleList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickerBucketList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.SellOrderBookList) > 0 {
-		for _, e := range m.SellOrderBookList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.BuyOrderBookList) > 0 {
-		for _, e := range m.BuyOrderBookList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.DenomTraceList) > 0 {
-		for _, e := range m.DenomTraceList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TickerBucketList = append(m.TickerBucketList, Candle{})
+			if err := m.TickerBucketList[len(m.TickerBucketList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapRecordList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -321,15 +1338,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TwapRecordList = append(m.TwapRecordList, TWAPRecord{})
+			if err := m.TwapRecordList[len(m.TwapRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePoolList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -339,27 +1357,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.IncentivePoolList = append(m.IncentivePoolList, IncentivePool{})
+			if err := m.IncentivePoolList[len(m.IncentivePoolList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderBookList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerRewardList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -386,14 +1406,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SellOrderBookList = append(m.SellOrderBookList, SellOrderBook{})
-			if err := m.SellOrderBookList[len(m.SellOrderBookList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MakerRewardList = append(m.MakerRewardList, MakerReward{})
+			if err := m.MakerRewardList[len(m.MakerRewardList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrderBookList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardStakeList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -420,14 +1440,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuyOrderBookList = append(m.BuyOrderBookList, BuyOrderBook{})
-			if err := m.BuyOrderBookList[len(m.BuyOrderBookList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardStakeList = append(m.RewardStakeList, RewardStake{})
+			if err := m.RewardStakeList[len(m.RewardStakeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomTraceList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityPoolList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -454,8 +1474,42 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomTraceList = append(m.DenomTraceList, DenomTrace{})
-			if err := m.DenomTraceList[len(m.DenomTraceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LiquidityPoolList = append(m.LiquidityPoolList, LiquidityPool{})
+			if err := m.LiquidityPoolList[len(m.LiquidityPoolList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutedOrderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutedOrderList = append(m.RoutedOrderList, RoutedOrder{})
+			if err := m.RoutedOrderList[len(m.RoutedOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
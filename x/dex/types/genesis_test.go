@@ -2,12 +2,17 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"interchange/testutil/sample"
 	"interchange/x/dex/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	makerAddress := sample.AccAddress()
+	routeLeg := types.RouteLeg{Port: "dex", Channel: "channel-0", OrderType: types.OrderTypeSell, AmountDenom: "stake", PriceDenom: "token", Price: 2}
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -46,6 +51,175 @@ func TestGenesisState_Validate(t *testing.T) {
 						Index: "1",
 					},
 				},
+				PendingOrderList: []types.PendingOrder{
+					{
+						Port:     "dex",
+						Channel:  "channel-0",
+						Sequence: 0,
+					},
+					{
+						Port:     "dex",
+						Channel:  "channel-0",
+						Sequence: 1,
+					},
+				},
+				RateLimitQuotaList: []types.RateLimitQuota{
+					{
+						Channel: "channel-0",
+						Denom:   "stake",
+					},
+					{
+						Channel: "channel-1",
+						Denom:   "stake",
+					},
+				},
+				PairStatusList: []types.PairStatus{
+					{
+						Index: "0",
+					},
+					{
+						Index: "1",
+					},
+				},
+				TrippedMsgTypes:    []string{"/interchange.dex.MsgSendSellOrder"},
+				TrippedPacketTypes: []string{types.EventTypeBuyOrderPacket},
+				TriggerOrderList: []types.TriggerOrder{
+					{
+						Id:           0,
+						OrderType:    types.OrderTypeSell,
+						TriggerType:  types.StopMarket,
+						Amount:       10,
+						TriggerPrice: 20,
+					},
+					{
+						Id:           1,
+						OrderType:    types.OrderTypeBuy,
+						TriggerType:  types.TakeProfit,
+						Amount:       10,
+						TriggerPrice: 20,
+						Price:        20,
+					},
+				},
+				TriggerOrderCount: 2,
+				LastPriceList: []types.LastPrice{
+					{
+						Index: "0",
+					},
+					{
+						Index: "1",
+					},
+				},
+				OrderDepositList: []types.OrderDeposit{
+					{
+						OrderType: types.OrderTypeSell,
+						Index:     "0",
+						OrderID:   0,
+						Deposit:   sdk.NewInt64Coin("stake", 10),
+					},
+					{
+						OrderType: types.OrderTypeBuy,
+						Index:     "0",
+						OrderID:   0,
+						Deposit:   sdk.NewInt64Coin("stake", 10),
+					},
+				},
+				CandleList: []types.Candle{
+					{
+						PairIndex: "0",
+						Interval:  time.Minute,
+						OpenTime:  time.Unix(60, 0).UTC(),
+						Open:      10,
+						High:      12,
+						Low:       9,
+						Close:     11,
+						Volume:    100,
+						Trades:    3,
+					},
+					{
+						PairIndex: "0",
+						Interval:  time.Hour,
+						OpenTime:  time.Unix(0, 0).UTC(),
+					},
+				},
+				TickerBucketList: []types.Candle{
+					{
+						PairIndex: "0",
+						Interval:  types.TickerBucketInterval,
+						OpenTime:  time.Unix(3600, 0).UTC(),
+					},
+				},
+				TwapRecordList: []types.TWAPRecord{
+					{
+						PairIndex:       "0",
+						Time:            time.Unix(60, 0).UTC(),
+						Price:           10,
+						CumulativePrice: sdk.ZeroDec(),
+					},
+					{
+						PairIndex:       "0",
+						Time:            time.Unix(120, 0).UTC(),
+						Price:           12,
+						CumulativePrice: sdk.NewDec(600),
+					},
+				},
+				IncentivePoolList: []types.IncentivePool{
+					{
+						PairIndex: "0",
+						Balance:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+					},
+					{
+						PairIndex: "1",
+					},
+				},
+				MakerRewardList: []types.MakerReward{
+					{
+						Address: sample.AccAddress(),
+						Rewards: sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+					},
+					{
+						Address: sample.AccAddress(),
+					},
+				},
+				RewardStakeList: []types.RewardStake{
+					{
+						Address:   sample.AccAddress(),
+						PairIndex: "0",
+						OrderType: types.OrderTypeSell,
+						Weight:    10,
+					},
+					{
+						Address:   sample.AccAddress(),
+						PairIndex: "0",
+						OrderType: types.OrderTypeBuy,
+						Weight:    5,
+					},
+				},
+				LiquidityPoolList: []types.LiquidityPool{
+					{
+						PairIndex:     "0",
+						AmountDenom:   "stake",
+						PriceDenom:    "token",
+						AmountReserve: sdk.NewInt(100),
+						PriceReserve:  sdk.NewInt(1000),
+						TotalShares:   sdk.NewInt(316),
+					},
+					types.NewLiquidityPool("1", "stake", "token"),
+				},
+				RoutedOrderList: []types.RoutedOrder{
+					{
+						Port:     "dex",
+						Channel:  "channel-0",
+						Sequence: 1,
+						Legs:     []types.RouteLeg{routeLeg},
+					},
+					{
+						Port:     "dex",
+						Channel:  "channel-0",
+						Sequence: 2,
+						Legs:     []types.RouteLeg{routeLeg, routeLeg},
+						Leg:      1,
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -92,6 +266,301 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated pendingOrder",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				PendingOrderList: []types.PendingOrder{
+					{
+						Port:     "dex",
+						Channel:  "channel-0",
+						Sequence: 0,
+					},
+					{
+						Port:     "dex",
+						Channel:  "channel-0",
+						Sequence: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated rateLimitQuota",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				RateLimitQuotaList: []types.RateLimitQuota{
+					{
+						Channel: "channel-0",
+						Denom:   "stake",
+					},
+					{
+						Channel: "channel-0",
+						Denom:   "stake",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid rate limit",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.RateLimits = []types.RateLimit{
+						{
+							Channel:    "channel-0",
+							Denom:      "stake",
+							MaxOutflow: 100,
+						},
+					}
+					return params
+				}(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated pairStatus",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				PairStatusList: []types.PairStatus{
+					{
+						Index: "0",
+					},
+					{
+						Index: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid tripped msg type",
+			genState: &types.GenesisState{
+				PortId:          types.PortID,
+				TrippedMsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated candle",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				CandleList: []types.Candle{
+					{
+						PairIndex: "0",
+						Interval:  time.Minute,
+						OpenTime:  time.Unix(60, 0).UTC(),
+					},
+					{
+						PairIndex: "0",
+						Interval:  time.Minute,
+						OpenTime:  time.Unix(60, 0).UTC(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid candle",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				CandleList: []types.Candle{
+					{
+						PairIndex: "0",
+						Interval:  time.Minute,
+						OpenTime:  time.Unix(90, 0).UTC(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid tickerBucket interval",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				TickerBucketList: []types.Candle{
+					{
+						PairIndex: "0",
+						Interval:  time.Minute,
+						OpenTime:  time.Unix(60, 0).UTC(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated twapRecord",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				TwapRecordList: []types.TWAPRecord{
+					{
+						PairIndex:       "0",
+						Time:            time.Unix(60, 0).UTC(),
+						CumulativePrice: sdk.ZeroDec(),
+					},
+					{
+						PairIndex:       "0",
+						Time:            time.Unix(60, 0).UTC(),
+						CumulativePrice: sdk.ZeroDec(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid twapRecord",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				TwapRecordList: []types.TWAPRecord{
+					{
+						PairIndex: "0",
+						Time:      time.Unix(60, 0).UTC(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated incentivePool",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				IncentivePoolList: []types.IncentivePool{
+					{
+						PairIndex: "0",
+					},
+					{
+						PairIndex: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid incentivePool balance",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				IncentivePoolList: []types.IncentivePool{
+					{
+						PairIndex: "0",
+						Balance:   sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated makerReward",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				MakerRewardList: []types.MakerReward{
+					{
+						Address: makerAddress,
+					},
+					{
+						Address: makerAddress,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid makerReward address",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				MakerRewardList: []types.MakerReward{
+					{
+						Address: "invalid",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated rewardStake",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				RewardStakeList: []types.RewardStake{
+					{
+						Address:   makerAddress,
+						PairIndex: "0",
+						OrderType: types.OrderTypeSell,
+						Weight:    10,
+					},
+					{
+						Address:   makerAddress,
+						PairIndex: "0",
+						OrderType: types.OrderTypeSell,
+						Weight:    10,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid rewardStake weight",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				RewardStakeList: []types.RewardStake{
+					{
+						Address:   makerAddress,
+						PairIndex: "0",
+						OrderType: types.OrderTypeSell,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated liquidityPool",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				LiquidityPoolList: []types.LiquidityPool{
+					types.NewLiquidityPool("0", "stake", "token"),
+					types.NewLiquidityPool("0", "stake", "token"),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "liquidityPool reserves without shares",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				LiquidityPoolList: []types.LiquidityPool{
+					{
+						PairIndex:     "0",
+						AmountDenom:   "stake",
+						PriceDenom:    "token",
+						AmountReserve: sdk.NewInt(100),
+						PriceReserve:  sdk.NewInt(1000),
+						TotalShares:   sdk.ZeroInt(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated routedOrder",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				RoutedOrderList: []types.RoutedOrder{
+					{Port: "dex", Channel: "channel-0", Sequence: 1, Legs: []types.RouteLeg{routeLeg}},
+					{Port: "dex", Channel: "channel-0", Sequence: 1, Legs: []types.RouteLeg{routeLeg}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "routedOrder without legs",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				RoutedOrderList: []types.RoutedOrder{
+					{Port: "dex", Channel: "channel-0", Sequence: 1},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
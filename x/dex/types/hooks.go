@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ DexHooks = MultiDexHooks{}
+
+// MultiDexHooks combines the hooks of several modules, they are called in order
+type MultiDexHooks []DexHooks
+
+// NewMultiDexHooks creates the hooks calling all the given hooks
+func NewMultiDexHooks(hooks ...DexHooks) MultiDexHooks {
+	return hooks
+}
+
+func (h MultiDexHooks) AfterPairCreated(ctx sdk.Context, pairIndex string, amountDenom string, priceDenom string) {
+	for i := range h {
+		h[i].AfterPairCreated(ctx, pairIndex, amountDenom, priceDenom)
+	}
+}
+
+func (h MultiDexHooks) AfterOrderPlaced(ctx sdk.Context, orderType string, pairIndex string, order Order) {
+	for i := range h {
+		h[i].AfterOrderPlaced(ctx, orderType, pairIndex, order)
+	}
+}
+
+func (h MultiDexHooks) AfterOrderFilled(ctx sdk.Context, orderType string, pairIndex string, order Order, taker string) {
+	for i := range h {
+		h[i].AfterOrderFilled(ctx, orderType, pairIndex, order, taker)
+	}
+}
+
+func (h MultiDexHooks) AfterOrderCancelled(ctx sdk.Context, orderType string, pairIndex string, order Order) {
+	for i := range h {
+		h[i].AfterOrderCancelled(ctx, orderType, pairIndex, order)
+	}
+}
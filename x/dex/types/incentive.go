@@ -0,0 +1,98 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// IncentivePoolAddress returns the address holding the incentive pools and the rewards not claimed yet
+func IncentivePoolAddress() sdk.AccAddress {
+	return address.Module(ModuleName, []byte("incentives"))
+}
+
+// Validate checks the liquidity mining policy is well formed
+func (p LiquidityMiningPolicy) Validate() error {
+	if !p.EmissionRate.IsNil() && (p.EmissionRate.IsNegative() || p.EmissionRate.GT(sdk.OneDec())) {
+		return fmt.Errorf("liquidity mining emission rate must be between 0 and 1: %s", p.EmissionRate)
+	}
+	if !p.MaxDistance.IsNil() && p.MaxDistance.IsNegative() {
+		return fmt.Errorf("liquidity mining max distance cannot be negative: %s", p.MaxDistance)
+	}
+	return nil
+}
+
+// IsEnabled returns true if the incentive pools distribute rewards
+func (p LiquidityMiningPolicy) IsEnabled() bool {
+	return !p.EmissionRate.IsNil() && p.EmissionRate.IsPositive() && !p.MaxDistance.IsNil()
+}
+
+// Emission returns the rewards distributed by an incentive pool in a block
+func (p LiquidityMiningPolicy) Emission(balance sdk.Coins) sdk.Coins {
+	emission := sdk.NewCoins()
+	for _, coin := range balance {
+		amount := p.EmissionRate.MulInt(coin.Amount).TruncateInt()
+		if amount.IsPositive() {
+			emission = emission.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return emission
+}
+
+// IsWithinDistance returns true if the price is close enough to the reference price to be rewarded
+func (p LiquidityMiningPolicy) IsWithinDistance(price int32, reference int32) bool {
+	distance := int64(price) - int64(reference)
+	if distance < 0 {
+		distance = -distance
+	}
+	return sdk.NewDec(distance).LTE(p.MaxDistance.MulInt64(int64(reference)))
+}
+
+// Validate checks the incentive pool is well formed
+func (p IncentivePool) Validate() error {
+	if p.PairIndex == "" {
+		return errors.New("incentive pool pair index cannot be empty")
+	}
+	if p.TotalWeight < 0 {
+		return fmt.Errorf("incentive pool total weight cannot be negative: %d", p.TotalWeight)
+	}
+	if err := p.RewardPerUnit.Validate(); err != nil {
+		return err
+	}
+	return p.Balance.Validate()
+}
+
+// Validate checks the reward stake is well formed
+func (s RewardStake) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Address); err != nil {
+		return fmt.Errorf("invalid reward stake address: %w", err)
+	}
+	if s.PairIndex == "" {
+		return errors.New("reward stake pair index cannot be empty")
+	}
+	if err := validateOrderType(s.OrderType); err != nil {
+		return err
+	}
+	if s.Weight <= 0 {
+		return fmt.Errorf("reward stake weight must be positive: %d", s.Weight)
+	}
+	return s.RewardPerUnit.Validate()
+}
+
+// Rewards returns the rewards accrued by the stake since they were last settled,
+// when the rewards per unit of the incentive pool reached rewardPerUnit
+func (s RewardStake) Rewards(rewardPerUnit sdk.DecCoins) sdk.Coins {
+	accrued := rewardPerUnit.Sub(s.RewardPerUnit).MulDecTruncate(sdk.NewDec(s.Weight))
+	rewards, _ := accrued.TruncateDecimal()
+	return rewards
+}
+
+// Validate checks the maker reward is well formed
+func (r MakerReward) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return fmt.Errorf("invalid maker reward address: %w", err)
+	}
+	return r.Rewards.Validate()
+}
@@ -0,0 +1,1362 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/incentive.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LiquidityMiningPolicy rewards the resting orders close to the price of a pair
+// with the incentive pool of the pair.
+type LiquidityMiningPolicy struct {
+	// fraction of the incentive pool distributed every block, zero to disable the rewards
+	EmissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=emissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"emissionRate" yaml:"emission_rate"`
+	// maximum distance of a rewarded order from the reference price, as a fraction of the price
+	MaxDistance github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=maxDistance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maxDistance" yaml:"max_distance"`
+}
+
+func (m *LiquidityMiningPolicy) Reset()         { *m = LiquidityMiningPolicy{} }
+func (m *LiquidityMiningPolicy) String() string { return proto.CompactTextString(m) }
+func (*LiquidityMiningPolicy) ProtoMessage()    {}
+func (*LiquidityMiningPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8b0d4fd1300aff, []int{0}
+}
+func (m *LiquidityMiningPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityMiningPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityMiningPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityMiningPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityMiningPolicy.Merge(m, src)
+}
+func (m *LiquidityMiningPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityMiningPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityMiningPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityMiningPolicy proto.InternalMessageInfo
+
+// IncentivePool holds the rewards left to distribute to the makers of a pair.
+type IncentivePool struct {
+	// index of the order book of the pair
+	PairIndex string                                   `protobuf:"bytes,1,opt,name=pairIndex,proto3" json:"pairIndex,omitempty"`
+	Balance   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	// cumulative rewards emitted per unit of rewarded order amount
+	RewardPerUnit github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=rewardPerUnit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewardPerUnit"`
+	// amount of the rewarded orders of the pair
+	TotalWeight int64 `protobuf:"varint,4,opt,name=totalWeight,proto3" json:"totalWeight,omitempty"`
+	// last price the distance of all the staked orders was measured from
+	ReferencePrice int32 `protobuf:"varint,5,opt,name=referencePrice,proto3" json:"referencePrice,omitempty"`
+}
+
+func (m *IncentivePool) Reset()         { *m = IncentivePool{} }
+func (m *IncentivePool) String() string { return proto.CompactTextString(m) }
+func (*IncentivePool) ProtoMessage()    {}
+func (*IncentivePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8b0d4fd1300aff, []int{1}
+}
+func (m *IncentivePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentivePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentivePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentivePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentivePool.Merge(m, src)
+}
+func (m *IncentivePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentivePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentivePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentivePool proto.InternalMessageInfo
+
+func (m *IncentivePool) GetPairIndex() string {
+	if m != nil {
+		return m.PairIndex
+	}
+	return ""
+}
+
+func (m *IncentivePool) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *IncentivePool) GetRewardPerUnit() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardPerUnit
+	}
+	return nil
+}
+
+func (m *IncentivePool) GetTotalWeight() int64 {
+	if m != nil {
+		return m.TotalWeight
+	}
+	return 0
+}
+
+func (m *IncentivePool) GetReferencePrice() int32 {
+	if m != nil {
+		return m.ReferencePrice
+	}
+	return 0
+}
+
+// RewardStake is the amount of the rewarded orders of a maker on a side of a pair.
+type RewardStake struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// index of the order book of the pair
+	PairIndex string `protobuf:"bytes,2,opt,name=pairIndex,proto3" json:"pairIndex,omitempty"`
+	// sell or buy
+	OrderType string `protobuf:"bytes,3,opt,name=orderType,proto3" json:"orderType,omitempty"`
+	Weight    int64  `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	// rewards per unit of the incentive pool when the rewards of the stake were last settled
+	RewardPerUnit github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=rewardPerUnit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewardPerUnit"`
+}
+
+func (m *RewardStake) Reset()         { *m = RewardStake{} }
+func (m *RewardStake) String() string { return proto.CompactTextString(m) }
+func (*RewardStake) ProtoMessage()    {}
+func (*RewardStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8b0d4fd1300aff, []int{2}
+}
+func (m *RewardStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardStake.Merge(m, src)
+}
+func (m *RewardStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardStake proto.InternalMessageInfo
+
+func (m *RewardStake) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RewardStake) GetPairIndex() string {
+	if m != nil {
+		return m.PairIndex
+	}
+	return ""
+}
+
+func (m *RewardStake) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *RewardStake) GetWeight() int64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *RewardStake) GetRewardPerUnit() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardPerUnit
+	}
+	return nil
+}
+
+// MakerReward is the rewards accrued by a maker and not claimed yet.
+type MakerReward struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *MakerReward) Reset()         { *m = MakerReward{} }
+func (m *MakerReward) String() string { return proto.CompactTextString(m) }
+func (*MakerReward) ProtoMessage()    {}
+func (*MakerReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8b0d4fd1300aff, []int{3}
+}
+func (m *MakerReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MakerReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MakerReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MakerReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MakerReward.Merge(m, src)
+}
+func (m *MakerReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MakerReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MakerReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MakerReward proto.InternalMessageInfo
+
+func (m *MakerReward) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MakerReward) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*LiquidityMiningPolicy)(nil), "interchange.dex.LiquidityMiningPolicy")
+	proto.RegisterType((*IncentivePool)(nil), "interchange.dex.IncentivePool")
+	proto.RegisterType((*RewardStake)(nil), "interchange.dex.RewardStake")
+	proto.RegisterType((*MakerReward)(nil), "interchange.dex.MakerReward")
+}
+
+func init() { proto.RegisterFile("dex/incentive.proto", fileDescriptor_2f8b0d4fd1300aff) }
+
+var fileDescriptor_2f8b0d4fd1300aff = []byte{
+	// 524 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x14, 0x85, 0xe3, 0xe4, 0x4f, 0xa3, 0x4c, 0xfe, 0x82, 0x34, 0x2d, 0x60, 0xaa, 0xc8, 0x89, 0xbc,
+	0xa8, 0x22, 0x21, 0x6c, 0x42, 0x77, 0x2c, 0x43, 0x40, 0xaa, 0x44, 0xa5, 0xc8, 0x80, 0x90, 0xd8,
+	0x54, 0x93, 0x99, 0x8b, 0x33, 0x24, 0x9e, 0x09, 0x33, 0xd3, 0xc6, 0x79, 0x09, 0xc4, 0x73, 0xf0,
+	0x24, 0x5d, 0x76, 0x07, 0x62, 0x11, 0x50, 0xb2, 0x65, 0x85, 0x78, 0x00, 0x14, 0x8f, 0x03, 0x49,
+	0x41, 0xa8, 0x2c, 0x60, 0x65, 0xcf, 0xbd, 0x9e, 0xf3, 0x9d, 0x7b, 0x64, 0x5d, 0xb4, 0xc3, 0x20,
+	0x0d, 0xb9, 0xa0, 0x20, 0x0c, 0x3f, 0x85, 0x60, 0xac, 0xa4, 0x91, 0xf8, 0x2a, 0x17, 0x06, 0x14,
+	0x1d, 0x10, 0x11, 0x43, 0xc0, 0x20, 0xdd, 0xdb, 0x8d, 0x65, 0x2c, 0xb3, 0x5e, 0xb8, 0x7c, 0xb3,
+	0x9f, 0xed, 0x79, 0x54, 0xea, 0x44, 0xea, 0xb0, 0x4f, 0x34, 0x84, 0xa7, 0xed, 0x3e, 0x18, 0xd2,
+	0x0e, 0xa9, 0xe4, 0xc2, 0xf6, 0xfd, 0xcf, 0x0e, 0xba, 0xf6, 0x88, 0xbf, 0x3a, 0xe1, 0x8c, 0x9b,
+	0xe9, 0x11, 0x17, 0x5c, 0xc4, 0x3d, 0x39, 0xe2, 0x74, 0x8a, 0x5f, 0xa2, 0xff, 0x21, 0xe1, 0x5a,
+	0x73, 0x29, 0x22, 0x62, 0xc0, 0x75, 0x9a, 0x4e, 0xab, 0xda, 0x79, 0x78, 0x36, 0x6b, 0x14, 0x3e,
+	0xcc, 0x1a, 0xfb, 0x31, 0x37, 0x83, 0x93, 0x7e, 0x40, 0x65, 0x12, 0xe6, 0x08, 0xfb, 0xb8, 0xad,
+	0xd9, 0x30, 0x34, 0xd3, 0x31, 0xe8, 0xa0, 0x0b, 0xf4, 0xcb, 0xac, 0xb1, 0x3b, 0x25, 0xc9, 0xe8,
+	0x9e, 0xbf, 0xd2, 0x3a, 0x56, 0xc4, 0x80, 0x1f, 0x6d, 0x68, 0xe3, 0x18, 0xd5, 0x12, 0x92, 0x76,
+	0xb9, 0x36, 0x44, 0x50, 0x70, 0x8b, 0x19, 0xea, 0xc1, 0x1f, 0xa3, 0x76, 0x2c, 0x2a, 0x21, 0xe9,
+	0x31, 0xcb, 0xb5, 0xfc, 0x68, 0x5d, 0xd9, 0x7f, 0x57, 0x44, 0xdb, 0x87, 0xab, 0x24, 0x7b, 0x52,
+	0x8e, 0x70, 0x1d, 0x55, 0xc7, 0x84, 0xab, 0x43, 0xc1, 0x20, 0xb5, 0x33, 0x46, 0x3f, 0x0a, 0x18,
+	0x50, 0xa5, 0x4f, 0x46, 0xb9, 0xa9, 0x52, 0xab, 0x76, 0xf7, 0x66, 0x60, 0xd9, 0xc1, 0x32, 0xd0,
+	0x20, 0x0f, 0x34, 0xb8, 0x2f, 0xb9, 0xe8, 0xdc, 0x59, 0xfa, 0x7d, 0xfb, 0xb1, 0xd1, 0xba, 0x84,
+	0xdf, 0xe5, 0x05, 0x1d, 0xad, 0xb4, 0xf1, 0x04, 0x6d, 0x2b, 0x98, 0x10, 0xc5, 0x7a, 0xa0, 0x9e,
+	0x0a, 0x6e, 0xdc, 0x52, 0x06, 0xab, 0xff, 0x12, 0xd6, 0x05, 0x9a, 0xf1, 0x0e, 0x72, 0xde, 0xad,
+	0xcb, 0xe5, 0x63, 0x91, 0x9b, 0x1c, 0xdc, 0x44, 0x35, 0x23, 0x0d, 0x19, 0x3d, 0x03, 0x1e, 0x0f,
+	0x8c, 0xfb, 0x5f, 0xd3, 0x69, 0x95, 0xa2, 0xf5, 0x12, 0xde, 0x47, 0x57, 0x14, 0xbc, 0x00, 0x05,
+	0x82, 0x42, 0x4f, 0x71, 0x0a, 0x6e, 0xb9, 0xe9, 0xb4, 0xca, 0xd1, 0x85, 0xaa, 0xff, 0xd5, 0x41,
+	0xb5, 0x28, 0xd3, 0x7e, 0x6c, 0xc8, 0x10, 0xb0, 0x8b, 0x2a, 0x84, 0x31, 0x05, 0x5a, 0xe7, 0xa9,
+	0xae, 0x8e, 0x9b, 0x89, 0x17, 0x2f, 0x26, 0x5e, 0x47, 0x55, 0xa9, 0x18, 0xa8, 0x27, 0xd3, 0x31,
+	0xb8, 0x25, 0xdb, 0xfd, 0x5e, 0xc0, 0xd7, 0xd1, 0xd6, 0x64, 0xdd, 0x6a, 0x7e, 0xfa, 0x39, 0xc0,
+	0xf2, 0xbf, 0x09, 0xd0, 0x7f, 0xed, 0xa0, 0xda, 0x11, 0x19, 0x82, 0xb2, 0xb3, 0xff, 0x66, 0x6c,
+	0x40, 0x15, 0x7b, 0x55, 0xff, 0x95, 0x5f, 0x29, 0xd7, 0xee, 0xb4, 0xcf, 0xe6, 0x9e, 0x73, 0x3e,
+	0xf7, 0x9c, 0x4f, 0x73, 0xcf, 0x79, 0xb3, 0xf0, 0x0a, 0xe7, 0x0b, 0xaf, 0xf0, 0x7e, 0xe1, 0x15,
+	0x9e, 0xdf, 0x58, 0xdb, 0x18, 0x61, 0x1a, 0x2e, 0x97, 0x4a, 0xa6, 0xd0, 0xdf, 0xca, 0x56, 0xc1,
+	0xc1, 0xb7, 0x01, 0x00, 0x08, 0x68, 0xd7, 0xb8, 0x68, 0x04, 0x00, 0x00,
+}
+
+func (m *LiquidityMiningPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityMiningPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityMiningPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxDistance.Size()
+		i -= size
+		if _, err := m.MaxDistance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentive(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.EmissionRate.Size()
+		i -= size
+		if _, err := m.EmissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentive(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentivePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentivePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentivePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReferencePrice != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.ReferencePrice))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalWeight != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.TotalWeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RewardPerUnit) > 0 {
+		for iNdEx := len(m.RewardPerUnit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerUnit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PairIndex) > 0 {
+		i -= len(m.PairIndex)
+		copy(dAtA[i:], m.PairIndex)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.PairIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPerUnit) > 0 {
+		for iNdEx := len(m.RewardPerUnit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerUnit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Weight != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PairIndex) > 0 {
+		i -= len(m.PairIndex)
+		copy(dAtA[i:], m.PairIndex)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.PairIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MakerReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MakerReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MakerReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIncentive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIncentive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LiquidityMiningPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EmissionRate.Size()
+	n += 1 + l + sovIncentive(uint64(l))
+	l = m.MaxDistance.Size()
+	n += 1 + l + sovIncentive(uint64(l))
+	return n
+}
+
+func (m *IncentivePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairIndex)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if len(m.RewardPerUnit) > 0 {
+		for _, e := range m.RewardPerUnit {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if m.TotalWeight != 0 {
+		n += 1 + sovIncentive(uint64(m.TotalWeight))
+	}
+	if m.ReferencePrice != 0 {
+		n += 1 + sovIncentive(uint64(m.ReferencePrice))
+	}
+	return n
+}
+
+func (m *RewardStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	l = len(m.PairIndex)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovIncentive(uint64(m.Weight))
+	}
+	if len(m.RewardPerUnit) > 0 {
+		for _, e := range m.RewardPerUnit {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MakerReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovIncentive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIncentive(x uint64) (n int) {
+	return sovIncentive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LiquidityMiningPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityMiningPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityMiningPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDistance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDistance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentivePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentivePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentivePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerUnit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerUnit = append(m.RewardPerUnit, types.DecCoin{})
+			if err := m.RewardPerUnit[len(m.RewardPerUnit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			m.TotalWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalWeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
+			}
+			m.ReferencePrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferencePrice |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerUnit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerUnit = append(m.RewardPerUnit, types.DecCoin{})
+			if err := m.RewardPerUnit[len(m.RewardPerUnit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MakerReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MakerReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MakerReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIncentive(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIncentive
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIncentive
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIncentive
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIncentive        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIncentive          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIncentive = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"interchange/x/dex/types"
+)
+
+func TestLiquidityMiningPolicyValidate(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		policy types.LiquidityMiningPolicy
+		valid  bool
+	}{
+		{
+			desc:   "disabled",
+			policy: types.LiquidityMiningPolicy{},
+			valid:  true,
+		},
+		{
+			desc: "valid",
+			policy: types.LiquidityMiningPolicy{
+				EmissionRate: sdk.NewDecWithPrec(1, 3),
+				MaxDistance:  sdk.NewDecWithPrec(5, 2),
+			},
+			valid: true,
+		},
+		{
+			desc: "negative emission rate",
+			policy: types.LiquidityMiningPolicy{
+				EmissionRate: sdk.NewDec(-1),
+			},
+		},
+		{
+			desc: "emission rate above one",
+			policy: types.LiquidityMiningPolicy{
+				EmissionRate: sdk.NewDecWithPrec(11, 1),
+			},
+		},
+		{
+			desc: "negative max distance",
+			policy: types.LiquidityMiningPolicy{
+				EmissionRate: sdk.NewDecWithPrec(1, 3),
+				MaxDistance:  sdk.NewDec(-1),
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.LiquidityMiningPolicy = tc.policy
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestLiquidityMiningPolicyEmission(t *testing.T) {
+	policy := types.LiquidityMiningPolicy{
+		EmissionRate: sdk.NewDecWithPrec(1, 2),
+		MaxDistance:  sdk.NewDecWithPrec(1, 1),
+	}
+	require.True(t, policy.IsEnabled())
+	require.False(t, types.LiquidityMiningPolicy{MaxDistance: sdk.NewDecWithPrec(1, 1)}.IsEnabled())
+
+	// the emission is truncated and the denoms emitting nothing are dropped
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		policy.Emission(sdk.NewCoins(sdk.NewInt64Coin("stake", 1050), sdk.NewInt64Coin("token", 99))),
+	)
+
+	require.True(t, policy.IsWithinDistance(100, 100))
+	require.True(t, policy.IsWithinDistance(110, 100))
+	require.True(t, policy.IsWithinDistance(90, 100))
+	require.False(t, policy.IsWithinDistance(111, 100))
+	require.False(t, policy.IsWithinDistance(89, 100))
+}
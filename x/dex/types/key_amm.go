@@ -0,0 +1,19 @@
+package types
+
+const (
+	// LiquidityPoolKeyPrefix is the prefix to retrieve all LiquidityPool
+	LiquidityPoolKeyPrefix = "LiquidityPool/value/"
+)
+
+// LiquidityPoolKey returns the store key to retrieve a LiquidityPool from the index fields
+func LiquidityPoolKey(
+	pairIndex string,
+) []byte {
+	var key []byte
+
+	pairIndexBytes := []byte(pairIndex)
+	key = append(key, pairIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -7,6 +7,9 @@ var _ binary.ByteOrder
 const (
 	// BuyOrderBookKeyPrefix is the prefix to retrieve all BuyOrderBook
 	BuyOrderBookKeyPrefix = "BuyOrderBook/value/"
+
+	// BuyOrderBookOwnerKeyPrefix is the prefix to retrieve all BuyOrderBook with orders of an owner
+	BuyOrderBookOwnerKeyPrefix = "BuyOrderBook/owner/"
 )
 
 // BuyOrderBookKey returns the store key to retrieve a BuyOrderBook from the index fields
@@ -21,3 +24,16 @@ func BuyOrderBookKey(
 
 	return key
 }
+
+// BuyOrderBookOwnerKey returns the prefix of the owner index of BuyOrderBook
+func BuyOrderBookOwnerKey(
+	owner string,
+) []byte {
+	var key []byte
+
+	ownerBytes := []byte(owner)
+	key = append(key, ownerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ binary.ByteOrder
+
+const (
+	// CandleKeyPrefix is the prefix to retrieve all Candle
+	CandleKeyPrefix = "Candle/value/"
+
+	// TickerBucketKeyPrefix is the prefix to retrieve all the hourly candles of the tickers
+	TickerBucketKeyPrefix = "TickerBucket/value/"
+)
+
+// CandleKey returns the store key to retrieve a Candle from the index fields
+func CandleKey(
+	pairIndex string,
+	interval time.Duration,
+	openTime time.Time,
+) []byte {
+	key := CandleIntervalKey(pairIndex, interval)
+
+	openTimeBytes := sdk.FormatTimeBytes(openTime)
+	key = append(key, openTimeBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// CandleIntervalKey returns the prefix of the Candle of a pair for an interval
+func CandleIntervalKey(
+	pairIndex string,
+	interval time.Duration,
+) []byte {
+	var key []byte
+
+	pairIndexBytes := []byte(pairIndex)
+	key = append(key, pairIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	intervalBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(intervalBytes, uint64(interval))
+	key = append(key, intervalBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,21 @@
+package types
+
+const (
+	// CircuitBreakerMsgKeyPrefix is the prefix to retrieve all the tripped message types
+	CircuitBreakerMsgKeyPrefix = "CircuitBreaker/msg/"
+	// CircuitBreakerPacketKeyPrefix is the prefix to retrieve all the tripped packet types
+	CircuitBreakerPacketKeyPrefix = "CircuitBreaker/packet/"
+)
+
+// CircuitBreakerKey returns the store key of a tripped message or packet type
+func CircuitBreakerKey(
+	typ string,
+) []byte {
+	var key []byte
+
+	typBytes := []byte(typ)
+	key = append(key, typBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
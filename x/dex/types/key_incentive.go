@@ -0,0 +1,70 @@
+package types
+
+const (
+	// IncentivePoolKeyPrefix is the prefix to retrieve all IncentivePool
+	IncentivePoolKeyPrefix = "IncentivePool/value/"
+
+	// MakerRewardKeyPrefix is the prefix to retrieve all MakerReward
+	MakerRewardKeyPrefix = "MakerReward/value/"
+
+	// RewardStakeKeyPrefix is the prefix to retrieve all RewardStake
+	RewardStakeKeyPrefix = "RewardStake/value/"
+)
+
+// IncentivePoolKey returns the store key to retrieve an IncentivePool from the index fields
+func IncentivePoolKey(
+	pairIndex string,
+) []byte {
+	var key []byte
+
+	pairIndexBytes := []byte(pairIndex)
+	key = append(key, pairIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// MakerRewardKey returns the store key to retrieve a MakerReward from the index fields
+func MakerRewardKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// RewardStakeKey returns the store key to retrieve a RewardStake from the index fields
+func RewardStakeKey(
+	address string,
+	pairIndex string,
+	orderType string,
+) []byte {
+	key := RewardStakeAddressKey(address)
+
+	pairIndexBytes := []byte(pairIndex)
+	key = append(key, pairIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	orderTypeBytes := []byte(orderType)
+	key = append(key, orderTypeBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// RewardStakeAddressKey returns the prefix of the RewardStake of an address
+func RewardStakeAddressKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
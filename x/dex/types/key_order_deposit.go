@@ -0,0 +1,63 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ binary.ByteOrder
+
+const (
+	// OrderDepositKeyPrefix is the prefix to retrieve all OrderDeposit
+	OrderDepositKeyPrefix = "OrderDeposit/value/"
+
+	// OrderDepositExpiryKeyPrefix is the prefix of the OrderDeposit queue ordered by expiration
+	OrderDepositExpiryKeyPrefix = "OrderDeposit/expiry/"
+)
+
+// OrderDepositKey returns the store key to retrieve an OrderDeposit from the index fields
+func OrderDepositKey(
+	orderType string,
+	index string,
+	orderID int32,
+) []byte {
+	var key []byte
+
+	orderTypeBytes := []byte(orderType)
+	key = append(key, orderTypeBytes...)
+	key = append(key, []byte("/")...)
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	orderIDBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(orderIDBytes, uint32(orderID))
+	key = append(key, orderIDBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// OrderDepositExpiryKey returns the key of an OrderDeposit in the expiry queue
+func OrderDepositExpiryKey(
+	expiration time.Time,
+	orderType string,
+	index string,
+	orderID int32,
+) []byte {
+	return append(OrderDepositExpiryTimeKey(expiration), OrderDepositKey(orderType, index, orderID)...)
+}
+
+// OrderDepositExpiryTimeKey returns the prefix of the OrderDeposit expiring at the given time
+func OrderDepositExpiryTimeKey(expiration time.Time) []byte {
+	var key []byte
+
+	timeBytes := sdk.FormatTimeBytes(expiration)
+	key = append(key, timeBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
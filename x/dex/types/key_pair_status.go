@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// PairStatusKeyPrefix is the prefix to retrieve all PairStatus
+	PairStatusKeyPrefix = "PairStatus/value/"
+)
+
+// PairStatusKey returns the store key to retrieve a PairStatus from the index fields
+func PairStatusKey(
+	index string,
+) []byte {
+	var key []byte
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,50 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// PendingOrderKeyPrefix is the prefix to retrieve all PendingOrder
+	PendingOrderKeyPrefix = "PendingOrder/value/"
+
+	// PendingOrderOwnerKeyPrefix is the prefix to retrieve all PendingOrder of an owner
+	PendingOrderOwnerKeyPrefix = "PendingOrder/owner/"
+)
+
+// PendingOrderKey returns the store key to retrieve a PendingOrder from the index fields
+func PendingOrderKey(
+	port string,
+	channel string,
+	sequence uint64,
+) []byte {
+	var key []byte
+
+	portBytes := []byte(port)
+	key = append(key, portBytes...)
+	key = append(key, []byte("/")...)
+
+	channelBytes := []byte(channel)
+	key = append(key, channelBytes...)
+	key = append(key, []byte("/")...)
+
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	key = append(key, sequenceBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// PendingOrderOwnerKey returns the prefix of the owner index of PendingOrder
+func PendingOrderOwnerKey(
+	owner string,
+) []byte {
+	var key []byte
+
+	ownerBytes := []byte(owner)
+	key = append(key, ownerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
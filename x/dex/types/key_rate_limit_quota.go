@@ -0,0 +1,33 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// RateLimitQuotaKeyPrefix is the prefix to retrieve all RateLimitQuota
+	RateLimitQuotaKeyPrefix = "RateLimitQuota/value/"
+)
+
+// RateLimitQuotaKey returns the store key to retrieve a RateLimitQuota from the index fields
+func RateLimitQuotaKey(
+	port string,
+	channel string,
+	denom string,
+) []byte {
+	var key []byte
+
+	portBytes := []byte(port)
+	key = append(key, portBytes...)
+	key = append(key, []byte("/")...)
+
+	channelBytes := []byte(channel)
+	key = append(key, channelBytes...)
+	key = append(key, []byte("/")...)
+
+	denomBytes := []byte(denom)
+	key = append(key, denomBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,34 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// RoutedOrderKeyPrefix is the prefix to retrieve all RoutedOrder
+	RoutedOrderKeyPrefix = "RoutedOrder/value/"
+)
+
+// RoutedOrderKey returns the store key to retrieve a RoutedOrder from the packet of its current leg
+func RoutedOrderKey(
+	port string,
+	channel string,
+	sequence uint64,
+) []byte {
+	var key []byte
+
+	portBytes := []byte(port)
+	key = append(key, portBytes...)
+	key = append(key, []byte("/")...)
+
+	channelBytes := []byte(channel)
+	key = append(key, channelBytes...)
+	key = append(key, []byte("/")...)
+
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	key = append(key, sequenceBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
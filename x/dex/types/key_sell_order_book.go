@@ -7,6 +7,9 @@ var _ binary.ByteOrder
 const (
 	// SellOrderBookKeyPrefix is the prefix to retrieve all SellOrderBook
 	SellOrderBookKeyPrefix = "SellOrderBook/value/"
+
+	// SellOrderBookOwnerKeyPrefix is the prefix to retrieve all SellOrderBook with orders of an owner
+	SellOrderBookOwnerKeyPrefix = "SellOrderBook/owner/"
 )
 
 // SellOrderBookKey returns the store key to retrieve a SellOrderBook from the index fields
@@ -21,3 +24,16 @@ func SellOrderBookKey(
 
 	return key
 }
+
+// SellOrderBookOwnerKey returns the prefix of the owner index of SellOrderBook
+func SellOrderBookOwnerKey(
+	owner string,
+) []byte {
+	var key []byte
+
+	ownerBytes := []byte(owner)
+	key = append(key, ownerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
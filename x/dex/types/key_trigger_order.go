@@ -0,0 +1,119 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// TriggerOrderKeyPrefix is the prefix to retrieve all TriggerOrder
+	TriggerOrderKeyPrefix = "TriggerOrder/value/"
+
+	// TriggerOrderPairKeyPrefix is the prefix to retrieve all TriggerOrder of a pair
+	TriggerOrderPairKeyPrefix = "TriggerOrder/pair/"
+
+	// TriggerOrderCreatorKeyPrefix is the prefix to retrieve the number of open TriggerOrder of a creator
+	TriggerOrderCreatorKeyPrefix = "TriggerOrder/creator/"
+
+	// TriggerOrderCountKey is the key of the number of TriggerOrder created
+	TriggerOrderCountKey = "TriggerOrder/count/"
+
+	// LastPriceKeyPrefix is the prefix to retrieve all LastPrice
+	LastPriceKeyPrefix = "LastPrice/value/"
+)
+
+// TriggerOrderKey returns the store key to retrieve a TriggerOrder from the index fields
+func TriggerOrderKey(
+	id uint64,
+) []byte {
+	var key []byte
+
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, id)
+	key = append(key, idBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// TriggerOrderPairKey returns the prefix of the pair index of TriggerOrder
+func TriggerOrderPairKey(
+	pairIndex string,
+) []byte {
+	var key []byte
+
+	pairIndexBytes := []byte(pairIndex)
+	key = append(key, pairIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// TriggerOrderPriceKey returns the prefix of the TriggerOrder of a pair triggered on the same
+// side at the trigger price, they are ordered by trigger price in the pair index
+func TriggerOrderPriceKey(
+	pairIndex string,
+	onFall bool,
+	triggerPrice int32,
+) []byte {
+	key := TriggerOrderSideKey(pairIndex, onFall)
+
+	priceBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(priceBytes, uint32(triggerPrice))
+	key = append(key, priceBytes...)
+
+	return key
+}
+
+// TriggerOrderSideKey returns the prefix of the TriggerOrder of a pair triggered when the
+// last price falls to their trigger price or when it rises to it
+func TriggerOrderSideKey(
+	pairIndex string,
+	onFall bool,
+) []byte {
+	key := TriggerOrderPairKey(pairIndex)
+
+	if onFall {
+		key = append(key, 0)
+	} else {
+		key = append(key, 1)
+	}
+
+	return key
+}
+
+// TriggerOrderCreatorKey returns the store key to retrieve the number of open TriggerOrder of a creator
+func TriggerOrderCreatorKey(
+	creator string,
+) []byte {
+	var key []byte
+
+	creatorBytes := []byte(creator)
+	key = append(key, creatorBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// LastPriceKey returns the store key to retrieve a LastPrice from the index fields
+func LastPriceKey(
+	index string,
+) []byte {
+	var key []byte
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// TriggerOrderFailedKey returns the prefix of the TriggerOrder of a pair that could neither be sent
+// nor refunded once triggered, they stay in the pair index but are no longer triggered
+func TriggerOrderFailedKey(
+	pairIndex string,
+) []byte {
+	key := TriggerOrderPairKey(pairIndex)
+	key = append(key, 2)
+
+	return key
+}
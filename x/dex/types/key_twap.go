@@ -0,0 +1,39 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TWAPRecordKeyPrefix is the prefix to retrieve all TWAPRecord
+	TWAPRecordKeyPrefix = "TWAPRecord/value/"
+)
+
+// TWAPRecordKey returns the store key to retrieve a TWAPRecord from the index fields
+func TWAPRecordKey(
+	pairIndex string,
+	t time.Time,
+) []byte {
+	key := TWAPRecordPairKey(pairIndex)
+
+	timeBytes := sdk.FormatTimeBytes(t)
+	key = append(key, timeBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// TWAPRecordPairKey returns the prefix of the TWAPRecord of a pair
+func TWAPRecordPairKey(
+	pairIndex string,
+) []byte {
+	var key []byte
+
+	pairIndexBytes := []byte(pairIndex)
+	key = append(key, pairIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
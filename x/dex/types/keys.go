@@ -1,6 +1,11 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+)
 
 const (
 	// ModuleName defines the module name
@@ -37,3 +42,21 @@ func KeyPrefix(p string) []byte {
 func OrderBookIndex(portID string, channelID string, sourceDenom string, targetDenom string) string {
 	return fmt.Sprintf("%s-%s-%s-%s", portID, channelID, sourceDenom, targetDenom)
 }
+
+// OrderBookChannelPrefix returns the prefix of the indexes of the order books of a channel
+func OrderBookChannelPrefix(portID string, channelID string) string {
+	return fmt.Sprintf("%s-%s-", portID, channelID)
+}
+
+// OrderBookChannel returns the port and channel of the index of the order book of a pair
+func OrderBookChannel(index string, sourceDenom string, targetDenom string) (portID string, channelID string, found bool) {
+	prefix := strings.TrimSuffix(index, fmt.Sprintf("-%s-%s", sourceDenom, targetDenom))
+	if prefix == index {
+		return "", "", false
+	}
+	i := strings.LastIndex(prefix, "-"+channeltypes.ChannelPrefix)
+	if i <= 0 {
+		return "", "", false
+	}
+	return prefix[:i], prefix[i+1:], true
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAddLiquidity = "add_liquidity"
+
+var _ sdk.Msg = &MsgAddLiquidity{}
+
+func NewMsgAddLiquidity(creator string, port string, channel string, amountDenom string, priceDenom string, amountDeposit sdk.Int, priceDeposit sdk.Int) *MsgAddLiquidity {
+	return &MsgAddLiquidity{
+		Creator:       creator,
+		Port:          port,
+		Channel:       channel,
+		AmountDenom:   amountDenom,
+		PriceDenom:    priceDenom,
+		AmountDeposit: amountDeposit,
+		PriceDeposit:  priceDeposit,
+	}
+}
+
+func (msg *MsgAddLiquidity) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddLiquidity) Type() string {
+	return TypeMsgAddLiquidity
+}
+
+func (msg *MsgAddLiquidity) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAddLiquidity) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddLiquidity) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validatePoolPair(msg.Port, msg.Channel, msg.AmountDenom, msg.PriceDenom); err != nil {
+		return err
+	}
+	if msg.AmountDeposit.IsNil() || !msg.AmountDeposit.IsPositive() || msg.PriceDeposit.IsNil() || !msg.PriceDeposit.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid deposits (%s, %s)", msg.AmountDeposit, msg.PriceDeposit)
+	}
+	return nil
+}
+
+// validatePoolPair checks the pair of a liquidity pool is provided
+func validatePoolPair(port string, channel string, amountDenom string, priceDenom string) error {
+	if port == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid port")
+	}
+	if channel == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid channel")
+	}
+	if amountDenom == "" || priceDenom == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the pool requires both the amount and price denoms")
+	}
+	return nil
+}
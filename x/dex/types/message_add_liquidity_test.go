@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"interchange/testutil/sample"
+)
+
+func TestMsgAddLiquidity_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAddLiquidity
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  *NewMsgAddLiquidity("invalid_address", "dex", "channel-0", "stake", "token", sdk.NewInt(100), sdk.NewInt(100)),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty channel",
+			msg:  *NewMsgAddLiquidity(sample.AccAddress(), "dex", "", "stake", "token", sdk.NewInt(100), sdk.NewInt(100)),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "missing price denom",
+			msg:  *NewMsgAddLiquidity(sample.AccAddress(), "dex", "channel-0", "stake", "", sdk.NewInt(100), sdk.NewInt(100)),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero deposit",
+			msg:  *NewMsgAddLiquidity(sample.AccAddress(), "dex", "channel-0", "stake", "token", sdk.NewInt(100), sdk.ZeroInt()),
+			err:  sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "missing deposit",
+			msg: MsgAddLiquidity{
+				Creator:     sample.AccAddress(),
+				Port:        "dex",
+				Channel:     "channel-0",
+				AmountDenom: "stake",
+				PriceDenom:  "token",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid",
+			msg:  *NewMsgAddLiquidity(sample.AccAddress(), "dex", "channel-0", "stake", "token", sdk.NewInt(100), sdk.NewInt(100)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAmendOrder = "amend_order"
+
+var _ sdk.Msg = &MsgAmendOrder{}
+
+func NewMsgAmendOrder(creator string, port string, channel string, orderType string, amountDenom string, priceDenom string, orderID int32, amount int32, price int32) *MsgAmendOrder {
+	return &MsgAmendOrder{
+		Creator:     creator,
+		Port:        port,
+		Channel:     channel,
+		OrderType:   orderType,
+		AmountDenom: amountDenom,
+		PriceDenom:  priceDenom,
+		OrderID:     orderID,
+		Amount:      amount,
+		Price:       price,
+	}
+}
+
+func (msg *MsgAmendOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAmendOrder) Type() string {
+	return TypeMsgAmendOrder
+}
+
+func (msg *MsgAmendOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAmendOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAmendOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Port == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid port")
+	}
+	if msg.Channel == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid channel")
+	}
+	if err := validateOrderType(msg.OrderType); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := checkAmountAndPrice(msg.Amount, msg.Price); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
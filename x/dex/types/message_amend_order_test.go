@@ -0,0 +1,71 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"interchange/testutil/sample"
+)
+
+func TestMsgAmendOrder_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAmendOrder
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAmendOrder{
+				Creator:   "invalid_address",
+				Port:      "port",
+				Channel:   "channel-0",
+				OrderType: OrderTypeSell,
+				Amount:    10,
+				Price:     15,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid order type",
+			msg: MsgAmendOrder{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				Channel:   "channel-0",
+				OrderType: "swap",
+				Amount:    10,
+				Price:     15,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero amount",
+			msg: MsgAmendOrder{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				Channel:   "channel-0",
+				OrderType: OrderTypeBuy,
+				Price:     15,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgAmendOrder{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				Channel:   "channel-0",
+				OrderType: OrderTypeSell,
+				Amount:    10,
+				Price:     15,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
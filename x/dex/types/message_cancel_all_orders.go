@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelAllOrders = "cancel_all_orders"
+
+var _ sdk.Msg = &MsgCancelAllOrders{}
+
+func NewMsgCancelAllOrders(creator string, port string, channel string, orderType string, amountDenom string, priceDenom string) *MsgCancelAllOrders {
+	return &MsgCancelAllOrders{
+		Creator:     creator,
+		Port:        port,
+		Channel:     channel,
+		OrderType:   orderType,
+		AmountDenom: amountDenom,
+		PriceDenom:  priceDenom,
+	}
+}
+
+func (msg *MsgCancelAllOrders) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelAllOrders) Type() string {
+	return TypeMsgCancelAllOrders
+}
+
+func (msg *MsgCancelAllOrders) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelAllOrders) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelAllOrders) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Port == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid port")
+	}
+	if msg.Channel == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid channel")
+	}
+	if msg.OrderType != "" {
+		if err := validateOrderType(msg.OrderType); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	if (msg.AmountDenom == "") != (msg.PriceDenom == "") {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the pair filter requires both the amount and price denoms")
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"interchange/testutil/sample"
+)
+
+func TestMsgCancelAllOrders_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCancelAllOrders
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCancelAllOrders{
+				Creator: "invalid_address",
+				Port:    "port",
+				Channel: "channel-0",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid channel",
+			msg: MsgCancelAllOrders{
+				Creator: sample.AccAddress(),
+				Port:    "port",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid order type",
+			msg: MsgCancelAllOrders{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				Channel:   "channel-0",
+				OrderType: "swap",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "incomplete pair",
+			msg: MsgCancelAllOrders{
+				Creator:     sample.AccAddress(),
+				Port:        "port",
+				Channel:     "channel-0",
+				AmountDenom: "stake",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgCancelAllOrders{
+				Creator: sample.AccAddress(),
+				Port:    "port",
+				Channel: "channel-0",
+			},
+		}, {
+			name: "valid message with filters",
+			msg: MsgCancelAllOrders{
+				Creator:     sample.AccAddress(),
+				Port:        "port",
+				Channel:     "channel-0",
+				OrderType:   OrderTypeBuy,
+				AmountDenom: "stake",
+				PriceDenom:  "token",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
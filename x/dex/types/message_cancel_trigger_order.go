@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelTriggerOrder = "cancel_trigger_order"
+
+var _ sdk.Msg = &MsgCancelTriggerOrder{}
+
+func NewMsgCancelTriggerOrder(creator string, id uint64) *MsgCancelTriggerOrder {
+	return &MsgCancelTriggerOrder{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgCancelTriggerOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelTriggerOrder) Type() string {
+	return TypeMsgCancelTriggerOrder
+}
+
+func (msg *MsgCancelTriggerOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelTriggerOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelTriggerOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgFundIncentivePool = "fund_incentive_pool"
+
+var _ sdk.Msg = &MsgFundIncentivePool{}
+
+func NewMsgFundIncentivePool(creator string, pairIndex string, amount sdk.Coins) *MsgFundIncentivePool {
+	return &MsgFundIncentivePool{
+		Creator:   creator,
+		PairIndex: pairIndex,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgFundIncentivePool) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFundIncentivePool) Type() string {
+	return TypeMsgFundIncentivePool
+}
+
+func (msg *MsgFundIncentivePool) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgFundIncentivePool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFundIncentivePool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.PairIndex == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid pair index")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount (%s)", msg.Amount)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"interchange/testutil/sample"
+)
+
+func TestMsgFundIncentivePool_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgFundIncentivePool
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgFundIncentivePool{
+				Creator:   "invalid_address",
+				PairIndex: "dex-channel-0-stake-token",
+				Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty pair index",
+			msg: MsgFundIncentivePool{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty amount",
+			msg: MsgFundIncentivePool{
+				Creator:   sample.AccAddress(),
+				PairIndex: "dex-channel-0-stake-token",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid",
+			msg: MsgFundIncentivePool{
+				Creator:   sample.AccAddress(),
+				PairIndex: "dex-channel-0-stake-token",
+				Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
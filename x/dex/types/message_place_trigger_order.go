@@ -0,0 +1,96 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgPlaceTriggerOrder = "place_trigger_order"
+
+var _ sdk.Msg = &MsgPlaceTriggerOrder{}
+
+func NewMsgPlaceTriggerOrder(
+	creator string,
+	port string,
+	channelID string,
+	packetTimeout uint64,
+	orderType string,
+	triggerType TriggerType,
+	amountDenom string,
+	priceDenom string,
+	amount int32,
+	triggerPrice int32,
+	price int32,
+) *MsgPlaceTriggerOrder {
+	return &MsgPlaceTriggerOrder{
+		Creator:       creator,
+		Port:          port,
+		ChannelID:     channelID,
+		PacketTimeout: packetTimeout,
+		OrderType:     orderType,
+		TriggerType:   triggerType,
+		AmountDenom:   amountDenom,
+		PriceDenom:    priceDenom,
+		Amount:        amount,
+		TriggerPrice:  triggerPrice,
+		Price:         price,
+	}
+}
+
+func (msg *MsgPlaceTriggerOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPlaceTriggerOrder) Type() string {
+	return TypeMsgPlaceTriggerOrder
+}
+
+func (msg *MsgPlaceTriggerOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPlaceTriggerOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPlaceTriggerOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Port == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet port")
+	}
+	if msg.ChannelID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet channel")
+	}
+	if msg.PacketTimeout == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
+	}
+	if err := msg.TriggerOrder().Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// TriggerOrder returns the trigger order placed by the message
+func (msg *MsgPlaceTriggerOrder) TriggerOrder() TriggerOrder {
+	return TriggerOrder{
+		Creator:       msg.Creator,
+		Port:          msg.Port,
+		Channel:       msg.ChannelID,
+		OrderType:     msg.OrderType,
+		TriggerType:   msg.TriggerType,
+		AmountDenom:   msg.AmountDenom,
+		PriceDenom:    msg.PriceDenom,
+		Amount:        msg.Amount,
+		TriggerPrice:  msg.TriggerPrice,
+		Price:         msg.Price,
+		PacketTimeout: msg.PacketTimeout,
+	}
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"interchange/testutil/sample"
+)
+
+func TestMsgPlaceTriggerOrder_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgPlaceTriggerOrder
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  *NewMsgPlaceTriggerOrder("invalid_address", "port", "channel-0", 100, OrderTypeSell, StopLimit, "stake", "token", 10, 20, 18),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid channel",
+			msg:  *NewMsgPlaceTriggerOrder(sample.AccAddress(), "port", "", 100, OrderTypeSell, StopLimit, "stake", "token", 10, 20, 18),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid timeout",
+			msg:  *NewMsgPlaceTriggerOrder(sample.AccAddress(), "port", "channel-0", 0, OrderTypeSell, StopLimit, "stake", "token", 10, 20, 18),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid order",
+			msg:  *NewMsgPlaceTriggerOrder(sample.AccAddress(), "port", "channel-0", 100, OrderTypeBuy, StopMarket, "stake", "token", 10, 20, 0),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg:  *NewMsgPlaceTriggerOrder(sample.AccAddress(), "port", "channel-0", 100, OrderTypeSell, StopMarket, "stake", "token", 10, 20, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
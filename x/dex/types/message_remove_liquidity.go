@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRemoveLiquidity = "remove_liquidity"
+
+var _ sdk.Msg = &MsgRemoveLiquidity{}
+
+func NewMsgRemoveLiquidity(creator string, port string, channel string, amountDenom string, priceDenom string, shares sdk.Int) *MsgRemoveLiquidity {
+	return &MsgRemoveLiquidity{
+		Creator:     creator,
+		Port:        port,
+		Channel:     channel,
+		AmountDenom: amountDenom,
+		PriceDenom:  priceDenom,
+		Shares:      shares,
+	}
+}
+
+func (msg *MsgRemoveLiquidity) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveLiquidity) Type() string {
+	return TypeMsgRemoveLiquidity
+}
+
+func (msg *MsgRemoveLiquidity) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRemoveLiquidity) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveLiquidity) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validatePoolPair(msg.Port, msg.Channel, msg.AmountDenom, msg.PriceDenom); err != nil {
+		return err
+	}
+	if msg.Shares.IsNil() || !msg.Shares.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid shares (%s)", msg.Shares)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"interchange/testutil/sample"
+)
+
+func TestMsgRemoveLiquidity_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRemoveLiquidity
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  *NewMsgRemoveLiquidity("invalid_address", "dex", "channel-0", "stake", "token", sdk.NewInt(10)),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty port",
+			msg:  *NewMsgRemoveLiquidity(sample.AccAddress(), "", "channel-0", "stake", "token", sdk.NewInt(10)),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero shares",
+			msg:  *NewMsgRemoveLiquidity(sample.AccAddress(), "dex", "channel-0", "stake", "token", sdk.ZeroInt()),
+			err:  sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid",
+			msg:  *NewMsgRemoveLiquidity(sample.AccAddress(), "dex", "channel-0", "stake", "token", sdk.NewInt(10)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRoutedOrder = "routed_order"
+
+var _ sdk.Msg = &MsgRoutedOrder{}
+
+func NewMsgRoutedOrder(creator string, amount int64, legs []RouteLeg, packetTimeout uint64) *MsgRoutedOrder {
+	return &MsgRoutedOrder{
+		Creator:       creator,
+		Amount:        amount,
+		Legs:          legs,
+		PacketTimeout: packetTimeout,
+	}
+}
+
+func (msg *MsgRoutedOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRoutedOrder) Type() string {
+	return TypeMsgRoutedOrder
+}
+
+func (msg *MsgRoutedOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRoutedOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRoutedOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.PacketTimeout == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
+	}
+	if err := validateRouteLegs(msg.Legs); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// the tokens must be enough for the order of the first leg
+	if _, err := msg.Legs[0].OrderAmount(msg.Amount); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
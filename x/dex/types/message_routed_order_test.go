@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"interchange/testutil/sample"
+)
+
+func TestMsgRoutedOrder_ValidateBasic(t *testing.T) {
+	sellLeg := RouteLeg{Port: "dex", Channel: "channel-0", OrderType: OrderTypeSell, AmountDenom: "stake", PriceDenom: "token", Price: 5}
+	buyLeg := RouteLeg{Port: "dex", Channel: "channel-0", OrderType: OrderTypeBuy, AmountDenom: "gold", PriceDenom: "token", Price: 20}
+	tests := []struct {
+		name string
+		msg  MsgRoutedOrder
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  *NewMsgRoutedOrder("invalid_address", 10, []RouteLeg{sellLeg}, 100),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid timeout",
+			msg:  *NewMsgRoutedOrder(sample.AccAddress(), 10, []RouteLeg{sellLeg}, 0),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "no leg",
+			msg:  *NewMsgRoutedOrder(sample.AccAddress(), 10, nil, 100),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "too many legs",
+			msg:  *NewMsgRoutedOrder(sample.AccAddress(), 10, []RouteLeg{sellLeg, buyLeg, buyLeg}, 100),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "amount below the price of the first buy leg",
+			msg:  *NewMsgRoutedOrder(sample.AccAddress(), 10, []RouteLeg{buyLeg}, 100),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg:  *NewMsgRoutedOrder(sample.AccAddress(), 10, []RouteLeg{sellLeg, buyLeg}, 100),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetCircuitBreaker = "set_circuit_breaker"
+
+var _ sdk.Msg = &MsgSetCircuitBreaker{}
+
+func NewMsgSetCircuitBreaker(creator string, msgTypes []string, packetTypes []string, tripped bool) *MsgSetCircuitBreaker {
+	return &MsgSetCircuitBreaker{
+		Creator:     creator,
+		MsgTypes:    msgTypes,
+		PacketTypes: packetTypes,
+		Tripped:     tripped,
+	}
+}
+
+func (msg *MsgSetCircuitBreaker) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetCircuitBreaker) Type() string {
+	return TypeMsgSetCircuitBreaker
+}
+
+func (msg *MsgSetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetCircuitBreaker) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetCircuitBreaker) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidateCircuitBreakerTypes(msg.MsgTypes, msg.PacketTypes); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"interchange/testutil/sample"
+)
+
+func TestMsgSetCircuitBreaker_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetCircuitBreaker
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetCircuitBreaker{
+				Creator:  "invalid_address",
+				MsgTypes: []string{sdk.MsgTypeURL(&MsgSendSellOrder{})},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no type",
+			msg: MsgSetCircuitBreaker{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "foreign message type",
+			msg: MsgSetCircuitBreaker{
+				Creator:  sample.AccAddress(),
+				MsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "circuit breaker message type",
+			msg: MsgSetCircuitBreaker{
+				Creator:  sample.AccAddress(),
+				MsgTypes: []string{sdk.MsgTypeURL(&MsgSetCircuitBreaker{})},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicated packet type",
+			msg: MsgSetCircuitBreaker{
+				Creator:     sample.AccAddress(),
+				PacketTypes: []string{EventTypeSellOrderPacket, EventTypeSellOrderPacket},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgSetCircuitBreaker{
+				Creator:     sample.AccAddress(),
+				MsgTypes:    []string{sdk.MsgTypeURL(&MsgSendSellOrder{})},
+				PacketTypes: []string{EventTypeSellOrderPacket},
+				Tripped:     true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
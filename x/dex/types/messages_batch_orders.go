@@ -0,0 +1,95 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgBatchOrders = "batch_orders"
+
+var _ sdk.Msg = &MsgBatchOrders{}
+
+func NewMsgBatchOrders(
+	creator string,
+	port string,
+	channelID string,
+	timeoutTimestamp uint64,
+	orderType string,
+	amountDenom string,
+	priceDenom string,
+	orders []BatchOrder,
+	cancelOrderIDs []int32,
+) *MsgBatchOrders {
+	return &MsgBatchOrders{
+		Creator:          creator,
+		Port:             port,
+		ChannelID:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+		OrderType:        orderType,
+		AmountDenom:      amountDenom,
+		PriceDenom:       priceDenom,
+		Orders:           orders,
+		CancelOrderIDs:   cancelOrderIDs,
+	}
+}
+
+func (msg *MsgBatchOrders) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBatchOrders) Type() string {
+	return TypeMsgBatchOrders
+}
+
+func (msg *MsgBatchOrders) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBatchOrders) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBatchOrders) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Port == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet port")
+	}
+	if msg.ChannelID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet channel")
+	}
+	if err := validateOrderType(msg.OrderType); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if len(msg.Orders) == 0 && len(msg.CancelOrderIDs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no order to place or cancel")
+	}
+	// the new orders are sent with a packet
+	if len(msg.Orders) > 0 && msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
+	}
+	if err := validateBatchOrders(msg.Orders); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := validateSelfTradePrevention(msg.SelfTradePrevention); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if len(msg.CancelOrderIDs) > MaxBatchSize {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "too many cancellations in the batch: %d > %d", len(msg.CancelOrderIDs), MaxBatchSize)
+	}
+	cancelled := make(map[int32]struct{})
+	for _, id := range msg.CancelOrderIDs {
+		if _, ok := cancelled[id]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order %d cancelled twice", id)
+		}
+		cancelled[id] = struct{}{}
+	}
+	return nil
+}
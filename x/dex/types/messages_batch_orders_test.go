@@ -0,0 +1,123 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"interchange/testutil/sample"
+)
+
+func TestMsgBatchOrders_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBatchOrders
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBatchOrders{
+				Creator:          "invalid_address",
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				OrderType:        OrderTypeSell,
+				Orders:           []BatchOrder{{Amount: 10, Price: 15}},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid order type",
+			msg: MsgBatchOrders{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				OrderType:        "swap",
+				Orders:           []BatchOrder{{Amount: 10, Price: 15}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty batch",
+			msg: MsgBatchOrders{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				OrderType:        OrderTypeSell,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid timeout",
+			msg: MsgBatchOrders{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				ChannelID: "channel-0",
+				OrderType: OrderTypeBuy,
+				Orders:    []BatchOrder{{Amount: 10, Price: 15}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid order",
+			msg: MsgBatchOrders{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				OrderType:        OrderTypeSell,
+				Orders:           []BatchOrder{{Amount: 10, Price: 15}, {Amount: 0, Price: 15}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "too many orders",
+			msg: MsgBatchOrders{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				OrderType:        OrderTypeSell,
+				Orders:           make([]BatchOrder, MaxBatchSize+1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicated cancellation",
+			msg: MsgBatchOrders{
+				Creator:        sample.AccAddress(),
+				Port:           "port",
+				ChannelID:      "channel-0",
+				OrderType:      OrderTypeBuy,
+				CancelOrderIDs: []int32{1, 2, 1},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid cancellations without timeout",
+			msg: MsgBatchOrders{
+				Creator:        sample.AccAddress(),
+				Port:           "port",
+				ChannelID:      "channel-0",
+				OrderType:      OrderTypeBuy,
+				CancelOrderIDs: []int32{1, 2},
+			},
+		}, {
+			name: "valid message",
+			msg: MsgBatchOrders{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				OrderType:        OrderTypeSell,
+				Orders:           []BatchOrder{{Amount: 10, Price: 15}, {Amount: 20, Price: 16}},
+				CancelOrderIDs:   []int32{0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -66,5 +66,11 @@ func (msg *MsgSendBuyOrder) ValidateBasic() error {
 	if msg.TimeoutTimestamp == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
 	}
+	if err := ValidateAmountAndPrice(msg.Amount, msg.Price); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := validateSelfTradePrevention(msg.SelfTradePrevention); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
 	return nil
 }
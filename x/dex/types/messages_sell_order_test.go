@@ -50,6 +50,38 @@ func TestMsgSendSellOrder_ValidateBasic(t *testing.T) {
 				TimeoutTimestamp: 0,
 			},
 			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid self-trade prevention",
+			msg: MsgSendSellOrder{
+				Creator:             sample.AccAddress(),
+				Port:                "port",
+				ChannelID:           "channel-0",
+				TimeoutTimestamp:    100,
+				SelfTradePrevention: 4,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid amount",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           0,
+				Price:            10,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "price above the maximum",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           65536,
+				Price:            65537 * 2,
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		}, {
 			name: "valid message",
 			msg: MsgSendSellOrder{
@@ -57,6 +89,8 @@ func TestMsgSendSellOrder_ValidateBasic(t *testing.T) {
 				Port:             "port",
 				ChannelID:        "channel-0",
 				TimeoutTimestamp: 100,
+				Amount:           MaxAmount,
+				Price:            MaxPrice,
 			},
 		},
 	}
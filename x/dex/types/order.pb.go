@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -22,6 +23,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SelfTradePrevention is the action taken when an incoming order would match a
+// resting order of the same creator.
+type SelfTradePrevention int32
+
+const (
+	// the orders of the same creator are matched
+	SelfTradeAllowed SelfTradePrevention = 0
+	// the remaining amount of the incoming order is cancelled
+	CancelNewest SelfTradePrevention = 1
+	// the resting order is cancelled and the incoming order keeps matching
+	CancelOldest SelfTradePrevention = 2
+	// both orders are decremented by the smaller amount
+	DecrementBoth SelfTradePrevention = 3
+)
+
+var SelfTradePrevention_name = map[int32]string{
+	0: "SELF_TRADE_PREVENTION_NONE",
+	1: "SELF_TRADE_PREVENTION_CANCEL_NEWEST",
+	2: "SELF_TRADE_PREVENTION_CANCEL_OLDEST",
+	3: "SELF_TRADE_PREVENTION_DECREMENT_BOTH",
+}
+
+var SelfTradePrevention_value = map[string]int32{
+	"SELF_TRADE_PREVENTION_NONE":           0,
+	"SELF_TRADE_PREVENTION_CANCEL_NEWEST":  1,
+	"SELF_TRADE_PREVENTION_CANCEL_OLDEST":  2,
+	"SELF_TRADE_PREVENTION_DECREMENT_BOTH": 3,
+}
+
+func (x SelfTradePrevention) String() string {
+	return proto.EnumName(SelfTradePrevention_name, int32(x))
+}
+
+func (SelfTradePrevention) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c2d5fab85368797d, []int{0}
+}
+
 type OrderBook struct {
 	IdCount int32    `protobuf:"varint,1,opt,name=idCount,proto3" json:"idCount,omitempty"`
 	Orders  []*Order `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
@@ -143,6 +181,7 @@ func (m *Order) GetPrice() int32 {
 }
 
 func init() {
+	proto.RegisterEnum("interchange.dex.SelfTradePrevention", SelfTradePrevention_name, SelfTradePrevention_value)
 	proto.RegisterType((*OrderBook)(nil), "interchange.dex.OrderBook")
 	proto.RegisterType((*Order)(nil), "interchange.dex.Order")
 }
@@ -150,21 +189,32 @@ func init() {
 func init() { proto.RegisterFile("dex/order.proto", fileDescriptor_c2d5fab85368797d) }
 
 var fileDescriptor_c2d5fab85368797d = []byte{
-	// 223 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4f, 0x49, 0xad, 0xd0,
-	0xcf, 0x2f, 0x4a, 0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0x4b, 0x4f, 0xd5, 0x4b, 0x49, 0xad, 0x50, 0x0a, 0xe5, 0xe2,
-	0xf4, 0x07, 0xc9, 0x3b, 0xe5, 0xe7, 0x67, 0x0b, 0x49, 0x70, 0xb1, 0x67, 0xa6, 0x38, 0xe7, 0x97,
-	0xe6, 0x95, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0xb0, 0x06, 0xc1, 0xb8, 0x42, 0x7a, 0x5c, 0x6c, 0x60,
-	0x63, 0x8a, 0x25, 0x98, 0x14, 0x98, 0x35, 0xb8, 0x8d, 0xc4, 0xf4, 0xd0, 0x0c, 0xd2, 0x03, 0x9b,
-	0x12, 0x04, 0x55, 0xa5, 0x14, 0xcf, 0xc5, 0x0a, 0x16, 0x10, 0xe2, 0xe3, 0x62, 0xca, 0x4c, 0x81,
-	0x9a, 0xc6, 0x94, 0x99, 0x02, 0xb2, 0x22, 0xb9, 0x28, 0x35, 0xb1, 0x24, 0xbf, 0x48, 0x82, 0x49,
-	0x81, 0x51, 0x83, 0x33, 0x08, 0xc6, 0x15, 0x12, 0xe3, 0x62, 0x4b, 0xcc, 0x05, 0xdb, 0xcd, 0x0c,
-	0x56, 0x0d, 0xe5, 0x09, 0x89, 0x70, 0xb1, 0x16, 0x14, 0x65, 0x26, 0xa7, 0x4a, 0xb0, 0x80, 0x85,
-	0x21, 0x1c, 0x27, 0xc3, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e,
-	0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x12, 0x47,
-	0x72, 0x99, 0x7e, 0x85, 0x3e, 0x28, 0x04, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x41,
-	0x60, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x58, 0x1a, 0x7b, 0xff, 0x15, 0x01, 0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xbd, 0x8e, 0xd3, 0x40,
+	0x14, 0x85, 0x6d, 0x67, 0x13, 0xb4, 0xc3, 0xcf, 0x9a, 0x21, 0x5a, 0x2c, 0x17, 0x96, 0xb5, 0x50,
+	0x44, 0x14, 0xb6, 0xf8, 0x69, 0x10, 0x55, 0x6c, 0x0f, 0x02, 0x29, 0x8c, 0x57, 0x8e, 0x01, 0x89,
+	0xc6, 0x32, 0x9e, 0x4b, 0xd6, 0xc2, 0xf1, 0x44, 0x93, 0x81, 0x84, 0x9e, 0x02, 0xa5, 0xe2, 0x05,
+	0x52, 0xf1, 0x32, 0x94, 0x29, 0x29, 0x51, 0xf2, 0x22, 0xc8, 0x93, 0x04, 0x45, 0x28, 0x12, 0xdd,
+	0x9c, 0x3b, 0xe7, 0x3b, 0xb7, 0x38, 0x17, 0x9d, 0x31, 0x98, 0xfb, 0x5c, 0x30, 0x10, 0xde, 0x44,
+	0x70, 0xc9, 0xf1, 0x59, 0x59, 0x4b, 0x10, 0xc5, 0x55, 0x5e, 0x8f, 0xc0, 0x63, 0x30, 0xb7, 0xbb,
+	0x23, 0x3e, 0xe2, 0xea, 0xcf, 0x6f, 0x5e, 0x5b, 0xdb, 0xc5, 0x6b, 0x74, 0x1a, 0x37, 0x54, 0xc0,
+	0xf9, 0x47, 0x6c, 0xa1, 0x6b, 0x25, 0x0b, 0xf9, 0xa7, 0x5a, 0x5a, 0xba, 0xab, 0xf7, 0xda, 0xc9,
+	0x5e, 0x62, 0x0f, 0x75, 0x54, 0xf8, 0xd4, 0x32, 0xdc, 0x56, 0xef, 0xfa, 0xa3, 0x73, 0xef, 0x9f,
+	0x78, 0x4f, 0xa5, 0x24, 0x3b, 0xd7, 0x45, 0x86, 0xda, 0x6a, 0x80, 0x6f, 0x21, 0xa3, 0x64, 0xbb,
+	0x34, 0xa3, 0x64, 0xcd, 0x8a, 0x42, 0x40, 0x2e, 0xb9, 0xb0, 0x0c, 0x57, 0xef, 0x9d, 0x26, 0x7b,
+	0x89, 0xcf, 0x51, 0x27, 0x1f, 0xab, 0xdd, 0x2d, 0xe5, 0xde, 0x29, 0xdc, 0x45, 0xed, 0x89, 0x28,
+	0x0b, 0xb0, 0x4e, 0xd4, 0x78, 0x2b, 0x1e, 0x7c, 0x35, 0xd0, 0x9d, 0x21, 0x54, 0x1f, 0x52, 0x91,
+	0x33, 0xb8, 0x14, 0xf0, 0x19, 0x6a, 0x59, 0xf2, 0x1a, 0x3f, 0x41, 0xf6, 0x90, 0x0c, 0x9e, 0x67,
+	0x69, 0xd2, 0x8f, 0x48, 0x76, 0x99, 0x90, 0x37, 0x84, 0xa6, 0x2f, 0x63, 0x9a, 0xd1, 0x98, 0x12,
+	0x53, 0xb3, 0xbb, 0x8b, 0xa5, 0x6b, 0xfe, 0x05, 0xfb, 0x55, 0xc5, 0x67, 0xc0, 0xf0, 0x53, 0x74,
+	0xef, 0x38, 0x15, 0xf6, 0x69, 0x48, 0x06, 0x19, 0x25, 0x6f, 0xc9, 0x30, 0x35, 0x75, 0xdb, 0x5c,
+	0x2c, 0xdd, 0x1b, 0x61, 0x5e, 0x17, 0x50, 0x51, 0x98, 0xc1, 0x54, 0xfe, 0x17, 0x8d, 0x07, 0x51,
+	0x83, 0x1a, 0x87, 0x68, 0x5c, 0xb1, 0x06, 0x7d, 0x86, 0xee, 0x1f, 0x47, 0x23, 0x12, 0x26, 0xe4,
+	0x15, 0xa1, 0x69, 0x16, 0xc4, 0xe9, 0x0b, 0xb3, 0x65, 0xdf, 0x5e, 0x2c, 0xdd, 0x9b, 0x11, 0x14,
+	0x02, 0xc6, 0x50, 0xcb, 0x80, 0xcb, 0x2b, 0xfb, 0xe4, 0xdb, 0x0f, 0x47, 0x0b, 0x1e, 0xfe, 0x5c,
+	0x3b, 0xfa, 0x6a, 0xed, 0xe8, 0xbf, 0xd7, 0x8e, 0xfe, 0x7d, 0xe3, 0x68, 0xab, 0x8d, 0xa3, 0xfd,
+	0xda, 0x38, 0xda, 0xbb, 0xbb, 0x07, 0x05, 0xf9, 0x73, 0xbf, 0x39, 0x0f, 0xf9, 0x65, 0x02, 0xd3,
+	0xf7, 0x1d, 0x55, 0xfc, 0xe3, 0x3f, 0x03, 0x00, 0x56, 0x9c, 0x5b, 0x2c, 0x32, 0x02, 0x00, 0x00,
 }
 
 func (m *OrderBook) Marshal() (dAtA []byte, err error) {
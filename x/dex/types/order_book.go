@@ -22,18 +22,26 @@ const (
 )
 
 var (
-	ErrMaxAmount     = errors.New("max amount reached")
-	ErrMaxPrice      = errors.New("max price reached")
-	ErrZeroAmount    = errors.New("amount is zero")
-	ErrZeroPrice     = errors.New("price is zero")
-	ErrOrderNotFound = errors.New("order not found")
+	ErrMaxAmount      = errors.New("max amount reached")
+	ErrMaxPrice       = errors.New("max price reached")
+	ErrZeroAmount     = errors.New("amount is zero")
+	ErrZeroPrice      = errors.New("price is zero")
+	ErrNegativeAmount = errors.New("amount is negative")
+	ErrNegativePrice  = errors.New("price is negative")
+	ErrOrderNotFound  = errors.New("order not found")
+	ErrMaxOpenOrders  = errors.New("max open orders reached")
 )
 
-func (book *OrderBook) appendOrder(creator string, amount int32, price int32, ordering Ordering) (int32, error) {
+func (book *OrderBook) appendOrder(creator string, amount int32, price int32, ordering Ordering, maxOpenOrders uint32) (int32, error) {
 	if err := checkAmountAndPrice(amount, price); err != nil {
 		return 0, err
 	}
 
+	// アカウントごとの注文数の上限を確認する(ゼロは無制限)
+	if maxOpenOrders > 0 && book.CountOrders(creator) >= maxOpenOrders {
+		return 0, ErrMaxOpenOrders
+	}
+
 	// Initialize the order
 	var order Order
 	order.Id = book.GetNextOrderID()
@@ -51,10 +59,18 @@ func (book *OrderBook) appendOrder(creator string, amount int32, price int32, or
 
 // x/dex/types/order_book.go
 
+// ValidateAmountAndPrice checks the amount and the price of an order fit in the order books
+func ValidateAmountAndPrice(amount int32, price int32) error {
+	return checkAmountAndPrice(amount, price)
+}
+
 func checkAmountAndPrice(amount int32, price int32) error {
 	if amount == int32(0) {
 		return ErrZeroAmount
 	}
+	if amount < 0 {
+		return ErrNegativeAmount
+	}
 	if amount > MaxAmount {
 		return ErrMaxAmount
 	}
@@ -62,6 +78,9 @@ func checkAmountAndPrice(amount int32, price int32) error {
 	if price == int32(0) {
 		return ErrZeroPrice
 	}
+	if price < 0 {
+		return ErrNegativePrice
+	}
 	if price > MaxPrice {
 		return ErrMaxPrice
 	}
@@ -110,7 +129,7 @@ func (book OrderBook) GetOrderFromID(id int32) (Order, error) {
 }
 
 // 特定の注文を削除する
-func (book OrderBook) RemoveOrderFromID(id int32) error {
+func (book *OrderBook) RemoveOrderFromID(id int32) error {
 	for i, order := range book.Orders {
 		if id == order.Id {
 			book.Orders = append(book.Orders[:i], book.Orders[i+1:]...)
@@ -119,3 +138,96 @@ func (book OrderBook) RemoveOrderFromID(id int32) error {
 	}
 	return ErrOrderNotFound
 }
+
+// 特定の注文の数量と価格を変更し、変更前の注文を返す
+// 数量を減らすだけの場合は時間優先を維持し、価格の変更や数量の増加の場合は並び直す
+func (book *OrderBook) amendOrder(id int32, amount int32, price int32, ordering Ordering) (Order, error) {
+	if err := checkAmountAndPrice(amount, price); err != nil {
+		return Order{}, err
+	}
+
+	for i, order := range book.Orders {
+		if id != order.Id {
+			continue
+		}
+		previous := *order
+
+		if price == order.Price && amount <= order.Amount {
+			order.Amount = amount
+			return previous, nil
+		}
+
+		amended := previous
+		amended.Amount = amount
+		amended.Price = price
+		book.Orders = append(book.Orders[:i], book.Orders[i+1:]...)
+		book.insertOrder(amended, ordering)
+		return previous, nil
+	}
+	return Order{}, ErrOrderNotFound
+}
+
+// 注文を持つアカウントを重複なしで返す
+func (book *OrderBook) Owners() []string {
+	if book == nil {
+		return nil
+	}
+	var owners []string
+	seen := make(map[string]bool)
+	for _, order := range book.Orders {
+		if seen[order.Creator] {
+			continue
+		}
+		seen[order.Creator] = true
+		owners = append(owners, order.Creator)
+	}
+	return owners
+}
+
+// アカウントの注文数を返す
+func (book *OrderBook) CountOrders(creator string) (count uint32) {
+	if book == nil {
+		return 0
+	}
+	for _, order := range book.Orders {
+		if order.Creator == creator {
+			count++
+		}
+	}
+	return count
+}
+
+// 2つのオーダーブックの間で注文が追加、変更、削除されたアカウントを返す
+func (book *OrderBook) ChangedOwners(other *OrderBook) []string {
+	orders := make(map[int32]Order)
+	if book != nil {
+		for _, order := range book.Orders {
+			orders[order.Id] = *order
+		}
+	}
+
+	changed := make(map[string]bool)
+	if other != nil {
+		for _, order := range other.Orders {
+			previous, found := orders[order.Id]
+			delete(orders, order.Id)
+			if found && previous == *order {
+				continue
+			}
+			changed[order.Creator] = true
+			if found {
+				changed[previous.Creator] = true
+			}
+		}
+	}
+	for _, order := range orders {
+		changed[order.Creator] = true
+	}
+
+	owners := make([]string, 0, len(changed))
+	for owner := range changed {
+		owners = append(owners, owner)
+	}
+	sort.Strings(owners)
+	return owners
+}
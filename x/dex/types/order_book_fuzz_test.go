@@ -0,0 +1,388 @@
+//go:build go1.18
+// +build go1.18
+
+package types_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"interchange/x/dex/types"
+)
+
+// The fuzzed orders stay small so that the incoming orders cross several price levels
+const (
+	fuzzMaxOrders = 64
+	fuzzMaxAmount = 1000
+	fuzzMaxPrice  = 1000
+)
+
+var fuzzCreators = []string{"alice", "bob", "carol"}
+
+// fuzzOrder is an order to append to the book decoded from the fuzzer input
+type fuzzOrder struct {
+	creator string
+	amount  int32
+	price   int32
+}
+
+// decodeOrders reads 5 bytes per order: the creator, then the amount and the price on 2 bytes each
+func decodeOrders(data []byte) []fuzzOrder {
+	var orders []fuzzOrder
+	for len(data) >= 5 && len(orders) < fuzzMaxOrders {
+		orders = append(orders, fuzzOrder{
+			creator: fuzzCreators[int(data[0])%len(fuzzCreators)],
+			amount:  fuzzBound(int32(data[1])<<8|int32(data[2]), fuzzMaxAmount),
+			price:   fuzzBound(int32(data[3])<<8|int32(data[4]), fuzzMaxPrice),
+		})
+		data = data[5:]
+	}
+	return orders
+}
+
+// fuzzBound maps any value into [1, max]
+func fuzzBound(v int32, max int32) int32 {
+	v %= max
+	if v < 0 {
+		v = -v
+	}
+	return v + 1
+}
+
+func fuzzSellOrderBook(t *testing.T, orders []fuzzOrder) types.SellOrderBook {
+	book := types.NewSellOrderBook("foo", "bar")
+	for _, order := range orders {
+		_, err := book.AppendOrder(order.creator, order.amount, order.price, 0)
+		require.NoError(t, err)
+	}
+	return book
+}
+
+func fuzzBuyOrderBook(t *testing.T, orders []fuzzOrder) types.BuyOrderBook {
+	book := types.NewBuyOrderBook("foo", "bar")
+	for _, order := range orders {
+		_, err := book.AppendOrder(order.creator, order.amount, order.price, 0)
+		require.NoError(t, err)
+	}
+	return book
+}
+
+func copyOrders(book *types.OrderBook) []types.Order {
+	orders := make([]types.Order, len(book.Orders))
+	for i, order := range book.Orders {
+		orders[i] = *order
+	}
+	return orders
+}
+
+func sumAmounts(orders []types.Order) (sum int32) {
+	for _, order := range orders {
+		sum += order.Amount
+	}
+	return sum
+}
+
+// requireBookInvariants checks the orders are sorted, positive and have unique IDs below the ID counter
+func requireBookInvariants(t *testing.T, book *types.OrderBook, ordering types.Ordering) {
+	ids := make(map[int32]bool)
+	for i, order := range book.Orders {
+		require.Positive(t, order.Amount)
+		require.LessOrEqual(t, order.Amount, types.MaxAmount)
+		require.Positive(t, order.Price)
+		require.LessOrEqual(t, order.Price, types.MaxPrice)
+
+		require.False(t, ids[order.Id], "duplicate order ID %d", order.Id)
+		ids[order.Id] = true
+		require.Less(t, order.Id, book.IdCount)
+
+		if i == 0 {
+			continue
+		}
+		previous := book.Orders[i-1]
+		if ordering == types.Increasing {
+			require.LessOrEqual(t, previous.Price, order.Price)
+		} else {
+			require.GreaterOrEqual(t, previous.Price, order.Price)
+		}
+	}
+}
+
+// naiveMatch is the reference matcher: it scans all the resting orders for the best price,
+// the latest order first among equal prices, and fills the incoming order until it no longer crosses
+func naiveMatch(
+	resting []types.Order,
+	incoming types.Order,
+	stp types.SelfTradePrevention,
+	better func(a, b int32) bool,
+	crosses func(price int32) bool,
+) (remaining types.Order, liquidated []types.Order, book []types.Order, selfTrade types.SelfTrade) {
+	book = make([]types.Order, len(resting))
+	copy(book, resting)
+	remaining = incoming
+
+	for remaining.Amount > 0 {
+		best := -1
+		for i, order := range book {
+			if best < 0 || better(order.Price, book[best].Price) ||
+				(order.Price == book[best].Price && order.Id > book[best].Id) {
+				best = i
+			}
+		}
+		if best < 0 || !crosses(book[best].Price) {
+			break
+		}
+
+		if remaining.Creator != "" && book[best].Creator == remaining.Creator && stp != types.SelfTradeAllowed {
+			switch stp {
+			case types.CancelNewest:
+				selfTrade.Prevented += remaining.Amount
+				remaining.Amount = 0
+			case types.CancelOldest:
+				selfTrade.Cancelled = append(selfTrade.Cancelled, book[best])
+				book = append(book[:best], book[best+1:]...)
+			case types.DecrementBoth:
+				amount := remaining.Amount
+				if book[best].Amount < amount {
+					amount = book[best].Amount
+				}
+				cancelled := book[best]
+				cancelled.Amount = amount
+				selfTrade.Prevented += amount
+				selfTrade.Cancelled = append(selfTrade.Cancelled, cancelled)
+				remaining.Amount -= amount
+				book[best].Amount -= amount
+				if book[best].Amount == 0 {
+					book = append(book[:best], book[best+1:]...)
+				}
+			}
+			continue
+		}
+
+		amount := remaining.Amount
+		if book[best].Amount < amount {
+			amount = book[best].Amount
+		}
+		liquidation := book[best]
+		liquidation.Amount = amount
+		liquidated = append(liquidated, liquidation)
+		remaining.Amount -= amount
+		book[best].Amount -= amount
+		if book[best].Amount == 0 {
+			book = append(book[:best], book[best+1:]...)
+		}
+	}
+	return remaining, liquidated, book, selfTrade
+}
+
+func FuzzAppendOrder(f *testing.F) {
+	f.Add([]byte{}, int32(10), int32(5), false)
+	f.Add([]byte{0, 0, 10, 0, 5, 1, 0, 20, 0, 5}, int32(10), int32(5), true)
+	f.Add([]byte{2, 1, 0, 0, 1}, int32(0), int32(-1), false)
+	f.Add([]byte{1, 255, 255, 255, 255}, types.MaxAmount+1, types.MaxPrice, true)
+
+	f.Fuzz(func(t *testing.T, data []byte, amount int32, price int32, sell bool) {
+		var book *types.OrderBook
+		var ordering types.Ordering
+		var appendOrder func(creator string, amount int32, price int32) (int32, error)
+		if sell {
+			sellBook := fuzzSellOrderBook(t, decodeOrders(data))
+			book, ordering, appendOrder = sellBook.Book, types.Decreasing, func(creator string, amount int32, price int32) (int32, error) {
+				return sellBook.AppendOrder(creator, amount, price, 0)
+			}
+		} else {
+			buyBook := fuzzBuyOrderBook(t, decodeOrders(data))
+			book, ordering, appendOrder = buyBook.Book, types.Increasing, func(creator string, amount int32, price int32) (int32, error) {
+				return buyBook.AppendOrder(creator, amount, price, 0)
+			}
+		}
+		requireBookInvariants(t, book, ordering)
+		before := copyOrders(book)
+		nextID := book.IdCount
+
+		id, err := appendOrder("alice", amount, price)
+		valid := amount > 0 && amount <= types.MaxAmount && price > 0 && price <= types.MaxPrice
+		if !valid {
+			require.Error(t, err)
+			require.Equal(t, before, copyOrders(book))
+			require.Equal(t, nextID, book.IdCount)
+			return
+		}
+		require.NoError(t, err)
+		require.Equal(t, nextID, id)
+		require.Equal(t, nextID+1, book.IdCount)
+		require.Len(t, book.Orders, len(before)+1)
+		requireBookInvariants(t, book, ordering)
+
+		order, err := book.GetOrderFromID(id)
+		require.NoError(t, err)
+		require.Equal(t, types.Order{Id: id, Creator: "alice", Amount: amount, Price: price}, order)
+	})
+}
+
+func FuzzRemoveOrderFromID(f *testing.F) {
+	f.Add([]byte{}, int32(0))
+	f.Add([]byte{0, 0, 10, 0, 5, 1, 0, 20, 0, 5, 2, 0, 30, 0, 7}, int32(1))
+	f.Add([]byte{0, 0, 10, 0, 5}, int32(-1))
+
+	f.Fuzz(func(t *testing.T, data []byte, id int32) {
+		book := fuzzSellOrderBook(t, decodeOrders(data)).Book
+		before := copyOrders(book)
+
+		err := book.RemoveOrderFromID(id)
+		var expected []types.Order
+		for _, order := range before {
+			if order.Id != id {
+				expected = append(expected, order)
+			}
+		}
+		if len(expected) == len(before) {
+			require.ErrorIs(t, err, types.ErrOrderNotFound)
+		} else {
+			require.NoError(t, err)
+			_, err = book.GetOrderFromID(id)
+			require.ErrorIs(t, err, types.ErrOrderNotFound)
+		}
+
+		// The other orders keep their order
+		require.ElementsMatch(t, expected, copyOrders(book))
+		if len(expected) > 0 {
+			require.Equal(t, expected, copyOrders(book))
+		}
+		requireBookInvariants(t, book, types.Decreasing)
+	})
+}
+
+func FuzzFillSellOrder(f *testing.F) {
+	f.Add([]byte{}, uint8(0), int32(10), int32(5), uint8(0))
+	f.Add([]byte{0, 0, 10, 0, 5, 1, 0, 20, 0, 6, 2, 0, 30, 0, 4}, uint8(1), int32(25), int32(5), uint8(0))
+	f.Add([]byte{0, 0, 10, 0, 5, 1, 0, 20, 0, 5, 0, 0, 30, 0, 5}, uint8(0), int32(100), int32(1), uint8(3))
+	f.Add([]byte{0, 0, 10, 0, 5, 0, 0, 20, 0, 6}, uint8(0), int32(15), int32(5), uint8(1))
+
+	f.Fuzz(func(t *testing.T, data []byte, creator uint8, amount int32, price int32, stp uint8) {
+		book := fuzzBuyOrderBook(t, decodeOrders(data))
+		before := copyOrders(book.Book)
+		incoming := types.Order{
+			Creator: fuzzCreators[int(creator)%len(fuzzCreators)],
+			Amount:  fuzzBound(amount, fuzzMaxAmount),
+			Price:   fuzzBound(price, fuzzMaxPrice),
+		}
+		mode := types.SelfTradePrevention(int32(stp) % int32(len(types.SelfTradePrevention_name)))
+
+		remaining, liquidated, gain, filled, selfTrade := book.FillSellOrder(incoming, mode)
+		after := copyOrders(book.Book)
+		requireBookInvariants(t, book.Book, types.Increasing)
+
+		// Conservation of the incoming and resting amounts
+		require.GreaterOrEqual(t, remaining.Amount, int32(0))
+		require.Equal(t, incoming.Amount, remaining.Amount+sumAmounts(liquidated)+selfTrade.Prevented)
+		require.Equal(t, sumAmounts(before), sumAmounts(after)+sumAmounts(liquidated)+sumAmounts(selfTrade.Cancelled))
+		require.Equal(t, remaining.Amount == 0, filled)
+
+		// Fills never go below the limit price of the sell order and are paid at the bid price
+		expectedGain := int64(0)
+		for _, liquidation := range liquidated {
+			require.Positive(t, liquidation.Amount)
+			require.GreaterOrEqual(t, liquidation.Price, incoming.Price)
+			expectedGain += int64(liquidation.Amount) * int64(liquidation.Price)
+		}
+		require.Equal(t, expectedGain, gain)
+
+		// A remaining amount means no bid crosses it anymore
+		if remaining.Amount > 0 && len(after) > 0 {
+			require.Less(t, after[len(after)-1].Price, incoming.Price)
+		}
+
+		naiveRemaining, naiveLiquidated, naiveBook, naiveSelfTrade := naiveMatch(
+			before, incoming, mode,
+			func(a, b int32) bool { return a > b },
+			func(bid int32) bool { return bid >= incoming.Price },
+		)
+		require.Equal(t, naiveRemaining, remaining)
+		require.Equal(t, naiveLiquidated, liquidated)
+		require.Equal(t, naiveSelfTrade, selfTrade)
+		require.Equal(t, naiveBook, after)
+	})
+}
+
+func FuzzFillBuyOrder(f *testing.F) {
+	f.Add([]byte{}, uint8(0), int32(10), int32(5), uint8(0))
+	f.Add([]byte{0, 0, 10, 0, 5, 1, 0, 20, 0, 6, 2, 0, 30, 0, 4}, uint8(1), int32(25), int32(6), uint8(0))
+	f.Add([]byte{0, 0, 10, 0, 5, 1, 0, 20, 0, 5, 0, 0, 30, 0, 5}, uint8(0), int32(100), int32(9), uint8(2))
+	f.Add([]byte{0, 0, 10, 0, 5, 0, 0, 20, 0, 4}, uint8(0), int32(15), int32(5), uint8(3))
+
+	f.Fuzz(func(t *testing.T, data []byte, creator uint8, amount int32, price int32, stp uint8) {
+		book := fuzzSellOrderBook(t, decodeOrders(data))
+		before := copyOrders(book.Book)
+		incoming := types.Order{
+			Creator: fuzzCreators[int(creator)%len(fuzzCreators)],
+			Amount:  fuzzBound(amount, fuzzMaxAmount),
+			Price:   fuzzBound(price, fuzzMaxPrice),
+		}
+		mode := types.SelfTradePrevention(int32(stp) % int32(len(types.SelfTradePrevention_name)))
+
+		remaining, liquidated, purchase, filled, selfTrade := book.FillBuyOrder(incoming, mode)
+		after := copyOrders(book.Book)
+		requireBookInvariants(t, book.Book, types.Decreasing)
+
+		// Conservation of the incoming and resting amounts
+		require.GreaterOrEqual(t, remaining.Amount, int32(0))
+		require.Equal(t, incoming.Amount, remaining.Amount+sumAmounts(liquidated)+selfTrade.Prevented)
+		require.Equal(t, sumAmounts(before), sumAmounts(after)+sumAmounts(liquidated)+sumAmounts(selfTrade.Cancelled))
+		require.Equal(t, sumAmounts(liquidated), purchase)
+		require.Equal(t, remaining.Amount == 0, filled)
+
+		// Fills never go above the limit price of the buy order
+		for _, liquidation := range liquidated {
+			require.Positive(t, liquidation.Amount)
+			require.LessOrEqual(t, liquidation.Price, incoming.Price)
+		}
+
+		// A remaining amount means no ask crosses it anymore
+		if remaining.Amount > 0 && len(after) > 0 {
+			require.Greater(t, after[len(after)-1].Price, incoming.Price)
+		}
+
+		naiveRemaining, naiveLiquidated, naiveBook, naiveSelfTrade := naiveMatch(
+			before, incoming, mode,
+			func(a, b int32) bool { return a < b },
+			func(ask int32) bool { return ask <= incoming.Price },
+		)
+		require.Equal(t, naiveRemaining, remaining)
+		require.Equal(t, naiveLiquidated, liquidated)
+		require.Equal(t, naiveSelfTrade, selfTrade)
+		require.Equal(t, naiveBook, after)
+	})
+}
+
+// The naive matcher must agree with itself whatever the order of the resting orders
+func TestNaiveMatchOrderIndependent(t *testing.T) {
+	resting := []types.Order{
+		{Id: 0, Creator: "alice", Amount: 10, Price: 5},
+		{Id: 1, Creator: "bob", Amount: 20, Price: 5},
+		{Id: 2, Creator: "carol", Amount: 30, Price: 7},
+	}
+	shuffled := []types.Order{resting[2], resting[0], resting[1]}
+	incoming := types.Order{Creator: "dave", Amount: 45, Price: 5}
+	higher := func(a, b int32) bool { return a > b }
+	crosses := func(bid int32) bool { return bid >= incoming.Price }
+
+	remaining, liquidated, book, _ := naiveMatch(resting, incoming, types.SelfTradeAllowed, higher, crosses)
+	shuffledRemaining, shuffledLiquidated, shuffledBook, _ := naiveMatch(shuffled, incoming, types.SelfTradeAllowed, higher, crosses)
+	require.Equal(t, remaining, shuffledRemaining)
+	require.Equal(t, liquidated, shuffledLiquidated)
+
+	sortByID := func(orders []types.Order) {
+		sort.Slice(orders, func(i, j int) bool { return orders[i].Id < orders[j].Id })
+	}
+	sortByID(book)
+	sortByID(shuffledBook)
+	require.Equal(t, book, shuffledBook)
+
+	// The best bid then the latest of the equal bids are filled first
+	require.Equal(t, []types.Order{
+		{Id: 2, Creator: "carol", Amount: 30, Price: 7},
+		{Id: 1, Creator: "bob", Amount: 15, Price: 5},
+	}, liquidated)
+}
@@ -110,3 +110,138 @@ func TestRemoveOrderFromID(t *testing.T) {
 	err = book.RemoveOrderFromID(4)
 	require.ErrorIs(t, err, types.ErrOrderNotFound)
 }
+
+func TestAmendOrder(t *testing.T) {
+	inputList := []types.Order{
+		{Id: 3, Creator: MockAccount("3"), Amount: 2, Price: 25},
+		{Id: 2, Creator: MockAccount("2"), Amount: 30, Price: 20},
+		{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
+		{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 10},
+	}
+
+	// Reducing the amount keeps the position of the order
+	book := types.NewSellOrderBook(GenPair())
+	orderBook := OrderListToOrderBook(inputList)
+	book.Book = &orderBook
+	previous, err := book.AmendOrder(2, 10, 20)
+	require.NoError(t, err)
+	require.Equal(t, inputList[1], previous)
+	expectedBook := OrderListToOrderBook([]types.Order{
+		{Id: 3, Creator: MockAccount("3"), Amount: 2, Price: 25},
+		{Id: 2, Creator: MockAccount("2"), Amount: 10, Price: 20},
+		{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
+		{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 10},
+	})
+	require.Equal(t, &expectedBook, book.Book)
+
+	// Increasing the amount queues the order again at its price
+	orderBook = OrderListToOrderBook(inputList)
+	book.Book = &orderBook
+	_, err = book.AmendOrder(2, 40, 20)
+	require.NoError(t, err)
+	expectedBook = OrderListToOrderBook([]types.Order{
+		{Id: 3, Creator: MockAccount("3"), Amount: 2, Price: 25},
+		{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
+		{Id: 2, Creator: MockAccount("2"), Amount: 40, Price: 20},
+		{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 10},
+	})
+	require.Equal(t, &expectedBook, book.Book)
+
+	// Changing the price moves the order to its new price
+	orderBook = OrderListToOrderBook(inputList)
+	book.Book = &orderBook
+	_, err = book.AmendOrder(3, 2, 15)
+	require.NoError(t, err)
+	expectedBook = OrderListToOrderBook([]types.Order{
+		{Id: 2, Creator: MockAccount("2"), Amount: 30, Price: 20},
+		{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
+		{Id: 3, Creator: MockAccount("3"), Amount: 2, Price: 15},
+		{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 10},
+	})
+	require.Equal(t, &expectedBook, book.Book)
+
+	// Buy orders are kept in increasing price
+	buyBook := types.NewBuyOrderBook(GenPair())
+	orderBook = OrderListToOrderBook([]types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 10},
+		{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
+	})
+	buyBook.Book = &orderBook
+	_, err = buyBook.AmendOrder(0, 50, 30)
+	require.NoError(t, err)
+	expectedBook = OrderListToOrderBook([]types.Order{
+		{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
+		{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 30},
+	})
+	require.Equal(t, &expectedBook, buyBook.Book)
+
+	_, err = book.AmendOrder(4, 10, 10)
+	require.ErrorIs(t, err, types.ErrOrderNotFound)
+	_, err = book.AmendOrder(0, 0, 10)
+	require.ErrorIs(t, err, types.ErrZeroAmount)
+	_, err = book.AmendOrder(0, 10, types.MaxPrice+1)
+	require.ErrorIs(t, err, types.ErrMaxPrice)
+}
+
+func TestAppendOrderMaxOpenOrders(t *testing.T) {
+	creator := MockAccount("1")
+	sellBook := types.NewSellOrderBook(GenPair())
+	buyBook := types.NewBuyOrderBook(GenPair())
+	for i := 0; i < 3; i++ {
+		_, err := sellBook.AppendOrder(creator, 10, 10, 3)
+		require.NoError(t, err)
+		_, err = buyBook.AppendOrder(creator, 10, 10, 3)
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint32(3), sellBook.Book.CountOrders(creator))
+
+	// The limit is per account
+	_, err := sellBook.AppendOrder(creator, 10, 10, 3)
+	require.ErrorIs(t, err, types.ErrMaxOpenOrders)
+	_, err = buyBook.AppendOrder(creator, 10, 10, 3)
+	require.ErrorIs(t, err, types.ErrMaxOpenOrders)
+	_, err = sellBook.AppendOrder(MockAccount("2"), 10, 10, 3)
+	require.NoError(t, err)
+
+	// Zero is no limit
+	_, err = sellBook.AppendOrder(creator, 10, 10, 0)
+	require.NoError(t, err)
+
+	// A cancelled order frees a slot
+	require.NoError(t, buyBook.Book.RemoveOrderFromID(0))
+	_, err = buyBook.AppendOrder(creator, 10, 10, 3)
+	require.NoError(t, err)
+}
+
+func TestChangedOwners(t *testing.T) {
+	alice, bob, carol := MockAccount("1"), MockAccount("2"), MockAccount("3")
+	book := types.NewSellOrderBook(GenPair())
+	for _, creator := range []string{alice, bob, carol} {
+		_, err := book.AppendOrder(creator, 10, 10, 0)
+		require.NoError(t, err)
+	}
+	previous := types.OrderBook{IdCount: book.Book.IdCount}
+	for _, order := range book.Book.Orders {
+		o := *order
+		previous.Orders = append(previous.Orders, &o)
+	}
+	require.Empty(t, previous.ChangedOwners(book.Book))
+
+	// The owners of the filled, removed and added orders change
+	var bobOrderID int32
+	for _, order := range book.Book.Orders {
+		if order.Creator == alice {
+			order.Amount = 5
+		}
+		if order.Creator == bob {
+			bobOrderID = order.Id
+		}
+	}
+	require.NoError(t, book.Book.RemoveOrderFromID(bobOrderID))
+	require.Equal(t, []string{alice, bob}, previous.ChangedOwners(book.Book))
+
+	// Every owner changes when a book is created or removed
+	var empty *types.OrderBook
+	require.ElementsMatch(t, []string{alice, bob, carol}, empty.ChangedOwners(&previous))
+	require.ElementsMatch(t, []string{alice, bob, carol}, previous.ChangedOwners(nil))
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxOrderExpirations is the maximum number of expired orders removed in a block,
+// the other expired orders are removed in the next blocks
+const MaxOrderExpirations = 100
+
+// Validate checks the order deposit policy is well formed
+func (p OrderDepositPolicy) Validate() error {
+	if p.Deposit != nil && (!p.Deposit.IsValid() || p.Deposit.IsZero()) {
+		return fmt.Errorf("invalid order deposit: %s", p.Deposit)
+	}
+	if p.Lifetime < 0 {
+		return fmt.Errorf("order lifetime cannot be negative: %s", p.Lifetime)
+	}
+	if p.SlashFraction.IsNil() {
+		return nil
+	}
+	if p.SlashFraction.IsNegative() || p.SlashFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("order deposit slash fraction must be between 0 and 1: %s", p.SlashFraction)
+	}
+	return nil
+}
+
+// IsEnabled returns true if the orders require a deposit
+func (p OrderDepositPolicy) IsEnabled() bool {
+	return p.Deposit != nil
+}
+
+// Slash splits the deposit into the amount burned on expiry and the amount refunded
+func (p OrderDepositPolicy) Slash(deposit sdk.Coin) (slashed sdk.Coin, refund sdk.Coin) {
+	slashed = sdk.NewCoin(deposit.Denom, sdk.ZeroInt())
+	if !p.SlashFraction.IsNil() {
+		slashed.Amount = p.SlashFraction.MulInt(deposit.Amount).TruncateInt()
+	}
+	return slashed, deposit.Sub(slashed)
+}
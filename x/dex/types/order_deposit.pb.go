@@ -0,0 +1,1338 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/order_deposit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OrderDepositPolicy requires a refundable deposit for every order sent. The deposit
+// is returned when the order is filled or cancelled and partly slashed when it expires.
+type OrderDepositPolicy struct {
+	// deposit of an order, empty to disable the deposits
+	Deposit *types.Coin `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+	// resting orders with a deposit are removed after their lifetime, zero to keep them
+	Lifetime time.Duration `protobuf:"bytes,2,opt,name=lifetime,proto3,stdduration" json:"lifetime" yaml:"lifetime"`
+	// fraction of the deposit burned when the order expires
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slashFraction" yaml:"slash_fraction"`
+}
+
+func (m *OrderDepositPolicy) Reset()         { *m = OrderDepositPolicy{} }
+func (m *OrderDepositPolicy) String() string { return proto.CompactTextString(m) }
+func (*OrderDepositPolicy) ProtoMessage()    {}
+func (*OrderDepositPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7313ef6e2bb8f21a, []int{0}
+}
+func (m *OrderDepositPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderDepositPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderDepositPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderDepositPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderDepositPolicy.Merge(m, src)
+}
+func (m *OrderDepositPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderDepositPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderDepositPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderDepositPolicy proto.InternalMessageInfo
+
+func (m *OrderDepositPolicy) GetDeposit() *types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *OrderDepositPolicy) GetLifetime() time.Duration {
+	if m != nil {
+		return m.Lifetime
+	}
+	return 0
+}
+
+// OrderDeposit is the deposit of a resting order of an order book.
+type OrderDeposit struct {
+	// sell or buy
+	OrderType string `protobuf:"bytes,1,opt,name=orderType,proto3" json:"orderType,omitempty"`
+	// index of the order book
+	Index   string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	OrderID int32  `protobuf:"varint,3,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Owner   string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// port and channel escrowing the tokens of the order
+	Port    string     `protobuf:"bytes,5,opt,name=port,proto3" json:"port,omitempty"`
+	Channel string     `protobuf:"bytes,6,opt,name=channel,proto3" json:"channel,omitempty"`
+	Deposit types.Coin `protobuf:"bytes,7,opt,name=deposit,proto3" json:"deposit"`
+	// time the order expires, unset if it doesn't expire
+	Expiration time.Time `protobuf:"bytes,8,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *OrderDeposit) Reset()         { *m = OrderDeposit{} }
+func (m *OrderDeposit) String() string { return proto.CompactTextString(m) }
+func (*OrderDeposit) ProtoMessage()    {}
+func (*OrderDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7313ef6e2bb8f21a, []int{1}
+}
+func (m *OrderDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderDeposit.Merge(m, src)
+}
+func (m *OrderDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderDeposit proto.InternalMessageInfo
+
+func (m *OrderDeposit) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *OrderDeposit) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *OrderDeposit) GetOrderID() int32 {
+	if m != nil {
+		return m.OrderID
+	}
+	return 0
+}
+
+func (m *OrderDeposit) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *OrderDeposit) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *OrderDeposit) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *OrderDeposit) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+func (m *OrderDeposit) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+// EventOrderExpiryFailed is emitted when an expired order cannot be removed, the order keeps
+// resting without expiration and its deposit is refunded when it is filled or cancelled.
+type EventOrderExpiryFailed struct {
+	// sell or buy
+	OrderType string `protobuf:"bytes,1,opt,name=orderType,proto3" json:"orderType,omitempty"`
+	// index of the order book
+	Index   string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	OrderID int32  `protobuf:"varint,3,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Owner   string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// error returned when expiring the order
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventOrderExpiryFailed) Reset()         { *m = EventOrderExpiryFailed{} }
+func (m *EventOrderExpiryFailed) String() string { return proto.CompactTextString(m) }
+func (*EventOrderExpiryFailed) ProtoMessage()    {}
+func (*EventOrderExpiryFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7313ef6e2bb8f21a, []int{2}
+}
+func (m *EventOrderExpiryFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderExpiryFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderExpiryFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderExpiryFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderExpiryFailed.Merge(m, src)
+}
+func (m *EventOrderExpiryFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderExpiryFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderExpiryFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderExpiryFailed proto.InternalMessageInfo
+
+func (m *EventOrderExpiryFailed) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *EventOrderExpiryFailed) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *EventOrderExpiryFailed) GetOrderID() int32 {
+	if m != nil {
+		return m.OrderID
+	}
+	return 0
+}
+
+func (m *EventOrderExpiryFailed) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventOrderExpiryFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*OrderDepositPolicy)(nil), "interchange.dex.OrderDepositPolicy")
+	proto.RegisterType((*OrderDeposit)(nil), "interchange.dex.OrderDeposit")
+	proto.RegisterType((*EventOrderExpiryFailed)(nil), "interchange.dex.EventOrderExpiryFailed")
+}
+
+func init() { proto.RegisterFile("dex/order_deposit.proto", fileDescriptor_7313ef6e2bb8f21a) }
+
+var fileDescriptor_7313ef6e2bb8f21a = []byte{
+	// 515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x3d, 0x73, 0xd3, 0x30,
+	0x18, 0x8e, 0x43, 0x3e, 0xc5, 0x47, 0xef, 0x74, 0xa5, 0x35, 0x81, 0xb3, 0x7b, 0x1e, 0xb8, 0x2e,
+	0x58, 0x17, 0x98, 0x60, 0x0c, 0x69, 0x7b, 0x4c, 0x70, 0xbe, 0x4e, 0x2c, 0x3d, 0xc5, 0x7e, 0xe3,
+	0xe8, 0xb0, 0x2d, 0x9f, 0xa5, 0x94, 0xe4, 0x5f, 0x74, 0x60, 0x60, 0x84, 0x7f, 0xd3, 0xb1, 0x23,
+	0xc7, 0x60, 0xb8, 0xe4, 0x1f, 0xe4, 0x17, 0x70, 0x92, 0x6c, 0x12, 0x60, 0x60, 0xeb, 0x64, 0xbf,
+	0x7a, 0xdf, 0xe7, 0xd1, 0xa3, 0xe7, 0x91, 0xd0, 0x61, 0x04, 0x0b, 0xc2, 0x8b, 0x08, 0x8a, 0x8b,
+	0x08, 0x72, 0x2e, 0x98, 0xf4, 0xf3, 0x82, 0x4b, 0x8e, 0xf7, 0x58, 0x26, 0xa1, 0x08, 0x67, 0x34,
+	0x8b, 0xc1, 0x8f, 0x60, 0x31, 0xd8, 0x8f, 0x79, 0xcc, 0x75, 0x8f, 0xa8, 0x3f, 0x33, 0x36, 0x70,
+	0x42, 0x2e, 0x52, 0x2e, 0xc8, 0x84, 0x0a, 0x20, 0x97, 0xc3, 0x09, 0x48, 0x3a, 0x24, 0x21, 0x67,
+	0x59, 0xdd, 0x8f, 0x39, 0x8f, 0x13, 0x20, 0xba, 0x9a, 0xcc, 0xa7, 0x24, 0x9a, 0x17, 0x54, 0x32,
+	0x5e, 0xf7, 0xdd, 0xbf, 0xfb, 0x92, 0xa5, 0x20, 0x24, 0x4d, 0x73, 0x33, 0xe0, 0x7d, 0x69, 0x22,
+	0xfc, 0x56, 0xe9, 0x1b, 0x1b, 0x79, 0xef, 0x78, 0xc2, 0xc2, 0x25, 0x3e, 0x43, 0xdd, 0x4a, 0xaf,
+	0x6d, 0x1d, 0x59, 0xc7, 0x77, 0x9f, 0x3f, 0xf2, 0x8d, 0x12, 0x5f, 0x29, 0xf1, 0x2b, 0x25, 0xfe,
+	0x6b, 0xce, 0xb2, 0x11, 0xde, 0x94, 0xee, 0x83, 0x25, 0x4d, 0x93, 0x57, 0x5e, 0x85, 0xf1, 0x82,
+	0x1a, 0x8d, 0x03, 0xd4, 0x4b, 0xd8, 0x14, 0xd4, 0xb6, 0x76, 0xb3, 0x62, 0x32, 0x9a, 0xfc, 0x5a,
+	0x93, 0x3f, 0xae, 0x34, 0x8f, 0x1e, 0x5f, 0x97, 0x6e, 0x63, 0x53, 0xba, 0x7b, 0x86, 0xad, 0x06,
+	0x7a, 0x9f, 0x7f, 0xb8, 0x56, 0xf0, 0x9b, 0x07, 0xa7, 0xe8, 0xbe, 0x48, 0xa8, 0x98, 0x9d, 0x16,
+	0x34, 0x54, 0x38, 0xfb, 0xce, 0x91, 0x75, 0xdc, 0x1f, 0x9d, 0x29, 0xf4, 0xf7, 0xd2, 0x7d, 0x1a,
+	0x33, 0x39, 0x9b, 0x4f, 0xfc, 0x90, 0xa7, 0xa4, 0xb2, 0xcf, 0x7c, 0x9e, 0x89, 0xe8, 0x03, 0x91,
+	0xcb, 0x1c, 0x84, 0x3f, 0x86, 0x70, 0x53, 0xba, 0x0f, 0xcd, 0x3e, 0x9a, 0xec, 0x62, 0x5a, 0xb1,
+	0x79, 0xc1, 0x9f, 0xec, 0xde, 0xd7, 0x26, 0xba, 0xb7, 0x6b, 0x11, 0x7e, 0x82, 0xfa, 0x3a, 0xd2,
+	0xf3, 0x65, 0x0e, 0xda, 0x9e, 0x7e, 0xb0, 0x5d, 0xc0, 0xfb, 0xa8, 0xcd, 0xb2, 0x08, 0x16, 0xfa,
+	0xb8, 0xfd, 0xc0, 0x14, 0xd8, 0x46, 0x5d, 0x3d, 0xf2, 0x66, 0xac, 0xd5, 0xb6, 0x83, 0xba, 0x54,
+	0xf3, 0xfc, 0x63, 0x06, 0x85, 0xdd, 0x32, 0xf3, 0xba, 0xc0, 0x18, 0xb5, 0x72, 0x5e, 0x48, 0xbb,
+	0xad, 0x17, 0xf5, 0xbf, 0xe2, 0x50, 0x17, 0x26, 0x83, 0xc4, 0xee, 0xe8, 0xe5, 0xba, 0xc4, 0x2f,
+	0xb7, 0x71, 0x75, 0xff, 0x17, 0x57, 0x4b, 0xd9, 0xb4, 0x0d, 0x68, 0x8c, 0x10, 0x2c, 0x72, 0x66,
+	0x12, 0xb0, 0x7b, 0x1a, 0x3d, 0xf8, 0x27, 0xa2, 0xf3, 0xfa, 0xda, 0x8c, 0x7a, 0x0a, 0x7e, 0xa5,
+	0x02, 0xd9, 0xc1, 0x79, 0x9f, 0x2c, 0x74, 0x70, 0x72, 0x09, 0x99, 0xd4, 0x46, 0x9d, 0xa8, 0xc6,
+	0xf2, 0x94, 0xb2, 0x04, 0xa2, 0x5b, 0x71, 0xeb, 0x00, 0x75, 0x0a, 0xa0, 0x82, 0x67, 0x95, 0x5f,
+	0x55, 0x35, 0x1a, 0x5e, 0xaf, 0x1c, 0xeb, 0x66, 0xe5, 0x58, 0x3f, 0x57, 0x8e, 0x75, 0xb5, 0x76,
+	0x1a, 0x37, 0x6b, 0xa7, 0xf1, 0x6d, 0xed, 0x34, 0xde, 0x1f, 0xee, 0xbc, 0x3f, 0xb2, 0x20, 0xea,
+	0x99, 0xea, 0x9b, 0x31, 0xe9, 0xe8, 0x33, 0xbf, 0xf8, 0x35, 0x00, 0x0a, 0xa2, 0x60, 0x71, 0xba,
+	0x03, 0x00, 0x00,
+}
+
+func (m *OrderDepositPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderDepositPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderDepositPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderDeposit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Lifetime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Lifetime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOrderDeposit(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrderDeposit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintOrderDeposit(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderDeposit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintOrderDeposit(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintOrderDeposit(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOrderDeposit(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OrderID != 0 {
+		i = encodeVarintOrderDeposit(dAtA, i, uint64(m.OrderID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintOrderDeposit(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintOrderDeposit(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderExpiryFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderExpiryFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderExpiryFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintOrderDeposit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOrderDeposit(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OrderID != 0 {
+		i = encodeVarintOrderDeposit(dAtA, i, uint64(m.OrderID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintOrderDeposit(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintOrderDeposit(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrderDeposit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrderDeposit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OrderDepositPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovOrderDeposit(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Lifetime)
+	n += 1 + l + sovOrderDeposit(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOrderDeposit(uint64(l))
+	return n
+}
+
+func (m *OrderDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovOrderDeposit(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovOrderDeposit(uint64(l))
+	}
+	if m.OrderID != 0 {
+		n += 1 + sovOrderDeposit(uint64(m.OrderID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOrderDeposit(uint64(l))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovOrderDeposit(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovOrderDeposit(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovOrderDeposit(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovOrderDeposit(uint64(l))
+	return n
+}
+
+func (m *EventOrderExpiryFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovOrderDeposit(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovOrderDeposit(uint64(l))
+	}
+	if m.OrderID != 0 {
+		n += 1 + sovOrderDeposit(uint64(m.OrderID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOrderDeposit(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovOrderDeposit(uint64(l))
+	}
+	return n
+}
+
+func sovOrderDeposit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrderDeposit(x uint64) (n int) {
+	return sovOrderDeposit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OrderDepositPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderDeposit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderDepositPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderDepositPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &types.Coin{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifetime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Lifetime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderDeposit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderDeposit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderID", wireType)
+			}
+			m.OrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderID |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderDeposit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderExpiryFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderDeposit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderExpiryFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderExpiryFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderID", wireType)
+			}
+			m.OrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderID |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderDeposit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderDeposit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOrderDeposit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOrderDeposit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrderDeposit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOrderDeposit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOrderDeposit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOrderDeposit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOrderDeposit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOrderDeposit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOrderDeposit = fmt.Errorf("proto: unexpected end of group")
+)
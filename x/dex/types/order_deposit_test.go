@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"interchange/x/dex/types"
+)
+
+func TestOrderDepositPolicyValidate(t *testing.T) {
+	deposit := sdk.NewInt64Coin("stake", 100)
+	for _, tc := range []struct {
+		desc   string
+		policy types.OrderDepositPolicy
+		valid  bool
+	}{
+		{
+			desc:   "disabled",
+			policy: types.OrderDepositPolicy{},
+			valid:  true,
+		},
+		{
+			desc: "valid",
+			policy: types.OrderDepositPolicy{
+				Deposit:       &deposit,
+				Lifetime:      time.Hour,
+				SlashFraction: sdk.NewDecWithPrec(5, 1),
+			},
+			valid: true,
+		},
+		{
+			desc: "zero deposit",
+			policy: types.OrderDepositPolicy{
+				Deposit: &sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()},
+			},
+		},
+		{
+			desc: "invalid deposit denom",
+			policy: types.OrderDepositPolicy{
+				Deposit: &sdk.Coin{Denom: "1stake", Amount: sdk.NewInt(100)},
+			},
+		},
+		{
+			desc: "negative lifetime",
+			policy: types.OrderDepositPolicy{
+				Deposit:  &deposit,
+				Lifetime: -time.Hour,
+			},
+		},
+		{
+			desc: "negative slash fraction",
+			policy: types.OrderDepositPolicy{
+				Deposit:       &deposit,
+				SlashFraction: sdk.NewDec(-1),
+			},
+		},
+		{
+			desc: "slash fraction above one",
+			policy: types.OrderDepositPolicy{
+				Deposit:       &deposit,
+				SlashFraction: sdk.NewDecWithPrec(11, 1),
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.OrderDepositPolicy = tc.policy
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestOrderDepositPolicySlash(t *testing.T) {
+	deposit := sdk.NewInt64Coin("stake", 105)
+	for _, tc := range []struct {
+		desc     string
+		fraction sdk.Dec
+		slashed  int64
+	}{
+		{desc: "unset", fraction: sdk.Dec{}, slashed: 0},
+		{desc: "zero", fraction: sdk.ZeroDec(), slashed: 0},
+		{desc: "truncated", fraction: sdk.NewDecWithPrec(1, 1), slashed: 10},
+		{desc: "all", fraction: sdk.OneDec(), slashed: 105},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			policy := types.OrderDepositPolicy{Deposit: &deposit, SlashFraction: tc.fraction}
+			slashed, refund := policy.Slash(deposit)
+			require.True(t, sdk.NewInt64Coin("stake", tc.slashed).IsEqual(slashed))
+			require.True(t, sdk.NewInt64Coin("stake", 105-tc.slashed).IsEqual(refund))
+		})
+	}
+}
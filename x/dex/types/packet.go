@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+)
+
+// PacketData is implemented by every payload carried in the DexPacketData envelope
+type PacketData interface {
+	proto.Message
+
+	// Type returns the packet type, also used as the event type of the packet
+	Type() string
+	ValidateBasic() error
+	GetBytes() ([]byte, error)
+}
+
+// Unwrap returns the payload carried by the envelope
+func (p DexPacketData) Unwrap() (PacketData, error) {
+	switch packet := p.Packet.(type) {
+	case *DexPacketData_CreatePairPacket:
+		return packet.CreatePairPacket, nil
+	case *DexPacketData_SellOrderPacket:
+		return packet.SellOrderPacket, nil
+	case *DexPacketData_BuyOrderPacket:
+		return packet.BuyOrderPacket, nil
+	case *DexPacketData_BatchOrderPacket:
+		return packet.BatchOrderPacket, nil
+	default:
+		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", ModuleName, packet)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+	}
+}
+
+// UnmarshalPacketData decodes the envelope and returns its payload
+func UnmarshalPacketData(bz []byte) (PacketData, error) {
+	var modulePacketData DexPacketData
+	if err := modulePacketData.Unmarshal(bz); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	}
+	return modulePacketData.Unwrap()
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -25,6 +27,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type DexPacketData struct {
 	// Types that are valid to be assigned to Packet:
 	//	*DexPacketData_NoData
+	//	*DexPacketData_BatchOrderPacket
 	//	*DexPacketData_BuyOrderPacket
 	//	*DexPacketData_SellOrderPacket
 	//	*DexPacketData_CreatePairPacket
@@ -73,6 +76,9 @@ type isDexPacketData_Packet interface {
 type DexPacketData_NoData struct {
 	NoData *NoData `protobuf:"bytes,1,opt,name=noData,proto3,oneof" json:"noData,omitempty"`
 }
+type DexPacketData_BatchOrderPacket struct {
+	BatchOrderPacket *BatchOrderPacketData `protobuf:"bytes,5,opt,name=batchOrderPacket,proto3,oneof" json:"batchOrderPacket,omitempty"`
+}
 type DexPacketData_BuyOrderPacket struct {
 	BuyOrderPacket *BuyOrderPacketData `protobuf:"bytes,4,opt,name=buyOrderPacket,proto3,oneof" json:"buyOrderPacket,omitempty"`
 }
@@ -84,6 +90,7 @@ type DexPacketData_CreatePairPacket struct {
 }
 
 func (*DexPacketData_NoData) isDexPacketData_Packet()           {}
+func (*DexPacketData_BatchOrderPacket) isDexPacketData_Packet() {}
 func (*DexPacketData_BuyOrderPacket) isDexPacketData_Packet()   {}
 func (*DexPacketData_SellOrderPacket) isDexPacketData_Packet()  {}
 func (*DexPacketData_CreatePairPacket) isDexPacketData_Packet() {}
@@ -102,6 +109,13 @@ func (m *DexPacketData) GetNoData() *NoData {
 	return nil
 }
 
+func (m *DexPacketData) GetBatchOrderPacket() *BatchOrderPacketData {
+	if x, ok := m.GetPacket().(*DexPacketData_BatchOrderPacket); ok {
+		return x.BatchOrderPacket
+	}
+	return nil
+}
+
 func (m *DexPacketData) GetBuyOrderPacket() *BuyOrderPacketData {
 	if x, ok := m.GetPacket().(*DexPacketData_BuyOrderPacket); ok {
 		return x.BuyOrderPacket
@@ -127,6 +141,7 @@ func (m *DexPacketData) GetCreatePairPacket() *CreatePairPacketData {
 func (*DexPacketData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*DexPacketData_NoData)(nil),
+		(*DexPacketData_BatchOrderPacket)(nil),
 		(*DexPacketData_BuyOrderPacket)(nil),
 		(*DexPacketData_SellOrderPacket)(nil),
 		(*DexPacketData_CreatePairPacket)(nil),
@@ -173,6 +188,11 @@ var xxx_messageInfo_NoData proto.InternalMessageInfo
 type CreatePairPacketData struct {
 	SourceDenom string `protobuf:"bytes,1,opt,name=sourceDenom,proto3" json:"sourceDenom,omitempty"`
 	TargetDenom string `protobuf:"bytes,2,opt,name=targetDenom,proto3" json:"targetDenom,omitempty"`
+	Creator     string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// deposit escrowed on the source chain by the creator
+	Deposit *types.Coin `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// display metadata of the source denom
+	SourceMetadata *DenomMetadata `protobuf:"bytes,5,opt,name=sourceMetadata,proto3" json:"sourceMetadata,omitempty"`
 }
 
 func (m *CreatePairPacketData) Reset()         { *m = CreatePairPacketData{} }
@@ -222,8 +242,31 @@ func (m *CreatePairPacketData) GetTargetDenom() string {
 	return ""
 }
 
+func (m *CreatePairPacketData) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *CreatePairPacketData) GetDeposit() *types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *CreatePairPacketData) GetSourceMetadata() *DenomMetadata {
+	if m != nil {
+		return m.SourceMetadata
+	}
+	return nil
+}
+
 // CreatePairPacketAck defines a struct for the packet acknowledgment
 type CreatePairPacketAck struct {
+	// full denom path of the target denom, resolved on the target chain
+	TargetDenom string `protobuf:"bytes,1,opt,name=targetDenom,proto3" json:"targetDenom,omitempty"`
 }
 
 func (m *CreatePairPacketAck) Reset()         { *m = CreatePairPacketAck{} }
@@ -259,13 +302,21 @@ func (m *CreatePairPacketAck) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CreatePairPacketAck proto.InternalMessageInfo
 
+func (m *CreatePairPacketAck) GetTargetDenom() string {
+	if m != nil {
+		return m.TargetDenom
+	}
+	return ""
+}
+
 // SellOrderPacketData defines a struct for the packet payload
 type SellOrderPacketData struct {
-	AmountDenom string `protobuf:"bytes,1,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
-	Amount      int32  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	PriceDenom  string `protobuf:"bytes,3,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
-	Price       int32  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	Seller      string `protobuf:"bytes,5,opt,name=seller,proto3" json:"seller,omitempty"`
+	AmountDenom         string              `protobuf:"bytes,1,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
+	Amount              int32               `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	PriceDenom          string              `protobuf:"bytes,3,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
+	Price               int32               `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Seller              string              `protobuf:"bytes,5,opt,name=seller,proto3" json:"seller,omitempty"`
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,6,opt,name=selfTradePrevention,proto3,enum=interchange.dex.SelfTradePrevention" json:"selfTradePrevention,omitempty"`
 }
 
 func (m *SellOrderPacketData) Reset()         { *m = SellOrderPacketData{} }
@@ -336,10 +387,20 @@ func (m *SellOrderPacketData) GetSeller() string {
 	return ""
 }
 
+func (m *SellOrderPacketData) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradeAllowed
+}
+
 // SellOrderPacketAck defines a struct for the packet acknowledgment
 type SellOrderPacketAck struct {
 	RemainingAmount int32 `protobuf:"varint,1,opt,name=remainingAmount,proto3" json:"remainingAmount,omitempty"`
-	Gain            int32 `protobuf:"varint,2,opt,name=gain,proto3" json:"gain,omitempty"`
+	// amount of price denom received, the price times the amount does not fit an int32
+	Gain int64 `protobuf:"varint,2,opt,name=gain,proto3" json:"gain,omitempty"`
+	// amount of the order cancelled by the self-trade prevention
+	PreventedAmount int32 `protobuf:"varint,3,opt,name=preventedAmount,proto3" json:"preventedAmount,omitempty"`
 }
 
 func (m *SellOrderPacketAck) Reset()         { *m = SellOrderPacketAck{} }
@@ -382,20 +443,28 @@ func (m *SellOrderPacketAck) GetRemainingAmount() int32 {
 	return 0
 }
 
-func (m *SellOrderPacketAck) GetGain() int32 {
+func (m *SellOrderPacketAck) GetGain() int64 {
 	if m != nil {
 		return m.Gain
 	}
 	return 0
 }
 
+func (m *SellOrderPacketAck) GetPreventedAmount() int32 {
+	if m != nil {
+		return m.PreventedAmount
+	}
+	return 0
+}
+
 // BuyOrderPacketData defines a struct for the packet payload
 type BuyOrderPacketData struct {
-	AmountDenom string `protobuf:"bytes,1,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
-	Amount      int32  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	PriceDenom  string `protobuf:"bytes,3,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
-	Price       int32  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	Buyer       string `protobuf:"bytes,5,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	AmountDenom         string              `protobuf:"bytes,1,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
+	Amount              int32               `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	PriceDenom          string              `protobuf:"bytes,3,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
+	Price               int32               `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Buyer               string              `protobuf:"bytes,5,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,6,opt,name=selfTradePrevention,proto3,enum=interchange.dex.SelfTradePrevention" json:"selfTradePrevention,omitempty"`
 }
 
 func (m *BuyOrderPacketData) Reset()         { *m = BuyOrderPacketData{} }
@@ -466,10 +535,21 @@ func (m *BuyOrderPacketData) GetBuyer() string {
 	return ""
 }
 
+func (m *BuyOrderPacketData) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradeAllowed
+}
+
 // BuyOrderPacketAck defines a struct for the packet acknowledgment
 type BuyOrderPacketAck struct {
 	RemainingAmount int32 `protobuf:"varint,1,opt,name=remainingAmount,proto3" json:"remainingAmount,omitempty"`
 	Purchase        int32 `protobuf:"varint,2,opt,name=purchase,proto3" json:"purchase,omitempty"`
+	// part of the price not spent by the fills below the price of the order
+	Refund int64 `protobuf:"varint,3,opt,name=refund,proto3" json:"refund,omitempty"`
+	// amount of the order cancelled by the self-trade prevention
+	PreventedAmount int32 `protobuf:"varint,4,opt,name=preventedAmount,proto3" json:"preventedAmount,omitempty"`
 }
 
 func (m *BuyOrderPacketAck) Reset()         { *m = BuyOrderPacketAck{} }
@@ -519,6 +599,152 @@ func (m *BuyOrderPacketAck) GetPurchase() int32 {
 	return 0
 }
 
+func (m *BuyOrderPacketAck) GetRefund() int64 {
+	if m != nil {
+		return m.Refund
+	}
+	return 0
+}
+
+func (m *BuyOrderPacketAck) GetPreventedAmount() int32 {
+	if m != nil {
+		return m.PreventedAmount
+	}
+	return 0
+}
+
+// BatchOrderPacketData defines a struct for the packet payload
+type BatchOrderPacketData struct {
+	// sell or buy
+	OrderType           string              `protobuf:"bytes,1,opt,name=orderType,proto3" json:"orderType,omitempty"`
+	AmountDenom         string              `protobuf:"bytes,2,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
+	PriceDenom          string              `protobuf:"bytes,3,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
+	Creator             string              `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	Orders              []BatchOrder        `protobuf:"bytes,5,rep,name=orders,proto3" json:"orders"`
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,6,opt,name=selfTradePrevention,proto3,enum=interchange.dex.SelfTradePrevention" json:"selfTradePrevention,omitempty"`
+}
+
+func (m *BatchOrderPacketData) Reset()         { *m = BatchOrderPacketData{} }
+func (m *BatchOrderPacketData) String() string { return proto.CompactTextString(m) }
+func (*BatchOrderPacketData) ProtoMessage()    {}
+func (*BatchOrderPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e40d3eecbdb512f, []int{8}
+}
+func (m *BatchOrderPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOrderPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOrderPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchOrderPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOrderPacketData.Merge(m, src)
+}
+func (m *BatchOrderPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOrderPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOrderPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOrderPacketData proto.InternalMessageInfo
+
+func (m *BatchOrderPacketData) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *BatchOrderPacketData) GetAmountDenom() string {
+	if m != nil {
+		return m.AmountDenom
+	}
+	return ""
+}
+
+func (m *BatchOrderPacketData) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *BatchOrderPacketData) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *BatchOrderPacketData) GetOrders() []BatchOrder {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *BatchOrderPacketData) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradeAllowed
+}
+
+// BatchOrderPacketAck defines a struct for the packet acknowledgment
+type BatchOrderPacketAck struct {
+	// results in the order of the packet orders
+	Results []BatchOrderResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *BatchOrderPacketAck) Reset()         { *m = BatchOrderPacketAck{} }
+func (m *BatchOrderPacketAck) String() string { return proto.CompactTextString(m) }
+func (*BatchOrderPacketAck) ProtoMessage()    {}
+func (*BatchOrderPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e40d3eecbdb512f, []int{9}
+}
+func (m *BatchOrderPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOrderPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOrderPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchOrderPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOrderPacketAck.Merge(m, src)
+}
+func (m *BatchOrderPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOrderPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOrderPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOrderPacketAck proto.InternalMessageInfo
+
+func (m *BatchOrderPacketAck) GetResults() []BatchOrderResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DexPacketData)(nil), "interchange.dex.DexPacketData")
 	proto.RegisterType((*NoData)(nil), "interchange.dex.NoData")
@@ -528,40 +754,60 @@ func init() {
 	proto.RegisterType((*SellOrderPacketAck)(nil), "interchange.dex.SellOrderPacketAck")
 	proto.RegisterType((*BuyOrderPacketData)(nil), "interchange.dex.BuyOrderPacketData")
 	proto.RegisterType((*BuyOrderPacketAck)(nil), "interchange.dex.BuyOrderPacketAck")
+	proto.RegisterType((*BatchOrderPacketData)(nil), "interchange.dex.BatchOrderPacketData")
+	proto.RegisterType((*BatchOrderPacketAck)(nil), "interchange.dex.BatchOrderPacketAck")
 }
 
 func init() { proto.RegisterFile("dex/packet.proto", fileDescriptor_9e40d3eecbdb512f) }
 
 var fileDescriptor_9e40d3eecbdb512f = []byte{
-	// 434 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0xde, 0x25, 0x2a, 0xef, 0x09, 0x7a, 0xf8, 0x0a, 0x57, 0x31, 0x44, 0x27, 0x03,
-	0xd2, 0x4d, 0xa9, 0x0e, 0x3e, 0x41, 0x4b, 0x07, 0x16, 0xa0, 0xca, 0x4d, 0xdc, 0xe6, 0xa6, 0xaf,
-	0x42, 0xd4, 0xab, 0x13, 0x39, 0x8e, 0x94, 0x7e, 0x0b, 0x66, 0x24, 0xbe, 0x0c, 0x13, 0xe3, 0x8d,
-	0x8c, 0xa8, 0xfd, 0x22, 0x28, 0xb6, 0x5b, 0xe5, 0x4f, 0x17, 0x96, 0xdb, 0xfc, 0xbc, 0x79, 0x9e,
-	0x9f, 0x5f, 0xbf, 0xb1, 0xe1, 0x7c, 0x89, 0xe5, 0x38, 0xe3, 0xd1, 0x0a, 0x55, 0x90, 0xc9, 0x54,
-	0xa5, 0x74, 0x90, 0x08, 0x85, 0x32, 0xfa, 0xc6, 0x45, 0x8c, 0xc1, 0x12, 0x4b, 0xf6, 0xab, 0x07,
-	0x4f, 0x67, 0x58, 0xce, 0xb5, 0x69, 0xc6, 0x15, 0xa7, 0x37, 0xe0, 0x89, 0xb4, 0x5a, 0x8d, 0xc8,
-	0x15, 0xb9, 0x3e, 0x7b, 0x77, 0x19, 0xb4, 0x32, 0xc1, 0x67, 0xfd, 0xf9, 0xa3, 0x13, 0x5a, 0x23,
-	0xfd, 0x04, 0xcf, 0x16, 0xc5, 0xe6, 0x8b, 0x5c, 0xa2, 0x34, 0xa0, 0xd1, 0xa9, 0x8e, 0xbe, 0xee,
-	0x44, 0xa7, 0x0d, 0x9b, 0xc5, 0xb4, 0xc2, 0x74, 0x0e, 0x83, 0x1c, 0xef, 0xef, 0xeb, 0xbc, 0x13,
-	0xcd, 0x7b, 0xd3, 0xe1, 0xdd, 0x36, 0x7d, 0x16, 0xd8, 0x8e, 0xd3, 0x5b, 0x38, 0x8f, 0x24, 0x72,
-	0x85, 0x73, 0x9e, 0xec, 0x91, 0x3d, 0x8d, 0x7c, 0xdb, 0x41, 0x7e, 0x68, 0x19, 0x2d, 0xb3, 0x03,
-	0x98, 0xf6, 0xc1, 0x33, 0xb3, 0x65, 0x7d, 0xf0, 0xcc, 0x4c, 0xd8, 0x1d, 0x0c, 0x8f, 0xe5, 0xe9,
-	0x15, 0x9c, 0xe5, 0x69, 0x21, 0x23, 0x9c, 0xa1, 0x48, 0xd7, 0x7a, 0xb2, 0x4f, 0xc2, 0x7a, 0xa9,
-	0x72, 0x28, 0x2e, 0x63, 0x54, 0xc6, 0xd1, 0x33, 0x8e, 0x5a, 0x89, 0xbd, 0x80, 0x8b, 0x36, 0x7b,
-	0x12, 0xad, 0xd8, 0x4f, 0x02, 0x17, 0x47, 0xc6, 0x50, 0x01, 0xf9, 0x3a, 0x2d, 0x84, 0x6a, 0x6c,
-	0x59, 0x2b, 0xd1, 0x97, 0xe0, 0x19, 0xa9, 0x77, 0x73, 0x43, 0xab, 0xa8, 0x0f, 0x90, 0xc9, 0x64,
-	0xdf, 0xeb, 0x89, 0x0e, 0xd6, 0x2a, 0x74, 0x08, 0xae, 0x56, 0xfa, 0x2f, 0xbb, 0xa1, 0x11, 0x15,
-	0xad, 0x1a, 0x3b, 0xca, 0x91, 0xab, 0x13, 0x56, 0xb1, 0x10, 0x68, 0xab, 0xbd, 0x49, 0xb4, 0xa2,
-	0xd7, 0x30, 0x90, 0xb8, 0xe6, 0x89, 0x48, 0x44, 0x3c, 0x31, 0x4d, 0x10, 0x4d, 0x6b, 0x97, 0x29,
-	0x85, 0xd3, 0x98, 0x27, 0xc2, 0xf6, 0xa8, 0xd7, 0xec, 0x07, 0x01, 0xda, 0xbd, 0x4a, 0x8f, 0x7e,
-	0xe4, 0x21, 0xb8, 0x8b, 0x62, 0x73, 0x38, 0xb1, 0x11, 0xec, 0x2b, 0x3c, 0x6f, 0xf6, 0xf6, 0x7f,
-	0xe7, 0x7d, 0x05, 0xfd, 0xac, 0xa8, 0x2e, 0x64, 0x8e, 0xb6, 0xc9, 0x83, 0x9e, 0xde, 0xfc, 0xde,
-	0xfa, 0xe4, 0x61, 0xeb, 0x93, 0xbf, 0x5b, 0x9f, 0x7c, 0xdf, 0xf9, 0xce, 0xc3, 0xce, 0x77, 0xfe,
-	0xec, 0x7c, 0xe7, 0xee, 0xb2, 0x76, 0x8d, 0xc7, 0xe5, 0xb8, 0x7a, 0xf8, 0x6a, 0x93, 0x61, 0xbe,
-	0xf0, 0xf4, 0xc3, 0x7f, 0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x3d, 0xc9, 0xce, 0x0c, 0x04,
-	0x00, 0x00,
+	// 732 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xf3, 0xd7, 0x66, 0x2a, 0x9a, 0xb2, 0x09, 0x34, 0x14, 0x64, 0x42, 0x00, 0x29, 0x27,
+	0x47, 0x69, 0x0f, 0x88, 0x63, 0xd3, 0x0a, 0x71, 0x29, 0x44, 0x6e, 0x85, 0x10, 0x97, 0x6a, 0x63,
+	0x6f, 0x53, 0xab, 0x89, 0xd7, 0x5a, 0xdb, 0x55, 0x22, 0xf1, 0x10, 0xdc, 0x38, 0x70, 0xe4, 0x65,
+	0x7a, 0xec, 0x91, 0x13, 0x42, 0xed, 0x13, 0x80, 0xc4, 0x1d, 0xed, 0x8f, 0x8b, 0xb3, 0x36, 0x45,
+	0x1c, 0xca, 0x6d, 0x67, 0x3c, 0xdf, 0x37, 0x33, 0xdf, 0x8c, 0x77, 0x61, 0xcd, 0x25, 0xb3, 0x5e,
+	0x80, 0x9d, 0x13, 0x12, 0x59, 0x01, 0xa3, 0x11, 0x45, 0x75, 0xcf, 0x8f, 0x08, 0x73, 0x8e, 0xb1,
+	0x3f, 0x26, 0x96, 0x4b, 0x66, 0x1b, 0xa6, 0x43, 0xc3, 0x29, 0x0d, 0x7b, 0x23, 0x1c, 0x92, 0xde,
+	0x69, 0x7f, 0x44, 0x22, 0xdc, 0xef, 0x39, 0xd4, 0xf3, 0x25, 0x60, 0xa3, 0xc5, 0x29, 0x5c, 0xe2,
+	0xd3, 0xe9, 0xe1, 0x94, 0x44, 0xd8, 0xc5, 0x11, 0x56, 0x5f, 0xee, 0xf0, 0x2f, 0x23, 0x1c, 0x39,
+	0xc7, 0x87, 0x94, 0xb9, 0x84, 0x29, 0x77, 0x9d, 0xbb, 0xd3, 0x8e, 0xe6, 0x98, 0x8e, 0xa9, 0x38,
+	0xf6, 0xf8, 0x49, 0x7a, 0x3b, 0x1f, 0x4b, 0x70, 0x6b, 0x97, 0xcc, 0x86, 0xa2, 0xb8, 0x5d, 0x1c,
+	0x61, 0xd4, 0x87, 0xaa, 0x4f, 0xf9, 0xa9, 0x65, 0xb4, 0x8d, 0xee, 0xca, 0xe6, 0xba, 0xa5, 0xd5,
+	0x6a, 0xbd, 0x12, 0x9f, 0x5f, 0x16, 0x6c, 0x15, 0x88, 0xf6, 0x61, 0x4d, 0x14, 0xf0, 0x9a, 0xa7,
+	0x93, 0x54, 0xad, 0x8a, 0x00, 0x3f, 0xcd, 0x80, 0x07, 0x5a, 0xa0, 0xa2, 0xca, 0x10, 0xa0, 0x3d,
+	0x58, 0x1d, 0xc5, 0xf3, 0x34, 0x65, 0x59, 0x50, 0x3e, 0xce, 0x52, 0xc6, 0xf3, 0x2c, 0xa1, 0x06,
+	0x46, 0x43, 0xa8, 0x87, 0x64, 0x32, 0x49, 0xf3, 0x95, 0x04, 0xdf, 0x93, 0x0c, 0xdf, 0xfe, 0x62,
+	0x9c, 0x22, 0xd4, 0xe1, 0xbc, 0x6b, 0x87, 0x11, 0x1c, 0x91, 0x21, 0xf6, 0x12, 0xca, 0xe2, 0x1f,
+	0xba, 0xde, 0xd1, 0x02, 0x93, 0xae, 0x75, 0x82, 0xc1, 0x32, 0x54, 0xe5, 0xa2, 0x74, 0x96, 0xa1,
+	0x2a, 0x85, 0xee, 0x7c, 0x37, 0xa0, 0x99, 0x47, 0x80, 0xda, 0xb0, 0x12, 0xd2, 0x98, 0x39, 0x64,
+	0x97, 0x2f, 0x86, 0x98, 0x57, 0xcd, 0x4e, 0xbb, 0x78, 0x44, 0x84, 0xd9, 0x98, 0x44, 0x32, 0xa2,
+	0x28, 0x23, 0x52, 0x2e, 0xd4, 0x82, 0x25, 0x51, 0x04, 0x65, 0x42, 0x8f, 0x9a, 0x9d, 0x98, 0x68,
+	0x0b, 0x96, 0x5c, 0x12, 0xd0, 0xd0, 0x4b, 0x94, 0xbf, 0x67, 0xc9, 0x25, 0xb5, 0xf8, 0x92, 0x5a,
+	0x6a, 0x49, 0xad, 0x1d, 0xea, 0xf9, 0x76, 0x12, 0x89, 0x5e, 0xc0, 0xaa, 0xcc, 0xbf, 0xa7, 0xb6,
+	0x54, 0x2d, 0x82, 0x99, 0x91, 0x44, 0xa4, 0x4f, 0xa2, 0x6c, 0x0d, 0xd5, 0x79, 0x06, 0x0d, 0xbd,
+	0xe5, 0x6d, 0xe7, 0x44, 0xef, 0xc7, 0xc8, 0xf4, 0xd3, 0xf9, 0x69, 0x40, 0x23, 0x67, 0x80, 0x1c,
+	0x89, 0xa7, 0x34, 0xf6, 0x17, 0x91, 0x29, 0x17, 0xba, 0x0b, 0x55, 0x69, 0x0a, 0x99, 0x2a, 0xb6,
+	0xb2, 0x90, 0x09, 0x10, 0x30, 0x2f, 0x11, 0x59, 0x8a, 0x94, 0xf2, 0xa0, 0x26, 0x54, 0x84, 0x25,
+	0x54, 0xaa, 0xd8, 0xd2, 0xe0, 0x6c, 0x7c, 0x61, 0x08, 0x13, 0x02, 0xd4, 0x6c, 0x65, 0xa1, 0x37,
+	0xd0, 0x08, 0xc9, 0xe4, 0xe8, 0x80, 0x61, 0x97, 0x0c, 0x19, 0x39, 0x25, 0x7e, 0xe4, 0x51, 0xbf,
+	0x55, 0x6d, 0x1b, 0xdd, 0xd5, 0xfc, 0x5d, 0xd4, 0x63, 0xed, 0x3c, 0x82, 0xce, 0x7b, 0x40, 0x5a,
+	0xdb, 0x5c, 0xaf, 0x2e, 0xd4, 0x19, 0x99, 0x62, 0xcf, 0xf7, 0xfc, 0xf1, 0xb6, 0x6c, 0xce, 0x10,
+	0x55, 0xea, 0x6e, 0x84, 0xa0, 0x3c, 0xc6, 0x9e, 0x2f, 0x7a, 0x2f, 0xd9, 0xe2, 0xcc, 0xd1, 0x81,
+	0xcc, 0x40, 0x5c, 0x85, 0x2e, 0x49, 0xb4, 0xe6, 0xee, 0xfc, 0x30, 0x00, 0x65, 0x7f, 0xc3, 0xff,
+	0x2e, 0x7a, 0x13, 0x2a, 0xa3, 0x78, 0x7e, 0xa5, 0xb9, 0x34, 0x6e, 0x4c, 0xf2, 0x4f, 0x06, 0xdc,
+	0x5e, 0x6c, 0xfa, 0xdf, 0x24, 0xdf, 0x80, 0xe5, 0x20, 0xe6, 0x99, 0x43, 0xa2, 0xba, 0xbf, 0xb2,
+	0xb9, 0x2e, 0x8c, 0x1c, 0xc5, 0xbe, 0x2b, 0x7a, 0x2f, 0xd9, 0xca, 0xca, 0x1b, 0x49, 0x39, 0x7f,
+	0x24, 0x9f, 0x8b, 0xd0, 0xcc, 0xbb, 0x6c, 0xd1, 0x03, 0xa8, 0x89, 0x77, 0xe1, 0x60, 0x1e, 0x10,
+	0x35, 0x92, 0xdf, 0x0e, 0x7d, 0x64, 0xc5, 0xec, 0xc8, 0xfe, 0x36, 0x9a, 0xd4, 0x8d, 0x52, 0x5e,
+	0xbc, 0x51, 0x9e, 0x43, 0x55, 0x24, 0x0a, 0x5b, 0x95, 0x76, 0xa9, 0xbb, 0xb2, 0x79, 0xff, 0x9a,
+	0xd7, 0x61, 0x50, 0x3e, 0xfb, 0xfa, 0xb0, 0x60, 0x2b, 0xc0, 0x8d, 0xcd, 0xf0, 0x2d, 0x34, 0x74,
+	0x91, 0xf8, 0x10, 0xb7, 0x61, 0x89, 0x91, 0x30, 0x9e, 0x44, 0x61, 0xcb, 0x10, 0xa5, 0x3e, 0xba,
+	0xa6, 0x54, 0x5b, 0x44, 0xaa, 0x82, 0x13, 0xdc, 0xa0, 0x7f, 0x76, 0x61, 0x1a, 0xe7, 0x17, 0xa6,
+	0xf1, 0xed, 0xc2, 0x34, 0x3e, 0x5c, 0x9a, 0x85, 0xf3, 0x4b, 0xb3, 0xf0, 0xe5, 0xd2, 0x2c, 0xbc,
+	0x5b, 0x4f, 0x51, 0xf5, 0xf8, 0xab, 0x3e, 0xeb, 0x45, 0xf3, 0x80, 0x84, 0xa3, 0xaa, 0x78, 0x93,
+	0xb7, 0x7e, 0x0d, 0x00, 0x39, 0xae, 0x32, 0xa4, 0x30, 0x08, 0x00, 0x00,
 }
 
 func (m *DexPacketData) Marshal() (dAtA []byte, err error) {
@@ -680,6 +926,27 @@ func (m *DexPacketData_BuyOrderPacket) MarshalToSizedBuffer(dAtA []byte) (int, e
 	}
 	return len(dAtA) - i, nil
 }
+func (m *DexPacketData_BatchOrderPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DexPacketData_BatchOrderPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BatchOrderPacket != nil {
+		{
+			size, err := m.BatchOrderPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *NoData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -723,6 +990,37 @@ func (m *CreatePairPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SourceMetadata != nil {
+		{
+			size, err := m.SourceMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.TargetDenom) > 0 {
 		i -= len(m.TargetDenom)
 		copy(dAtA[i:], m.TargetDenom)
@@ -760,6 +1058,13 @@ func (m *CreatePairPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TargetDenom) > 0 {
+		i -= len(m.TargetDenom)
+		copy(dAtA[i:], m.TargetDenom)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.TargetDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -783,6 +1088,11 @@ func (m *SellOrderPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Seller) > 0 {
 		i -= len(m.Seller)
 		copy(dAtA[i:], m.Seller)
@@ -837,6 +1147,11 @@ func (m *SellOrderPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PreventedAmount != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.PreventedAmount))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Gain != 0 {
 		i = encodeVarintPacket(dAtA, i, uint64(m.Gain))
 		i--
@@ -870,6 +1185,11 @@ func (m *BuyOrderPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Buyer) > 0 {
 		i -= len(m.Buyer)
 		copy(dAtA[i:], m.Buyer)
@@ -924,6 +1244,16 @@ func (m *BuyOrderPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PreventedAmount != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.PreventedAmount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Refund != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Refund))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Purchase != 0 {
 		i = encodeVarintPacket(dAtA, i, uint64(m.Purchase))
 		i--
@@ -937,6 +1267,113 @@ func (m *BuyOrderPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BatchOrderPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOrderPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOrderPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AmountDenom) > 0 {
+		i -= len(m.AmountDenom)
+		copy(dAtA[i:], m.AmountDenom)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.AmountDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchOrderPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOrderPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOrderPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -1008,7 +1445,19 @@ func (m *DexPacketData_BuyOrderPacket) Size() (n int) {
 	}
 	return n
 }
-func (m *NoData) Size() (n int) {
+func (m *DexPacketData_BatchOrderPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchOrderPacket != nil {
+		l = m.BatchOrderPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *NoData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1031,6 +1480,18 @@ func (m *CreatePairPacketData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.SourceMetadata != nil {
+		l = m.SourceMetadata.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
 	return n
 }
 
@@ -1040,6 +1501,10 @@ func (m *CreatePairPacketAck) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.TargetDenom)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
 	return n
 }
 
@@ -1067,6 +1532,9 @@ func (m *SellOrderPacketData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovPacket(uint64(m.SelfTradePrevention))
+	}
 	return n
 }
 
@@ -1082,6 +1550,9 @@ func (m *SellOrderPacketAck) Size() (n int) {
 	if m.Gain != 0 {
 		n += 1 + sovPacket(uint64(m.Gain))
 	}
+	if m.PreventedAmount != 0 {
+		n += 1 + sovPacket(uint64(m.PreventedAmount))
+	}
 	return n
 }
 
@@ -1109,6 +1580,9 @@ func (m *BuyOrderPacketData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovPacket(uint64(m.SelfTradePrevention))
+	}
 	return n
 }
 
@@ -1124,6 +1598,61 @@ func (m *BuyOrderPacketAck) Size() (n int) {
 	if m.Purchase != 0 {
 		n += 1 + sovPacket(uint64(m.Purchase))
 	}
+	if m.Refund != 0 {
+		n += 1 + sovPacket(uint64(m.Refund))
+	}
+	if m.PreventedAmount != 0 {
+		n += 1 + sovPacket(uint64(m.PreventedAmount))
+	}
+	return n
+}
+
+func (m *BatchOrderPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.AmountDenom)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovPacket(uint64(m.SelfTradePrevention))
+	}
+	return n
+}
+
+func (m *BatchOrderPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1302,6 +1831,41 @@ func (m *DexPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Packet = &DexPacketData_BuyOrderPacket{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchOrderPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BatchOrderPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &DexPacketData_BatchOrderPacket{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -1466,6 +2030,110 @@ func (m *CreatePairPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.TargetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &types.Coin{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SourceMetadata == nil {
+				m.SourceMetadata = &DenomMetadata{}
+			}
+			if err := m.SourceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -1516,6 +2184,38 @@ func (m *CreatePairPacketAck) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: CreatePairPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -1700,6 +2400,25 @@ func (m *SellOrderPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -1783,7 +2502,26 @@ func (m *SellOrderPacketAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gain |= int32(b&0x7F) << shift
+				m.Gain |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreventedAmount", wireType)
+			}
+			m.PreventedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreventedAmount |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1972,14 +2710,33 @@ func (m *BuyOrderPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPacket(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPacket
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -2060,6 +2817,359 @@ func (m *BuyOrderPacketAck) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			m.Refund = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Refund |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreventedAmount", wireType)
+			}
+			m.PreventedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreventedAmount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchOrderPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchOrderPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchOrderPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, BatchOrder{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchOrderPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchOrderPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchOrderPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, BatchOrderResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
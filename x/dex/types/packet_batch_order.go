@@ -0,0 +1,31 @@
+package types
+
+import "errors"
+
+// Type returns the packet type
+func (p BatchOrderPacketData) Type() string {
+	return EventTypeBatchOrderPacket
+}
+
+// ValidateBasic is used for validating the packet
+func (p BatchOrderPacketData) ValidateBasic() error {
+	if err := validateOrderType(p.OrderType); err != nil {
+		return err
+	}
+	if len(p.Orders) == 0 {
+		return errors.New("no order in the batch")
+	}
+	if err := validateSelfTradePrevention(p.SelfTradePrevention); err != nil {
+		return err
+	}
+	return validateBatchOrders(p.Orders)
+}
+
+// GetBytes is a helper for serialising
+func (p BatchOrderPacketData) GetBytes() ([]byte, error) {
+	var modulePacket DexPacketData
+
+	modulePacket.Packet = &DexPacketData_BatchOrderPacket{&p}
+
+	return modulePacket.Marshal()
+}
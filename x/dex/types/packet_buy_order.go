@@ -1,11 +1,18 @@
 package types
 
-// ValidateBasic is used for validating the packet
-func (p BuyOrderPacketData) ValidateBasic() error {
+import "fmt"
 
-	// TODO: Validate the packet data
+// Type returns the packet type
+func (p BuyOrderPacketData) Type() string {
+	return EventTypeBuyOrderPacket
+}
 
-	return nil
+// ValidateBasic is used for validating the packet
+func (p BuyOrderPacketData) ValidateBasic() error {
+	if err := ValidateAmountAndPrice(p.Amount, p.Price); err != nil {
+		return err
+	}
+	return validateSelfTradePrevention(p.SelfTradePrevention)
 }
 
 // GetBytes is a helper for serialising
@@ -16,3 +23,21 @@ func (p BuyOrderPacketData) GetBytes() ([]byte, error) {
 
 	return modulePacket.Marshal()
 }
+
+// ValidateSettlement checks the acknowledgement does not settle more than the order: the amount left,
+// the amount cancelled by the self-trade prevention and the amount purchased cannot exceed the amount
+// of the order, and the price refunded for the fills below the price of the order cannot exceed the
+// escrow of the amount purchased
+func (ack BuyOrderPacketAck) ValidateSettlement(data BuyOrderPacketData) error {
+	if ack.RemainingAmount < 0 || ack.Purchase < 0 || ack.PreventedAmount < 0 || ack.Refund < 0 {
+		return fmt.Errorf("negative amount in the acknowledgement of the buy order: %s", ack.String())
+	}
+	unfilled := int64(ack.RemainingAmount) + int64(ack.PreventedAmount)
+	if unfilled+int64(ack.Purchase) > int64(data.Amount) {
+		return fmt.Errorf("the acknowledgement settles more than the amount %d of the buy order: %s", data.Amount, ack.String())
+	}
+	if ack.Refund > int64(ack.Purchase)*int64(data.Price) {
+		return fmt.Errorf("the acknowledgement refunds more than the escrow of the buy order: %s", ack.String())
+	}
+	return nil
+}
@@ -1,9 +1,32 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Type returns the packet type
+func (p CreatePairPacketData) Type() string {
+	return EventTypeCreatePairPacket
+}
+
 // ValidateBasic is used for validating the packet
 func (p CreatePairPacketData) ValidateBasic() error {
-
-	// TODO: Validate the packet data
+	if err := sdk.ValidateDenom(p.SourceDenom); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.TargetDenom); err != nil {
+		return err
+	}
+	if p.SourceMetadata != nil {
+		if err := p.SourceMetadata.Validate(); err != nil {
+			return err
+		}
+	}
+	if p.Deposit != nil && !p.Deposit.IsValid() {
+		return fmt.Errorf("invalid pair creation deposit: %s", p.Deposit)
+	}
 
 	return nil
 }
@@ -1,11 +1,18 @@
 package types
 
-// ValidateBasic is used for validating the packet
-func (p SellOrderPacketData) ValidateBasic() error {
+import "fmt"
 
-	// TODO: Validate the packet data
+// Type returns the packet type
+func (p SellOrderPacketData) Type() string {
+	return EventTypeSellOrderPacket
+}
 
-	return nil
+// ValidateBasic is used for validating the packet
+func (p SellOrderPacketData) ValidateBasic() error {
+	if err := ValidateAmountAndPrice(p.Amount, p.Price); err != nil {
+		return err
+	}
+	return validateSelfTradePrevention(p.SelfTradePrevention)
 }
 
 // GetBytes is a helper for serialising
@@ -16,3 +23,17 @@ func (p SellOrderPacketData) GetBytes() ([]byte, error) {
 
 	return modulePacket.Marshal()
 }
+
+// ValidateSettlement checks the acknowledgement does not settle more than the order: the amount left
+// and the amount cancelled by the self-trade prevention are refunded from the escrow, so they cannot
+// exceed the amount of the order. The gain does not bound the amount filled since the bids are filled
+// at their price, which may be above the price of the order.
+func (ack SellOrderPacketAck) ValidateSettlement(data SellOrderPacketData) error {
+	if ack.RemainingAmount < 0 || ack.Gain < 0 || ack.PreventedAmount < 0 {
+		return fmt.Errorf("negative amount in the acknowledgement of the sell order: %s", ack.String())
+	}
+	if int64(ack.RemainingAmount)+int64(ack.PreventedAmount) > int64(data.Amount) {
+		return fmt.Errorf("the acknowledgement settles more than the amount %d of the sell order: %s", data.Amount, ack.String())
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"interchange/testutil/sample"
+	"interchange/x/dex/types"
+)
+
+func TestUnmarshalPacketData(t *testing.T) {
+	for _, tc := range []struct {
+		desc string
+		data types.PacketData
+	}{
+		{
+			desc: "CreatePair",
+			data: &types.CreatePairPacketData{
+				SourceDenom:    "marscoin",
+				TargetDenom:    "venuscoin",
+				SourceMetadata: &types.DenomMetadata{Display: "mars", Decimals: 6},
+			},
+		},
+		{
+			desc: "SellOrder",
+			data: &types.SellOrderPacketData{AmountDenom: "marscoin", Amount: 10, PriceDenom: "venuscoin", Price: 15, Seller: sample.AccAddress()},
+		},
+		{
+			desc: "BuyOrder",
+			data: &types.BuyOrderPacketData{AmountDenom: "marscoin", Amount: 10, PriceDenom: "venuscoin", Price: 15, Buyer: sample.AccAddress()},
+		},
+		{
+			desc: "BatchOrder",
+			data: &types.BatchOrderPacketData{
+				OrderType:   types.OrderTypeSell,
+				AmountDenom: "marscoin",
+				PriceDenom:  "venuscoin",
+				Creator:     sample.AccAddress(),
+				Orders:      []types.BatchOrder{{Amount: 10, Price: 15}, {Amount: 20, Price: 16}},
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			bz, err := tc.data.GetBytes()
+			require.NoError(t, err)
+
+			got, err := types.UnmarshalPacketData(bz)
+			require.NoError(t, err)
+			require.Equal(t, tc.data, got)
+			require.Equal(t, tc.data.Type(), got.Type())
+		})
+	}
+
+	t.Run("NoData", func(t *testing.T) {
+		bz, err := (&types.DexPacketData{Packet: &types.DexPacketData_NoData{NoData: &types.NoData{}}}).Marshal()
+		require.NoError(t, err)
+
+		_, err = types.UnmarshalPacketData(bz)
+		require.ErrorIs(t, err, sdkerrors.ErrUnknownRequest)
+	})
+	t.Run("InvalidBytes", func(t *testing.T) {
+		_, err := types.UnmarshalPacketData([]byte("invalid"))
+		require.ErrorIs(t, err, sdkerrors.ErrUnknownRequest)
+	})
+}
+
+func TestCreatePairPacketDataValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		data  types.CreatePairPacketData
+		valid bool
+	}{
+		{
+			desc:  "valid",
+			data:  types.CreatePairPacketData{SourceDenom: "marscoin", TargetDenom: "venuscoin"},
+			valid: true,
+		},
+		{
+			desc:  "valid with metadata",
+			data:  types.CreatePairPacketData{SourceDenom: "umars", TargetDenom: "venuscoin", SourceMetadata: &types.DenomMetadata{Display: "mars", Decimals: 6}},
+			valid: true,
+		},
+		{
+			desc: "invalid source denom",
+			data: types.CreatePairPacketData{SourceDenom: "1mars", TargetDenom: "venuscoin"},
+		},
+		{
+			desc: "invalid target denom",
+			data: types.CreatePairPacketData{SourceDenom: "marscoin", TargetDenom: ""},
+		},
+		{
+			desc: "invalid display",
+			data: types.CreatePairPacketData{SourceDenom: "umars", TargetDenom: "venuscoin", SourceMetadata: &types.DenomMetadata{}},
+		},
+		{
+			desc: "too many decimals",
+			data: types.CreatePairPacketData{SourceDenom: "umars", TargetDenom: "venuscoin", SourceMetadata: &types.DenomMetadata{Display: "mars", Decimals: 19}},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.data.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestOrderPacketDataSelfTradePrevention(t *testing.T) {
+	for _, stp := range []types.SelfTradePrevention{types.SelfTradeAllowed, types.CancelNewest, types.CancelOldest, types.DecrementBoth} {
+		require.NoError(t, types.SellOrderPacketData{Amount: 10, Price: 5, SelfTradePrevention: stp}.ValidateBasic())
+		require.NoError(t, types.BuyOrderPacketData{Amount: 10, Price: 5, SelfTradePrevention: stp}.ValidateBasic())
+	}
+	require.Error(t, types.SellOrderPacketData{Amount: 10, Price: 5, SelfTradePrevention: 4}.ValidateBasic())
+	require.Error(t, types.BuyOrderPacketData{Amount: 10, Price: 5, SelfTradePrevention: 4}.ValidateBasic())
+}
+
+func TestOrderPacketDataAmountAndPrice(t *testing.T) {
+	// 65536*65537 wraps to 65536 in an int32, the price is out of bounds
+	require.ErrorIs(t, types.BuyOrderPacketData{Amount: 65536, Price: 65537 * 2}.ValidateBasic(), types.ErrMaxPrice)
+	require.ErrorIs(t, types.BuyOrderPacketData{Amount: 0, Price: 5}.ValidateBasic(), types.ErrZeroAmount)
+	require.ErrorIs(t, types.SellOrderPacketData{Amount: -1, Price: 5}.ValidateBasic(), types.ErrNegativeAmount)
+	require.ErrorIs(t, types.SellOrderPacketData{Amount: 10, Price: types.MaxPrice + 1}.ValidateBasic(), types.ErrMaxPrice)
+}
+
+func TestSellOrderPacketAckValidateSettlement(t *testing.T) {
+	data := types.SellOrderPacketData{Amount: 10, Price: 5}
+	for _, tc := range []struct {
+		desc  string
+		ack   types.SellOrderPacketAck
+		valid bool
+	}{
+		{desc: "filled above the price", ack: types.SellOrderPacketAck{RemainingAmount: 4, Gain: 60}, valid: true},
+		{desc: "unfilled", ack: types.SellOrderPacketAck{RemainingAmount: 6, PreventedAmount: 4}, valid: true},
+		{desc: "negative gain", ack: types.SellOrderPacketAck{Gain: -1}},
+		{desc: "remaining above the amount", ack: types.SellOrderPacketAck{RemainingAmount: 11}},
+		{desc: "prevented above the amount", ack: types.SellOrderPacketAck{RemainingAmount: 5, PreventedAmount: 6}},
+		{desc: "overflow", ack: types.SellOrderPacketAck{RemainingAmount: math.MaxInt32, PreventedAmount: math.MaxInt32}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.ack.ValidateSettlement(data)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestBuyOrderPacketAckValidateSettlement(t *testing.T) {
+	data := types.BuyOrderPacketData{Amount: 10, Price: 5}
+	for _, tc := range []struct {
+		desc  string
+		ack   types.BuyOrderPacketAck
+		valid bool
+	}{
+		{desc: "filled below the price", ack: types.BuyOrderPacketAck{RemainingAmount: 2, PreventedAmount: 2, Purchase: 6, Refund: 12}, valid: true},
+		{desc: "refund of the whole escrow of the purchase", ack: types.BuyOrderPacketAck{Purchase: 10, Refund: 50}, valid: true},
+		{desc: "negative refund", ack: types.BuyOrderPacketAck{Purchase: 10, Refund: -1}},
+		{desc: "purchase above the amount", ack: types.BuyOrderPacketAck{RemainingAmount: 1, Purchase: 10}},
+		{desc: "prevented above the amount", ack: types.BuyOrderPacketAck{PreventedAmount: math.MaxInt32}},
+		{desc: "refund above the escrow of the purchase", ack: types.BuyOrderPacketAck{RemainingAmount: 4, Purchase: 6, Refund: 31}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.ack.ValidateSettlement(data)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
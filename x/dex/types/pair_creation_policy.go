@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks the pair creation policy is well formed
+func (p PairCreationPolicy) Validate() error {
+	switch p.Type {
+	case PairCreationOpen:
+	case PairCreationAllowList:
+		if len(p.AllowedDenoms) == 0 {
+			return fmt.Errorf("allow list pair creation policy requires allowed denoms")
+		}
+		for _, denom := range p.AllowedDenoms {
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return err
+			}
+		}
+	case PairCreationAuthority:
+		if _, err := sdk.AccAddressFromBech32(p.Authority); err != nil {
+			return fmt.Errorf("invalid pair creation authority: %w", err)
+		}
+	case PairCreationDeposit:
+		if p.Deposit == nil || !p.Deposit.IsValid() || p.Deposit.IsZero() {
+			return fmt.Errorf("deposit pair creation policy requires a positive deposit")
+		}
+	default:
+		return fmt.Errorf("invalid pair creation policy type: %s", p.Type)
+	}
+	return nil
+}
+
+// IsDenomAllowed returns true if the denom is in the allow list of the policy
+func (p PairCreationPolicy) IsDenomAllowed(denom string) bool {
+	for _, allowed := range p.AllowedDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,528 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/pair_creation_policy.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PairCreationPolicyType defines who can create a pair.
+type PairCreationPolicyType int32
+
+const (
+	// anyone can create a pair
+	PairCreationOpen PairCreationPolicyType = 0
+	// both denoms of the pair must be allowed
+	PairCreationAllowList PairCreationPolicyType = 1
+	// only the authority can create a pair
+	PairCreationAuthority PairCreationPolicyType = 2
+	// the creator escrows a deposit, returned once the pair is created and burned if it is rejected
+	PairCreationDeposit PairCreationPolicyType = 3
+)
+
+var PairCreationPolicyType_name = map[int32]string{
+	0: "PAIR_CREATION_POLICY_OPEN",
+	1: "PAIR_CREATION_POLICY_ALLOW_LIST",
+	2: "PAIR_CREATION_POLICY_AUTHORITY",
+	3: "PAIR_CREATION_POLICY_DEPOSIT",
+}
+
+var PairCreationPolicyType_value = map[string]int32{
+	"PAIR_CREATION_POLICY_OPEN":       0,
+	"PAIR_CREATION_POLICY_ALLOW_LIST": 1,
+	"PAIR_CREATION_POLICY_AUTHORITY":  2,
+	"PAIR_CREATION_POLICY_DEPOSIT":    3,
+}
+
+func (x PairCreationPolicyType) String() string {
+	return proto.EnumName(PairCreationPolicyType_name, int32(x))
+}
+
+func (PairCreationPolicyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6598c95f25fb169c, []int{0}
+}
+
+// PairCreationPolicy is enforced on the source chain when the pair creation is sent
+// and on the target chain when it is received.
+type PairCreationPolicy struct {
+	Type PairCreationPolicyType `protobuf:"varint,1,opt,name=type,proto3,enum=interchange.dex.PairCreationPolicyType" json:"type,omitempty" yaml:"type"`
+	// denoms allowed in a pair, source denoms are in their full path on the chain sending the pair
+	AllowedDenoms []string `protobuf:"bytes,2,rep,name=allowedDenoms,proto3" json:"allowedDenoms,omitempty" yaml:"allowed_denoms"`
+	// address of the pair creator on the chain sending the pair
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// deposit escrowed on the chain sending the pair, the target chain requires at least this deposit
+	Deposit *types.Coin `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *PairCreationPolicy) Reset()         { *m = PairCreationPolicy{} }
+func (m *PairCreationPolicy) String() string { return proto.CompactTextString(m) }
+func (*PairCreationPolicy) ProtoMessage()    {}
+func (*PairCreationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6598c95f25fb169c, []int{0}
+}
+func (m *PairCreationPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairCreationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairCreationPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairCreationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairCreationPolicy.Merge(m, src)
+}
+func (m *PairCreationPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairCreationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairCreationPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairCreationPolicy proto.InternalMessageInfo
+
+func (m *PairCreationPolicy) GetType() PairCreationPolicyType {
+	if m != nil {
+		return m.Type
+	}
+	return PairCreationOpen
+}
+
+func (m *PairCreationPolicy) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *PairCreationPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *PairCreationPolicy) GetDeposit() *types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("interchange.dex.PairCreationPolicyType", PairCreationPolicyType_name, PairCreationPolicyType_value)
+	proto.RegisterType((*PairCreationPolicy)(nil), "interchange.dex.PairCreationPolicy")
+}
+
+func init() { proto.RegisterFile("dex/pair_creation_policy.proto", fileDescriptor_6598c95f25fb169c) }
+
+var fileDescriptor_6598c95f25fb169c = []byte{
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x8a, 0xd3, 0x40,
+	0x1c, 0xc7, 0x9b, 0xb6, 0x28, 0x9d, 0xc5, 0xdd, 0x30, 0x76, 0xdd, 0x36, 0xc8, 0x24, 0xe4, 0x62,
+	0xf1, 0x90, 0xd0, 0xee, 0x49, 0x41, 0xa5, 0x69, 0x8b, 0x06, 0xc2, 0x26, 0x64, 0x23, 0xb2, 0x5e,
+	0x42, 0x9a, 0x0c, 0xdd, 0x81, 0x36, 0x13, 0x92, 0x51, 0x9b, 0x37, 0x90, 0x82, 0xe0, 0x0b, 0x14,
+	0x04, 0x5f, 0xc6, 0xe3, 0x1e, 0x3d, 0x15, 0x69, 0xdf, 0xa0, 0x4f, 0x20, 0xf9, 0xb3, 0x5a, 0xdd,
+	0xdc, 0x02, 0xdf, 0xef, 0xe7, 0xf7, 0x23, 0x9f, 0xf9, 0x01, 0x14, 0xe0, 0xa5, 0x1a, 0x79, 0x24,
+	0x76, 0xfd, 0x18, 0x7b, 0x8c, 0xd0, 0xd0, 0x8d, 0xe8, 0x9c, 0xf8, 0xa9, 0x12, 0xc5, 0x94, 0x51,
+	0x78, 0x42, 0x42, 0x86, 0x63, 0xff, 0xda, 0x0b, 0x67, 0x58, 0x09, 0xf0, 0x52, 0x68, 0xcf, 0xe8,
+	0x8c, 0xe6, 0x99, 0x9a, 0x7d, 0x15, 0x35, 0x01, 0xf9, 0x34, 0x59, 0xd0, 0x44, 0x9d, 0x7a, 0x09,
+	0x56, 0x3f, 0xf6, 0xa7, 0x98, 0x79, 0x7d, 0xd5, 0xa7, 0x24, 0x2c, 0x72, 0xf9, 0x5b, 0x1d, 0x40,
+	0xcb, 0x23, 0xf1, 0xa8, 0x5c, 0x62, 0xe5, 0x3b, 0xa0, 0x01, 0x9a, 0x2c, 0x8d, 0x70, 0x87, 0x93,
+	0xb8, 0xde, 0xf1, 0xe0, 0x89, 0xf2, 0xdf, 0x32, 0xe5, 0x2e, 0xe2, 0xa4, 0x11, 0xd6, 0x4e, 0xf6,
+	0x1b, 0xf1, 0x28, 0xf5, 0x16, 0xf3, 0xe7, 0x72, 0x86, 0xcb, 0x76, 0x3e, 0x05, 0xbe, 0x02, 0x0f,
+	0xbc, 0xf9, 0x9c, 0x7e, 0xc2, 0xc1, 0x18, 0x87, 0x74, 0x91, 0x74, 0xea, 0x52, 0xa3, 0xd7, 0xd2,
+	0xba, 0xfb, 0x8d, 0x78, 0x5a, 0xb4, 0xcb, 0xd8, 0x0d, 0xf2, 0x5c, 0xb6, 0xff, 0xed, 0xc3, 0x01,
+	0x68, 0x79, 0x1f, 0xd8, 0x35, 0x8d, 0x09, 0x4b, 0x3b, 0x0d, 0x89, 0xeb, 0xb5, 0xb4, 0xf6, 0x7e,
+	0x23, 0xf2, 0x25, 0x7c, 0x1b, 0xc9, 0xf6, 0xdf, 0x1a, 0x7c, 0x0d, 0xee, 0x07, 0x38, 0xa2, 0x09,
+	0x61, 0x9d, 0xa6, 0xc4, 0xf5, 0x8e, 0x06, 0x5d, 0xa5, 0x70, 0xa1, 0x64, 0x2e, 0x94, 0xd2, 0x85,
+	0x32, 0xa2, 0x24, 0xd4, 0xe0, 0x7e, 0x23, 0x1e, 0x17, 0xc3, 0x4a, 0x46, 0xb6, 0x6f, 0xe9, 0xa7,
+	0x5f, 0xea, 0xe0, 0x51, 0xf5, 0xff, 0xc2, 0x73, 0xd0, 0xb5, 0x86, 0xba, 0xed, 0x8e, 0xec, 0xc9,
+	0xd0, 0xd1, 0xcd, 0x0b, 0xd7, 0x32, 0x0d, 0x7d, 0x74, 0xe5, 0x9a, 0xd6, 0xe4, 0x82, 0xaf, 0x09,
+	0xed, 0xd5, 0x5a, 0xe2, 0x0f, 0x51, 0x33, 0xc2, 0x21, 0x7c, 0x09, 0xc4, 0x4a, 0x68, 0x68, 0x18,
+	0xe6, 0x3b, 0xd7, 0xd0, 0x2f, 0x1d, 0x9e, 0x13, 0xba, 0xab, 0xb5, 0x74, 0x7a, 0x88, 0x0e, 0x33,
+	0x21, 0x06, 0x49, 0x18, 0x7c, 0x01, 0x50, 0x35, 0xff, 0xd6, 0x79, 0x63, 0xda, 0xba, 0x73, 0xc5,
+	0xd7, 0x2b, 0xf0, 0x3f, 0x5e, 0x9e, 0x81, 0xc7, 0x95, 0xf8, 0x78, 0x62, 0x99, 0x97, 0xba, 0xc3,
+	0x37, 0x84, 0xb3, 0xd5, 0x5a, 0x7a, 0x78, 0x08, 0x8f, 0x0b, 0x13, 0x42, 0xf3, 0xf3, 0x77, 0x54,
+	0xd3, 0xfa, 0x3f, 0xb6, 0x88, 0xbb, 0xd9, 0x22, 0xee, 0xd7, 0x16, 0x71, 0x5f, 0x77, 0xa8, 0x76,
+	0xb3, 0x43, 0xb5, 0x9f, 0x3b, 0x54, 0x7b, 0x7f, 0x76, 0x70, 0x26, 0xea, 0x52, 0xcd, 0x2e, 0x38,
+	0x7b, 0xff, 0x64, 0x7a, 0x2f, 0x3f, 0xb6, 0xf3, 0xdf, 0x03, 0x00, 0xf4, 0x71, 0x27, 0x9f, 0xd5,
+	0x02, 0x00, 0x00,
+}
+
+func (m *PairCreationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairCreationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairCreationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPairCreationPolicy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintPairCreationPolicy(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintPairCreationPolicy(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintPairCreationPolicy(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPairCreationPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPairCreationPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PairCreationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPairCreationPolicy(uint64(m.Type))
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovPairCreationPolicy(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovPairCreationPolicy(uint64(l))
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovPairCreationPolicy(uint64(l))
+	}
+	return n
+}
+
+func sovPairCreationPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPairCreationPolicy(x uint64) (n int) {
+	return sovPairCreationPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PairCreationPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPairCreationPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairCreationPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairCreationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairCreationPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PairCreationPolicyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairCreationPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPairCreationPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPairCreationPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairCreationPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPairCreationPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPairCreationPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairCreationPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPairCreationPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPairCreationPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &types.Coin{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPairCreationPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPairCreationPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPairCreationPolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPairCreationPolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPairCreationPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPairCreationPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPairCreationPolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPairCreationPolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPairCreationPolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPairCreationPolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPairCreationPolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPairCreationPolicy = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,35 +1,135 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v2/modules/core/24-host"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// DefaultCandleRetention is the number of candles kept for every pair and interval by default
+const DefaultCandleRetention = 1440
+
+var (
+	// KeyRateLimits is the store key of the RateLimits param
+	KeyRateLimits = []byte("RateLimits")
+	// KeyRestrictPairCreation is the store key of the RestrictPairCreation param
+	KeyRestrictPairCreation = []byte("RestrictPairCreation")
+	// KeyCircuitBreakerAuthority is the store key of the CircuitBreakerAuthority param
+	KeyCircuitBreakerAuthority = []byte("CircuitBreakerAuthority")
+	// KeyPairCreationPolicy is the store key of the PairCreationPolicy param
+	KeyPairCreationPolicy = []byte("PairCreationPolicy")
+	// KeyMaxOpenOrders is the store key of the MaxOpenOrders param
+	KeyMaxOpenOrders = []byte("MaxOpenOrders")
+	// KeyOrderDepositPolicy is the store key of the OrderDepositPolicy param
+	KeyOrderDepositPolicy = []byte("OrderDepositPolicy")
+	// KeyCandleIntervals is the store key of the CandleIntervals param
+	KeyCandleIntervals = []byte("CandleIntervals")
+	// KeyCandleRetention is the store key of the CandleRetention param
+	KeyCandleRetention = []byte("CandleRetention")
+	// KeyLiquidityMiningPolicy is the store key of the LiquidityMiningPolicy param
+	KeyLiquidityMiningPolicy = []byte("LiquidityMiningPolicy")
+	// KeyPoolSwapFee is the store key of the PoolSwapFee param
+	KeyPoolSwapFee = []byte("PoolSwapFee")
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	rateLimits []RateLimit,
+	restrictPairCreation bool,
+	circuitBreakerAuthority string,
+	pairCreationPolicy PairCreationPolicy,
+	maxOpenOrders uint32,
+	orderDepositPolicy OrderDepositPolicy,
+	candleIntervals []time.Duration,
+	candleRetention uint32,
+	liquidityMiningPolicy LiquidityMiningPolicy,
+	poolSwapFee sdk.Dec,
+) Params {
+	return Params{
+		RateLimits:              rateLimits,
+		RestrictPairCreation:    restrictPairCreation,
+		CircuitBreakerAuthority: circuitBreakerAuthority,
+		PairCreationPolicy:      pairCreationPolicy,
+		MaxOpenOrders:           maxOpenOrders,
+		OrderDepositPolicy:      orderDepositPolicy,
+		CandleIntervals:         candleIntervals,
+		CandleRetention:         candleRetention,
+		LiquidityMiningPolicy:   liquidityMiningPolicy,
+		PoolSwapFee:             poolSwapFee,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	// no rate limit, open pair creation, circuit breaker controlled by governance only
+	// no limit nor deposit on the orders, a day of minute candles, and the incentive
+	// pools rewarding the orders within 5% of the price with 0.1% of the pool per block,
+	// and a 0.3% fee on the trades of the liquidity pools by default
+	return NewParams(
+		nil,
+		false,
+		"",
+		PairCreationPolicy{Type: PairCreationOpen},
+		0,
+		OrderDepositPolicy{SlashFraction: sdk.ZeroDec()},
+		[]time.Duration{time.Minute, time.Hour, 24 * time.Hour},
+		DefaultCandleRetention,
+		LiquidityMiningPolicy{
+			EmissionRate: sdk.NewDecWithPrec(1, 3),
+			MaxDistance:  sdk.NewDecWithPrec(5, 2),
+		},
+		sdk.NewDecWithPrec(3, 3),
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRateLimits, &p.RateLimits, validateRateLimits),
+		paramtypes.NewParamSetPair(KeyRestrictPairCreation, &p.RestrictPairCreation, validateBool),
+		paramtypes.NewParamSetPair(KeyCircuitBreakerAuthority, &p.CircuitBreakerAuthority, validateAuthority),
+		paramtypes.NewParamSetPair(KeyPairCreationPolicy, &p.PairCreationPolicy, validatePairCreationPolicy),
+		paramtypes.NewParamSetPair(KeyMaxOpenOrders, &p.MaxOpenOrders, validateMaxOpenOrders),
+		paramtypes.NewParamSetPair(KeyOrderDepositPolicy, &p.OrderDepositPolicy, validateOrderDepositPolicy),
+		paramtypes.NewParamSetPair(KeyCandleIntervals, &p.CandleIntervals, validateCandleIntervals),
+		paramtypes.NewParamSetPair(KeyCandleRetention, &p.CandleRetention, validateCandleRetention),
+		paramtypes.NewParamSetPair(KeyLiquidityMiningPolicy, &p.LiquidityMiningPolicy, validateLiquidityMiningPolicy),
+		paramtypes.NewParamSetPair(KeyPoolSwapFee, &p.PoolSwapFee, validatePoolSwapFee),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateRateLimits(p.RateLimits); err != nil {
+		return err
+	}
+	if err := validateAuthority(p.CircuitBreakerAuthority); err != nil {
+		return err
+	}
+	if err := validatePairCreationPolicy(p.PairCreationPolicy); err != nil {
+		return err
+	}
+	if err := validateOrderDepositPolicy(p.OrderDepositPolicy); err != nil {
+		return err
+	}
+	if err := validateCandleIntervals(p.CandleIntervals); err != nil {
+		return err
+	}
+	if err := validateLiquidityMiningPolicy(p.LiquidityMiningPolicy); err != nil {
+		return err
+	}
+	return validatePoolSwapFee(p.PoolSwapFee)
 }
 
 // String implements the Stringer interface.
@@ -37,3 +137,132 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAuthority(i interface{}) error {
+	authority, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if authority == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return fmt.Errorf("invalid circuit breaker authority: %w", err)
+	}
+	return nil
+}
+
+func validatePairCreationPolicy(i interface{}) error {
+	policy, ok := i.(PairCreationPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return policy.Validate()
+}
+
+func validateMaxOpenOrders(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateOrderDepositPolicy(i interface{}) error {
+	policy, ok := i.(OrderDepositPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return policy.Validate()
+}
+
+func validateCandleIntervals(i interface{}) error {
+	intervals, ok := i.([]time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	intervalMap := make(map[time.Duration]struct{})
+	for _, interval := range intervals {
+		if interval < time.Second || interval%time.Second != 0 {
+			return fmt.Errorf("candle interval must be a positive number of seconds: %s", interval)
+		}
+		if _, ok := intervalMap[interval]; ok {
+			return fmt.Errorf("duplicated candle interval %s", interval)
+		}
+		intervalMap[interval] = struct{}{}
+	}
+	return nil
+}
+
+func validateCandleRetention(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateLiquidityMiningPolicy(i interface{}) error {
+	policy, ok := i.(LiquidityMiningPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return policy.Validate()
+}
+
+func validatePoolSwapFee(i interface{}) error {
+	fee, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !fee.IsNil() && (fee.IsNegative() || fee.GTE(sdk.OneDec())) {
+		return fmt.Errorf("pool swap fee must be at least 0 and less than 1: %s", fee)
+	}
+	return nil
+}
+
+func validateRateLimits(i interface{}) error {
+	rateLimits, ok := i.([]RateLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	rateLimitMap := make(map[string]struct{})
+	for _, rateLimit := range rateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+		key := string(RateLimitQuotaKey(rateLimit.Port, rateLimit.Channel, rateLimit.Denom))
+		if _, ok := rateLimitMap[key]; ok {
+			return fmt.Errorf("duplicated rate limit for port %s, channel %s and denom %s", rateLimit.Port, rateLimit.Channel, rateLimit.Denom)
+		}
+		rateLimitMap[key] = struct{}{}
+	}
+	return nil
+}
+
+// Validate checks the rate limit is well formed
+func (r RateLimit) Validate() error {
+	if err := host.PortIdentifierValidator(r.Port); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(r.Channel); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+	if r.MaxOutflow < 0 {
+		return fmt.Errorf("max outflow of %s on %s cannot be negative: %d", r.Denom, r.Channel, r.MaxOutflow)
+	}
+	if r.Window <= 0 {
+		return fmt.Errorf("window of %s on %s must be positive: %s", r.Denom, r.Channel, r.Window)
+	}
+	return nil
+}
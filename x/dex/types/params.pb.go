@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +30,23 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	RateLimits []RateLimit `protobuf:"bytes,1,rep,name=rateLimits,proto3" json:"rateLimits" yaml:"rate_limits"`
+	// only the pairs allowed by governance can be created
+	RestrictPairCreation bool `protobuf:"varint,2,opt,name=restrictPairCreation,proto3" json:"restrictPairCreation,omitempty" yaml:"restrict_pair_creation"`
+	// address allowed to trip the circuit breaker besides governance, empty to disable
+	CircuitBreakerAuthority string             `protobuf:"bytes,3,opt,name=circuitBreakerAuthority,proto3" json:"circuitBreakerAuthority,omitempty" yaml:"circuit_breaker_authority"`
+	PairCreationPolicy      PairCreationPolicy `protobuf:"bytes,4,opt,name=pairCreationPolicy,proto3" json:"pairCreationPolicy" yaml:"pair_creation_policy"`
+	// maximum number of resting orders of an account in an order book, zero for no limit
+	MaxOpenOrders      uint32             `protobuf:"varint,5,opt,name=maxOpenOrders,proto3" json:"maxOpenOrders,omitempty" yaml:"max_open_orders"`
+	OrderDepositPolicy OrderDepositPolicy `protobuf:"bytes,6,opt,name=orderDepositPolicy,proto3" json:"orderDepositPolicy" yaml:"order_deposit_policy"`
+	// intervals of the candles aggregated for every pair
+	CandleIntervals []time.Duration `protobuf:"bytes,7,rep,name=candleIntervals,proto3,stdduration" json:"candleIntervals" yaml:"candle_intervals"`
+	// number of intervals of candles kept for every pair and interval up to the current block, the older ones are pruned
+	// at the end of the block, zero to disable and prune the candles
+	CandleRetention       uint32                `protobuf:"varint,8,opt,name=candleRetention,proto3" json:"candleRetention,omitempty" yaml:"candle_retention"`
+	LiquidityMiningPolicy LiquidityMiningPolicy `protobuf:"bytes,9,opt,name=liquidityMiningPolicy,proto3" json:"liquidityMiningPolicy" yaml:"liquidity_mining_policy"`
+	// fraction of the amount sold to a liquidity pool kept by the pool as a fee for the liquidity providers
+	PoolSwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=poolSwapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"poolSwapFee" yaml:"pool_swap_fee"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +81,69 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *Params) GetRestrictPairCreation() bool {
+	if m != nil {
+		return m.RestrictPairCreation
+	}
+	return false
+}
+
+func (m *Params) GetCircuitBreakerAuthority() string {
+	if m != nil {
+		return m.CircuitBreakerAuthority
+	}
+	return ""
+}
+
+func (m *Params) GetPairCreationPolicy() PairCreationPolicy {
+	if m != nil {
+		return m.PairCreationPolicy
+	}
+	return PairCreationPolicy{}
+}
+
+func (m *Params) GetMaxOpenOrders() uint32 {
+	if m != nil {
+		return m.MaxOpenOrders
+	}
+	return 0
+}
+
+func (m *Params) GetOrderDepositPolicy() OrderDepositPolicy {
+	if m != nil {
+		return m.OrderDepositPolicy
+	}
+	return OrderDepositPolicy{}
+}
+
+func (m *Params) GetCandleIntervals() []time.Duration {
+	if m != nil {
+		return m.CandleIntervals
+	}
+	return nil
+}
+
+func (m *Params) GetCandleRetention() uint32 {
+	if m != nil {
+		return m.CandleRetention
+	}
+	return 0
+}
+
+func (m *Params) GetLiquidityMiningPolicy() LiquidityMiningPolicy {
+	if m != nil {
+		return m.LiquidityMiningPolicy
+	}
+	return LiquidityMiningPolicy{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "interchange.dex.Params")
 }
@@ -66,16 +151,47 @@ func init() {
 func init() { proto.RegisterFile("dex/params.proto", fileDescriptor_e49286500ccff43e) }
 
 var fileDescriptor_e49286500ccff43e = []byte{
-	// 132 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x48, 0x49, 0xad, 0xd0,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xcf, 0xcc,
-	0x2b, 0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0x4b, 0x4f, 0xd5, 0x4b, 0x49, 0xad, 0x90, 0x12, 0x49,
-	0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xe9, 0x83, 0x58, 0x10, 0x65, 0x4a, 0x7c, 0x5c, 0x6c, 0x01, 0x60,
-	0x6d, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33, 0x38, 0x19, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
-	0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3,
-	0xb1, 0x1c, 0x43, 0x94, 0x38, 0x92, 0x81, 0xfa, 0x15, 0xfa, 0x20, 0x0b, 0x4b, 0x2a, 0x0b, 0x52,
-	0x8b, 0x93, 0xd8, 0xc0, 0x26, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x81, 0xe6, 0xee, 0x86,
-	0x84, 0x00, 0x00, 0x00,
+	// 638 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x69, 0xe9, 0x87, 0xab, 0xaa, 0xc8, 0x04, 0x62, 0x52, 0x61, 0x07, 0x17, 0x55, 0xb9,
+	0x60, 0x8b, 0x72, 0xeb, 0x09, 0x4c, 0xa9, 0x84, 0x54, 0xd4, 0xc8, 0x08, 0x0e, 0x1c, 0x58, 0x6d,
+	0xec, 0xad, 0xb3, 0xaa, 0xed, 0x35, 0xbb, 0x9b, 0x36, 0xb9, 0xf3, 0x03, 0x38, 0xf6, 0xc8, 0xcf,
+	0xe9, 0xb1, 0x47, 0xc4, 0xc1, 0xa0, 0xf6, 0x1f, 0x44, 0xe2, 0x8e, 0x76, 0xbd, 0x4e, 0xf3, 0xd5,
+	0x53, 0x62, 0xcf, 0x9b, 0xf7, 0xde, 0xcc, 0x3c, 0x59, 0x7f, 0x10, 0xa1, 0x81, 0x97, 0x43, 0x0a,
+	0x53, 0xe6, 0xe6, 0x94, 0x70, 0x62, 0x6c, 0xe1, 0x8c, 0x23, 0x1a, 0xf6, 0x60, 0x16, 0x23, 0x37,
+	0x42, 0x83, 0x66, 0x3d, 0x26, 0x31, 0x91, 0x35, 0x4f, 0xfc, 0x2b, 0x61, 0xcd, 0xba, 0x68, 0xa4,
+	0x90, 0x23, 0x90, 0xe0, 0x14, 0x73, 0xf5, 0xd6, 0x2a, 0xe9, 0x30, 0x05, 0x21, 0x45, 0x90, 0x63,
+	0x92, 0x81, 0x9c, 0x24, 0x38, 0x1c, 0xaa, 0x7a, 0x43, 0xd4, 0x09, 0x8d, 0x10, 0x05, 0x11, 0xca,
+	0x09, 0x1b, 0x37, 0x3e, 0x14, 0x05, 0x9c, 0x85, 0x28, 0xe3, 0xf8, 0x0c, 0x55, 0x6c, 0x31, 0x21,
+	0x71, 0x82, 0x3c, 0xf9, 0xd4, 0xed, 0x9f, 0x78, 0x51, 0x9f, 0x4a, 0xd2, 0xb2, 0xee, 0xfc, 0x5b,
+	0xd5, 0x57, 0x3a, 0xd2, 0xbb, 0xf1, 0x59, 0xd7, 0x85, 0x99, 0x23, 0xe1, 0x85, 0x99, 0x5a, 0x6b,
+	0xa9, 0xbd, 0xb1, 0xd7, 0x74, 0x67, 0x46, 0x71, 0x83, 0x0a, 0xe2, 0x37, 0x2f, 0x0b, 0xbb, 0x36,
+	0x2a, 0x6c, 0x63, 0x08, 0xd3, 0x64, 0xdf, 0xb9, 0x1d, 0x84, 0x39, 0xc1, 0x04, 0x93, 0xf1, 0x49,
+	0xaf, 0x53, 0xc4, 0x38, 0xc5, 0x21, 0xef, 0x40, 0x4c, 0xdf, 0xaa, 0xa9, 0xcc, 0x7b, 0x2d, 0xad,
+	0xbd, 0xe6, 0x3f, 0x1b, 0x15, 0xf6, 0x53, 0xc5, 0xa0, 0x50, 0x60, 0x6a, 0x7a, 0x27, 0x58, 0xd8,
+	0x6e, 0x7c, 0xd5, 0x1b, 0x21, 0xa6, 0x61, 0x1f, 0x73, 0x9f, 0x22, 0x78, 0x8a, 0xe8, 0x9b, 0x3e,
+	0xef, 0x11, 0x8a, 0xf9, 0xd0, 0x5c, 0x6a, 0x69, 0xed, 0x75, 0xff, 0xf9, 0xa8, 0xb0, 0x5b, 0x25,
+	0xb3, 0x02, 0x82, 0x6e, 0x89, 0x04, 0xb0, 0x82, 0x3a, 0xc1, 0x5d, 0x24, 0xc6, 0x40, 0x37, 0xf2,
+	0x09, 0xbd, 0x8e, 0xbc, 0x81, 0xb9, 0xdc, 0xd2, 0xda, 0x1b, 0x7b, 0x3b, 0x73, 0x6b, 0xe9, 0xcc,
+	0x41, 0xfd, 0x1d, 0xb5, 0x9f, 0xed, 0xd2, 0xc3, 0xa2, 0x93, 0x3a, 0xc1, 0x02, 0x0d, 0xe3, 0xb5,
+	0xbe, 0x99, 0xc2, 0xc1, 0x71, 0x8e, 0xb2, 0x63, 0x71, 0x66, 0x66, 0xde, 0x6f, 0x69, 0xed, 0x4d,
+	0xbf, 0x39, 0x2a, 0xec, 0xc7, 0x25, 0x57, 0x0a, 0x07, 0x80, 0xe4, 0x28, 0x03, 0x32, 0x07, 0xcc,
+	0x09, 0xa6, 0x1b, 0x84, 0x77, 0x59, 0x39, 0x28, 0x03, 0xa2, 0xbc, 0xaf, 0xdc, 0xe1, 0xfd, 0x78,
+	0x0e, 0x3a, 0xeb, 0x7d, 0x2a, 0x6e, 0xb7, 0xde, 0xe7, 0x35, 0x8c, 0x9e, 0xbe, 0x15, 0xc2, 0x2c,
+	0x4a, 0xd0, 0x7b, 0x21, 0x72, 0x06, 0x13, 0x66, 0xae, 0xca, 0x24, 0x3d, 0x71, 0xcb, 0x24, 0xba,
+	0x55, 0x12, 0xdd, 0x03, 0x95, 0xc4, 0xb1, 0x58, 0x43, 0x1d, 0x4b, 0xf6, 0x03, 0x5c, 0x11, 0x38,
+	0x17, 0x7f, 0x6c, 0x2d, 0x98, 0xa5, 0x35, 0xde, 0x55, 0x4a, 0x01, 0xe2, 0x22, 0xf2, 0x24, 0x33,
+	0xd7, 0xe4, 0x9e, 0xb6, 0xe7, 0xa8, 0x68, 0x85, 0x70, 0x82, 0xd9, 0x1e, 0xe3, 0xbb, 0xa6, 0x3f,
+	0x4a, 0xf0, 0xb7, 0x3e, 0x8e, 0x30, 0x1f, 0x7e, 0xc0, 0x19, 0xce, 0x62, 0xb5, 0xae, 0x75, 0xb9,
+	0xae, 0xdd, 0xb9, 0x75, 0x1d, 0x2d, 0x42, 0xfb, 0xbb, 0x6a, 0x08, 0xab, 0x54, 0x1e, 0x53, 0x82,
+	0x54, 0xa2, 0xc6, 0x4b, 0x5b, 0x2c, 0x66, 0xf4, 0xf4, 0x8d, 0x9c, 0x90, 0xe4, 0xe3, 0x39, 0xcc,
+	0x0f, 0x11, 0x32, 0x75, 0x99, 0xe0, 0x43, 0xc1, 0xf9, 0xbb, 0xb0, 0x77, 0x63, 0xcc, 0x7b, 0xfd,
+	0xae, 0x1b, 0x92, 0xd4, 0x0b, 0x09, 0x4b, 0x09, 0x53, 0x3f, 0x2f, 0x58, 0x74, 0xea, 0xf1, 0x61,
+	0x8e, 0x98, 0x7b, 0x80, 0xc2, 0x51, 0x61, 0xd7, 0x55, 0xd6, 0x08, 0x49, 0x00, 0x3b, 0x87, 0x39,
+	0x38, 0x41, 0xc8, 0x09, 0x26, 0xa9, 0xf7, 0x97, 0x2f, 0x7e, 0xda, 0x35, 0xff, 0xe5, 0xe5, 0xb5,
+	0xa5, 0x5d, 0x5d, 0x5b, 0xda, 0xdf, 0x6b, 0x4b, 0xfb, 0x71, 0x63, 0xd5, 0xae, 0x6e, 0xac, 0xda,
+	0xaf, 0x1b, 0xab, 0xf6, 0xa5, 0x31, 0x31, 0xaf, 0x37, 0xf0, 0xc4, 0x47, 0x45, 0x2a, 0x74, 0x57,
+	0xe4, 0xe5, 0x5e, 0xfd, 0x1f, 0x00, 0x0a, 0x76, 0x24, 0x60, 0xf0, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -98,6 +214,99 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.PoolSwapFee.Size()
+		i -= size
+		if _, err := m.PoolSwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.LiquidityMiningPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.CandleRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CandleRetention))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.CandleIntervals) > 0 {
+		for iNdEx := len(m.CandleIntervals) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CandleIntervals[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CandleIntervals[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintParams(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.OrderDepositPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaxOpenOrders != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOpenOrders))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.PairCreationPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.CircuitBreakerAuthority) > 0 {
+		i -= len(m.CircuitBreakerAuthority)
+		copy(dAtA[i:], m.CircuitBreakerAuthority)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.CircuitBreakerAuthority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RestrictPairCreation {
+		i--
+		if m.RestrictPairCreation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -118,6 +327,39 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.RestrictPairCreation {
+		n += 2
+	}
+	l = len(m.CircuitBreakerAuthority)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.PairCreationPolicy.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxOpenOrders != 0 {
+		n += 1 + sovParams(uint64(m.MaxOpenOrders))
+	}
+	l = m.OrderDepositPolicy.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.CandleIntervals) > 0 {
+		for _, e := range m.CandleIntervals {
+			l = github_com_gogo_protobuf_types.SizeOfStdDuration(e)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.CandleRetention != 0 {
+		n += 1 + sovParams(uint64(m.CandleRetention))
+	}
+	l = m.LiquidityMiningPolicy.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.PoolSwapFee.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -156,6 +398,297 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictPairCreation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestrictPairCreation = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairCreationPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PairCreationPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenOrders", wireType)
+			}
+			m.MaxOpenOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenOrders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderDepositPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderDepositPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleIntervals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CandleIntervals = append(m.CandleIntervals, time.Duration(0))
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&(m.CandleIntervals[len(m.CandleIntervals)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleRetention", wireType)
+			}
+			m.CandleRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CandleRetention |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityMiningPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityMiningPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,204 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"interchange/testutil/sample"
+	"interchange/x/dex/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	rateLimit := types.RateLimit{
+		Port:       "dex",
+		Channel:    "channel-0",
+		Denom:      "stake",
+		MaxOutflow: 1000,
+		Window:     time.Hour,
+	}
+	for _, tc := range []struct {
+		desc       string
+		rateLimits []types.RateLimit
+		valid      bool
+	}{
+		{
+			desc:       "valid",
+			rateLimits: []types.RateLimit{rateLimit},
+			valid:      true,
+		},
+		{
+			desc: "invalid port",
+			rateLimits: []types.RateLimit{func() types.RateLimit {
+				r := rateLimit
+				r.Port = ""
+				return r
+			}()},
+		},
+		{
+			desc: "same channel on another port",
+			rateLimits: []types.RateLimit{rateLimit, func() types.RateLimit {
+				r := rateLimit
+				r.Port = "other"
+				return r
+			}()},
+			valid: true,
+		},
+		{
+			desc: "invalid channel",
+			rateLimits: []types.RateLimit{func() types.RateLimit {
+				r := rateLimit
+				r.Channel = ""
+				return r
+			}()},
+		},
+		{
+			desc: "invalid denom",
+			rateLimits: []types.RateLimit{func() types.RateLimit {
+				r := rateLimit
+				r.Denom = "1"
+				return r
+			}()},
+		},
+		{
+			desc: "negative max outflow",
+			rateLimits: []types.RateLimit{func() types.RateLimit {
+				r := rateLimit
+				r.MaxOutflow = -1
+				return r
+			}()},
+		},
+		{
+			desc: "zero window",
+			rateLimits: []types.RateLimit{func() types.RateLimit {
+				r := rateLimit
+				r.Window = 0
+				return r
+			}()},
+		},
+		{
+			desc:       "duplicated",
+			rateLimits: []types.RateLimit{rateLimit, rateLimit},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.RateLimits = tc.rateLimits
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsValidateCircuitBreakerAuthority(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+	params.CircuitBreakerAuthority = sample.AccAddress()
+	require.NoError(t, params.Validate())
+	params.CircuitBreakerAuthority = "invalid"
+	require.Error(t, params.Validate())
+}
+
+func TestParamsValidatePairCreationPolicy(t *testing.T) {
+	deposit := sdk.NewInt64Coin("stake", 100)
+	for _, tc := range []struct {
+		desc   string
+		policy types.PairCreationPolicy
+		valid  bool
+	}{
+		{
+			desc:   "open",
+			policy: types.PairCreationPolicy{Type: types.PairCreationOpen},
+			valid:  true,
+		},
+		{
+			desc:   "allow list",
+			policy: types.PairCreationPolicy{Type: types.PairCreationAllowList, AllowedDenoms: []string{"stake"}},
+			valid:  true,
+		},
+		{
+			desc:   "empty allow list",
+			policy: types.PairCreationPolicy{Type: types.PairCreationAllowList},
+		},
+		{
+			desc:   "authority",
+			policy: types.PairCreationPolicy{Type: types.PairCreationAuthority, Authority: sample.AccAddress()},
+			valid:  true,
+		},
+		{
+			desc:   "invalid authority",
+			policy: types.PairCreationPolicy{Type: types.PairCreationAuthority, Authority: "invalid"},
+		},
+		{
+			desc:   "deposit",
+			policy: types.PairCreationPolicy{Type: types.PairCreationDeposit, Deposit: &deposit},
+			valid:  true,
+		},
+		{
+			desc:   "no deposit",
+			policy: types.PairCreationPolicy{Type: types.PairCreationDeposit},
+		},
+		{
+			desc:   "unknown type",
+			policy: types.PairCreationPolicy{Type: 42},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.PairCreationPolicy = tc.policy
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsValidateCandleIntervals(t *testing.T) {
+	for _, tc := range []struct {
+		desc      string
+		intervals []time.Duration
+		valid     bool
+	}{
+		{
+			desc:  "none",
+			valid: true,
+		},
+		{
+			desc:      "valid",
+			intervals: []time.Duration{time.Minute, time.Hour, 24 * time.Hour},
+			valid:     true,
+		},
+		{
+			desc:      "zero",
+			intervals: []time.Duration{0},
+		},
+		{
+			desc:      "not whole seconds",
+			intervals: []time.Duration{1500 * time.Millisecond},
+		},
+		{
+			desc:      "duplicated",
+			intervals: []time.Duration{time.Minute, time.Minute},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.CandleIntervals = tc.intervals
+			params.CandleRetention = 1
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package types
+
+const (
+	// OrderTypeSell is the type of the pending orders sent with a sell-order packet
+	OrderTypeSell = "sell"
+	// OrderTypeBuy is the type of the pending orders sent with a buy-order packet
+	OrderTypeBuy = "buy"
+)
@@ -0,0 +1,814 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/pending_order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingOrder is an order sent over IBC that has not been acknowledged yet
+type PendingOrder struct {
+	Port        string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	Channel     string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sequence    uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Owner       string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	OrderType   string `protobuf:"bytes,5,opt,name=orderType,proto3" json:"orderType,omitempty"`
+	AmountDenom string `protobuf:"bytes,6,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
+	Amount      int32  `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	PriceDenom  string `protobuf:"bytes,8,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
+	Price       int32  `protobuf:"varint,9,opt,name=price,proto3" json:"price,omitempty"`
+	// orders sent with a batch-order packet, amount and price are unset
+	BatchOrders []BatchOrder `protobuf:"bytes,10,rep,name=batchOrders,proto3" json:"batchOrders"`
+	// deposit escrowed for each order, unset if no deposit was required
+	Deposit *types.Coin `protobuf:"bytes,11,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *PendingOrder) Reset()         { *m = PendingOrder{} }
+func (m *PendingOrder) String() string { return proto.CompactTextString(m) }
+func (*PendingOrder) ProtoMessage()    {}
+func (*PendingOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a785a46dd42fff3, []int{0}
+}
+func (m *PendingOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingOrder.Merge(m, src)
+}
+func (m *PendingOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingOrder proto.InternalMessageInfo
+
+func (m *PendingOrder) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *PendingOrder) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *PendingOrder) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PendingOrder) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *PendingOrder) GetAmountDenom() string {
+	if m != nil {
+		return m.AmountDenom
+	}
+	return ""
+}
+
+func (m *PendingOrder) GetAmount() int32 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PendingOrder) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *PendingOrder) GetPrice() int32 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *PendingOrder) GetBatchOrders() []BatchOrder {
+	if m != nil {
+		return m.BatchOrders
+	}
+	return nil
+}
+
+func (m *PendingOrder) GetDeposit() *types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PendingOrder)(nil), "interchange.dex.PendingOrder")
+}
+
+func init() { proto.RegisterFile("dex/pending_order.proto", fileDescriptor_9a785a46dd42fff3) }
+
+var fileDescriptor_9a785a46dd42fff3 = []byte{
+	// 364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcd, 0x6e, 0xe2, 0x30,
+	0x14, 0x85, 0x63, 0x08, 0x7f, 0xce, 0x48, 0x23, 0x59, 0xcc, 0xe0, 0xc9, 0x54, 0x6e, 0xd4, 0x55,
+	0x56, 0x8e, 0x80, 0x37, 0x80, 0xee, 0x5b, 0x45, 0x5d, 0x75, 0x53, 0xe5, 0xe7, 0x2a, 0x44, 0x2a,
+	0x76, 0x9a, 0x98, 0x16, 0xde, 0xa2, 0x8f, 0xc5, 0x92, 0x65, 0x57, 0x55, 0x05, 0xef, 0xd0, 0x75,
+	0x15, 0x1b, 0x68, 0xd4, 0x9d, 0xcf, 0x39, 0xf7, 0xd3, 0xb5, 0x8f, 0xf1, 0x28, 0x85, 0x75, 0x50,
+	0x80, 0x48, 0x73, 0x91, 0x3d, 0xc8, 0x32, 0x85, 0x92, 0x17, 0xa5, 0x54, 0x92, 0xfc, 0xce, 0x85,
+	0x82, 0x32, 0x59, 0x44, 0x22, 0x03, 0x9e, 0xc2, 0xda, 0x1d, 0x66, 0x32, 0x93, 0x3a, 0x0b, 0xea,
+	0x93, 0x19, 0x73, 0xff, 0xd4, 0x7c, 0x1c, 0xa9, 0x64, 0xd1, 0xa4, 0x5d, 0x96, 0xc8, 0x6a, 0x29,
+	0xab, 0x20, 0x8e, 0x2a, 0x08, 0x9e, 0xc7, 0x31, 0xa8, 0x68, 0x1c, 0x24, 0x32, 0x17, 0x26, 0xbf,
+	0xfa, 0x6c, 0xe1, 0x5f, 0xb7, 0x66, 0xeb, 0x4d, 0x8d, 0x11, 0x82, 0xed, 0x42, 0x96, 0x8a, 0x22,
+	0x0f, 0xf9, 0x83, 0x50, 0x9f, 0x09, 0xc5, 0xbd, 0x7a, 0xbf, 0x80, 0x47, 0xda, 0xd2, 0xf6, 0x49,
+	0x12, 0x17, 0xf7, 0x2b, 0x78, 0x5a, 0x81, 0x48, 0x80, 0xb6, 0x3d, 0xe4, 0xdb, 0xe1, 0x59, 0x93,
+	0x21, 0xee, 0xc8, 0x17, 0x01, 0x25, 0xb5, 0x35, 0x63, 0x04, 0xb9, 0xc0, 0x03, 0x7d, 0xbf, 0xbb,
+	0x4d, 0x01, 0xb4, 0xa3, 0x93, 0x6f, 0x83, 0x78, 0xd8, 0x89, 0x96, 0x72, 0x25, 0xd4, 0x35, 0x08,
+	0xb9, 0xa4, 0x5d, 0x9d, 0x37, 0x2d, 0xf2, 0x17, 0x77, 0x8d, 0xa4, 0x3d, 0x0f, 0xf9, 0x9d, 0xf0,
+	0xa8, 0x08, 0xc3, 0xb8, 0x28, 0xf3, 0x04, 0x0c, 0xd8, 0xd7, 0x60, 0xc3, 0xa9, 0x6f, 0xa3, 0x15,
+	0x1d, 0x68, 0xcc, 0x08, 0x32, 0xc7, 0x8e, 0xee, 0x4c, 0xbf, 0xbd, 0xa2, 0xd8, 0x6b, 0xfb, 0xce,
+	0xe4, 0x3f, 0xff, 0x51, 0x39, 0x9f, 0x9d, 0x67, 0x66, 0xf6, 0xf6, 0xfd, 0xd2, 0x0a, 0x9b, 0x14,
+	0x99, 0xe2, 0x5e, 0x0a, 0x85, 0xac, 0x72, 0x45, 0x1d, 0x0f, 0xf9, 0xce, 0xe4, 0x1f, 0x37, 0xad,
+	0xf3, 0xba, 0x75, 0x7e, 0x6c, 0x9d, 0xcf, 0x65, 0x2e, 0xc2, 0xd3, 0xe4, 0x6c, 0xbc, 0xdd, 0x33,
+	0xb4, 0xdb, 0x33, 0xf4, 0xb1, 0x67, 0xe8, 0xf5, 0xc0, 0xac, 0xdd, 0x81, 0x59, 0x6f, 0x07, 0x66,
+	0xdd, 0x8f, 0x1a, 0xdb, 0x83, 0x75, 0x50, 0xff, 0xab, 0xda, 0x14, 0x50, 0xc5, 0x5d, 0xfd, 0x65,
+	0xd3, 0xaf, 0x01, 0x00, 0x03, 0xbc, 0xf0, 0x19, 0x2b, 0x02, 0x00, 0x00,
+}
+
+func (m *PendingOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPendingOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.BatchOrders) > 0 {
+		for iNdEx := len(m.BatchOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPendingOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Price != 0 {
+		i = encodeVarintPendingOrder(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintPendingOrder(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Amount != 0 {
+		i = encodeVarintPendingOrder(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AmountDenom) > 0 {
+		i -= len(m.AmountDenom)
+		copy(dAtA[i:], m.AmountDenom)
+		i = encodeVarintPendingOrder(dAtA, i, uint64(len(m.AmountDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintPendingOrder(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintPendingOrder(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintPendingOrder(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintPendingOrder(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintPendingOrder(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPendingOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPendingOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovPendingOrder(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovPendingOrder(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovPendingOrder(uint64(m.Sequence))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovPendingOrder(uint64(l))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovPendingOrder(uint64(l))
+	}
+	l = len(m.AmountDenom)
+	if l > 0 {
+		n += 1 + l + sovPendingOrder(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovPendingOrder(uint64(m.Amount))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovPendingOrder(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 1 + sovPendingOrder(uint64(m.Price))
+	}
+	if len(m.BatchOrders) > 0 {
+		for _, e := range m.BatchOrders {
+			l = e.Size()
+			n += 1 + l + sovPendingOrder(uint64(l))
+		}
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovPendingOrder(uint64(l))
+	}
+	return n
+}
+
+func sovPendingOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPendingOrder(x uint64) (n int) {
+	return sovPendingOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPendingOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchOrders = append(m.BatchOrders, BatchOrder{})
+			if err := m.BatchOrders[len(m.BatchOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &types.Coin{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPendingOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPendingOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPendingOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPendingOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPendingOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPendingOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPendingOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPendingOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPendingOrder = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,343 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	host "github.com/cosmos/ibc-go/v2/modules/core/24-host"
+)
+
+const (
+	// ProposalTypeResetRateLimitQuota defines the type for a ResetRateLimitQuotaProposal
+	ProposalTypeResetRateLimitQuota = "ResetRateLimitQuota"
+	// ProposalTypeAllowPairCreation defines the type for a AllowPairCreationProposal
+	ProposalTypeAllowPairCreation = "AllowPairCreation"
+	// ProposalTypePausePair defines the type for a PausePairProposal
+	ProposalTypePausePair = "PausePair"
+	// ProposalTypeDelistPair defines the type for a DelistPairProposal
+	ProposalTypeDelistPair = "DelistPair"
+	// ProposalTypeCircuitBreaker defines the type for a CircuitBreakerProposal
+	ProposalTypeCircuitBreaker = "CircuitBreaker"
+	// ProposalTypeFundIncentivePool defines the type for a FundIncentivePoolProposal
+	ProposalTypeFundIncentivePool = "FundIncentivePool"
+)
+
+var (
+	_ govtypes.Content = &ResetRateLimitQuotaProposal{}
+	_ govtypes.Content = &AllowPairCreationProposal{}
+	_ govtypes.Content = &PausePairProposal{}
+	_ govtypes.Content = &DelistPairProposal{}
+	_ govtypes.Content = &CircuitBreakerProposal{}
+	_ govtypes.Content = &FundIncentivePoolProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeResetRateLimitQuota)
+	govtypes.RegisterProposalType(ProposalTypeAllowPairCreation)
+	govtypes.RegisterProposalType(ProposalTypePausePair)
+	govtypes.RegisterProposalType(ProposalTypeDelistPair)
+	govtypes.RegisterProposalType(ProposalTypeCircuitBreaker)
+	govtypes.RegisterProposalType(ProposalTypeFundIncentivePool)
+}
+
+// validatePair checks the identifiers and denoms of a pair
+func validatePair(port, channel, sourceDenom, targetDenom string) error {
+	if err := host.PortIdentifierValidator(port); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(channel); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(sourceDenom); err != nil {
+		return err
+	}
+	return sdk.ValidateDenom(targetDenom)
+}
+
+// NewResetRateLimitQuotaProposal creates a new ResetRateLimitQuotaProposal
+func NewResetRateLimitQuotaProposal(title, description, port, channel, denom string) *ResetRateLimitQuotaProposal {
+	return &ResetRateLimitQuotaProposal{
+		Title:       title,
+		Description: description,
+		Port:        port,
+		Channel:     channel,
+		Denom:       denom,
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p *ResetRateLimitQuotaProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p *ResetRateLimitQuotaProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p *ResetRateLimitQuotaProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *ResetRateLimitQuotaProposal) ProposalType() string {
+	return ProposalTypeResetRateLimitQuota
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ResetRateLimitQuotaProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := host.PortIdentifierValidator(p.Port); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(p.Channel); err != nil {
+		return err
+	}
+	return sdk.ValidateDenom(p.Denom)
+}
+
+// String implements the Stringer interface
+func (p ResetRateLimitQuotaProposal) String() string {
+	return fmt.Sprintf(`Reset Rate Limit Quota Proposal:
+  Title:       %s
+  Description: %s
+  Port:        %s
+  Channel:     %s
+  Denom:       %s
+`, p.Title, p.Description, p.Port, p.Channel, p.Denom)
+}
+
+// NewAllowPairCreationProposal creates a new AllowPairCreationProposal
+func NewAllowPairCreationProposal(title, description, port, channel, sourceDenom, targetDenom string) *AllowPairCreationProposal {
+	return &AllowPairCreationProposal{
+		Title:       title,
+		Description: description,
+		Port:        port,
+		Channel:     channel,
+		SourceDenom: sourceDenom,
+		TargetDenom: targetDenom,
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p *AllowPairCreationProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p *AllowPairCreationProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p *AllowPairCreationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *AllowPairCreationProposal) ProposalType() string { return ProposalTypeAllowPairCreation }
+
+// PairIndex returns the order book index of the pair
+func (p *AllowPairCreationProposal) PairIndex() string {
+	return OrderBookIndex(p.Port, p.Channel, p.SourceDenom, p.TargetDenom)
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *AllowPairCreationProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return validatePair(p.Port, p.Channel, p.SourceDenom, p.TargetDenom)
+}
+
+// String implements the Stringer interface
+func (p AllowPairCreationProposal) String() string {
+	return fmt.Sprintf(`Allow Pair Creation Proposal:
+  Title:        %s
+  Description:  %s
+  Port:         %s
+  Channel:      %s
+  Source Denom: %s
+  Target Denom: %s
+`, p.Title, p.Description, p.Port, p.Channel, p.SourceDenom, p.TargetDenom)
+}
+
+// NewPausePairProposal creates a new PausePairProposal
+func NewPausePairProposal(title, description, port, channel, sourceDenom, targetDenom string, paused bool) *PausePairProposal {
+	return &PausePairProposal{
+		Title:       title,
+		Description: description,
+		Port:        port,
+		Channel:     channel,
+		SourceDenom: sourceDenom,
+		TargetDenom: targetDenom,
+		Paused:      paused,
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p *PausePairProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p *PausePairProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p *PausePairProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *PausePairProposal) ProposalType() string { return ProposalTypePausePair }
+
+// PairIndex returns the order book index of the pair
+func (p *PausePairProposal) PairIndex() string {
+	return OrderBookIndex(p.Port, p.Channel, p.SourceDenom, p.TargetDenom)
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *PausePairProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return validatePair(p.Port, p.Channel, p.SourceDenom, p.TargetDenom)
+}
+
+// String implements the Stringer interface
+func (p PausePairProposal) String() string {
+	return fmt.Sprintf(`Pause Pair Proposal:
+  Title:        %s
+  Description:  %s
+  Port:         %s
+  Channel:      %s
+  Source Denom: %s
+  Target Denom: %s
+  Paused:       %t
+`, p.Title, p.Description, p.Port, p.Channel, p.SourceDenom, p.TargetDenom, p.Paused)
+}
+
+// NewDelistPairProposal creates a new DelistPairProposal
+func NewDelistPairProposal(title, description, port, channel, sourceDenom, targetDenom string) *DelistPairProposal {
+	return &DelistPairProposal{
+		Title:       title,
+		Description: description,
+		Port:        port,
+		Channel:     channel,
+		SourceDenom: sourceDenom,
+		TargetDenom: targetDenom,
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p *DelistPairProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p *DelistPairProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p *DelistPairProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *DelistPairProposal) ProposalType() string { return ProposalTypeDelistPair }
+
+// PairIndex returns the order book index of the pair
+func (p *DelistPairProposal) PairIndex() string {
+	return OrderBookIndex(p.Port, p.Channel, p.SourceDenom, p.TargetDenom)
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *DelistPairProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return validatePair(p.Port, p.Channel, p.SourceDenom, p.TargetDenom)
+}
+
+// String implements the Stringer interface
+func (p DelistPairProposal) String() string {
+	return fmt.Sprintf(`Delist Pair Proposal:
+  Title:        %s
+  Description:  %s
+  Port:         %s
+  Channel:      %s
+  Source Denom: %s
+  Target Denom: %s
+`, p.Title, p.Description, p.Port, p.Channel, p.SourceDenom, p.TargetDenom)
+}
+
+// NewCircuitBreakerProposal creates a new CircuitBreakerProposal
+func NewCircuitBreakerProposal(title, description string, msgTypes, packetTypes []string, tripped bool) *CircuitBreakerProposal {
+	return &CircuitBreakerProposal{
+		Title:       title,
+		Description: description,
+		MsgTypes:    msgTypes,
+		PacketTypes: packetTypes,
+		Tripped:     tripped,
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p *CircuitBreakerProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p *CircuitBreakerProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p *CircuitBreakerProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *CircuitBreakerProposal) ProposalType() string { return ProposalTypeCircuitBreaker }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *CircuitBreakerProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return ValidateCircuitBreakerTypes(p.MsgTypes, p.PacketTypes)
+}
+
+// String implements the Stringer interface
+func (p CircuitBreakerProposal) String() string {
+	return fmt.Sprintf(`Circuit Breaker Proposal:
+  Title:        %s
+  Description:  %s
+  Msg Types:    %v
+  Packet Types: %v
+  Tripped:      %t
+`, p.Title, p.Description, p.MsgTypes, p.PacketTypes, p.Tripped)
+}
+
+// NewFundIncentivePoolProposal creates a new FundIncentivePoolProposal
+func NewFundIncentivePoolProposal(title, description, pairIndex string, amount sdk.Coins) *FundIncentivePoolProposal {
+	return &FundIncentivePoolProposal{
+		Title:       title,
+		Description: description,
+		PairIndex:   pairIndex,
+		Amount:      amount,
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p *FundIncentivePoolProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p *FundIncentivePoolProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p *FundIncentivePoolProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *FundIncentivePoolProposal) ProposalType() string { return ProposalTypeFundIncentivePool }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *FundIncentivePoolProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.PairIndex == "" {
+		return fmt.Errorf("pair index cannot be empty")
+	}
+	if !p.Amount.IsValid() || p.Amount.IsZero() {
+		return fmt.Errorf("invalid incentive pool amount: %s", p.Amount)
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (p FundIncentivePoolProposal) String() string {
+	return fmt.Sprintf(`Fund Incentive Pool Proposal:
+  Title:       %s
+  Description: %s
+  Pair Index:  %s
+  Amount:      %s
+`, p.Title, p.Description, p.PairIndex, p.Amount)
+}
@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"interchange/x/dex/types"
+)
+
+func TestPairProposalValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		proposal interface{ ValidateBasic() error }
+		valid    bool
+	}{
+		{
+			desc:     "valid allow",
+			proposal: types.NewAllowPairCreationProposal("title", "description", "dex", "channel-0", "stake", "token"),
+			valid:    true,
+		},
+		{
+			desc:     "valid pause",
+			proposal: types.NewPausePairProposal("title", "description", "dex", "channel-0", "stake", "token", true),
+			valid:    true,
+		},
+		{
+			desc:     "valid delist",
+			proposal: types.NewDelistPairProposal("title", "description", "dex", "channel-0", "stake", "token"),
+			valid:    true,
+		},
+		{
+			desc:     "empty title",
+			proposal: types.NewAllowPairCreationProposal("", "description", "dex", "channel-0", "stake", "token"),
+		},
+		{
+			desc:     "invalid channel",
+			proposal: types.NewPausePairProposal("title", "description", "dex", "", "stake", "token", true),
+		},
+		{
+			desc:     "invalid denom",
+			proposal: types.NewDelistPairProposal("title", "description", "dex", "channel-0", "stake", "1"),
+		},
+		{
+			desc:     "valid circuit breaker",
+			proposal: types.NewCircuitBreakerProposal("title", "description", []string{"/interchange.dex.MsgSendSellOrder"}, []string{types.EventTypeSellOrderPacket}, true),
+			valid:    true,
+		},
+		{
+			desc:     "empty circuit breaker",
+			proposal: types.NewCircuitBreakerProposal("title", "description", nil, nil, true),
+		},
+		{
+			desc:     "valid fund incentive pool",
+			proposal: types.NewFundIncentivePoolProposal("title", "description", "dex-channel-0-stake-token", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+			valid:    true,
+		},
+		{
+			desc:     "empty incentive pool amount",
+			proposal: types.NewFundIncentivePoolProposal("title", "description", "dex-channel-0-stake-token", nil),
+		},
+		{
+			desc:     "invalid rate limit channel",
+			proposal: types.NewResetRateLimitQuotaProposal("title", "description", "dex", "", "stake"),
+		},
+		{
+			desc:     "invalid rate limit port",
+			proposal: types.NewResetRateLimitQuotaProposal("title", "description", "", "channel-0", "stake"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+)
+
+// MaxRouteLegs is the maximum number of legs of a routed order, a direct leg or two legs through an intermediate denom
+const MaxRouteLegs = 2
+
+// Validate checks the leg fields
+func (l RouteLeg) Validate() error {
+	if l.Port == "" {
+		return errors.New("invalid packet port")
+	}
+	if l.Channel == "" {
+		return errors.New("invalid packet channel")
+	}
+	if err := validateOrderType(l.OrderType); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(l.AmountDenom); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(l.PriceDenom); err != nil {
+		return err
+	}
+	return checkAmountAndPrice(1, l.Price)
+}
+
+// PairIndex returns the index of the order book of the pair of the leg
+func (l RouteLeg) PairIndex() string {
+	return OrderBookIndex(l.Port, l.Channel, l.AmountDenom, l.PriceDenom)
+}
+
+// OrderAmount returns the amount of the order sent with the tokens of the leg,
+// a buy order spends the tokens at its price and the rest of the tokens is not sent.
+// It fails if the amount or the price is out of the bounds of an order.
+func (l RouteLeg) OrderAmount(tokens int64) (int32, error) {
+	amount := tokens
+	if l.OrderType == OrderTypeBuy && l.Price > 0 {
+		amount = tokens / int64(l.Price)
+	}
+	switch {
+	case amount < 0:
+		return 0, ErrNegativeAmount
+	case amount > int64(MaxAmount):
+		return 0, ErrMaxAmount
+	}
+	if err := checkAmountAndPrice(int32(amount), l.Price); err != nil {
+		return 0, err
+	}
+	return int32(amount), nil
+}
+
+// validateRouteLegs checks the number of legs of a route and their fields
+func validateRouteLegs(legs []RouteLeg) error {
+	if len(legs) == 0 {
+		return errors.New("no leg in the route")
+	}
+	if len(legs) > MaxRouteLegs {
+		return fmt.Errorf("too many legs in the route: %d > %d", len(legs), MaxRouteLegs)
+	}
+	for i, leg := range legs {
+		if err := leg.Validate(); err != nil {
+			return fmt.Errorf("leg %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks the legs of the routed order and its current leg
+func (o RoutedOrder) Validate() error {
+	if err := validateRouteLegs(o.Legs); err != nil {
+		return err
+	}
+	if int(o.Leg) >= len(o.Legs) {
+		return fmt.Errorf("invalid current leg %d of %d legs", o.Leg, len(o.Legs))
+	}
+	return nil
+}
+
+// CounterpartyDenom returns the denom path of a token on the other end of a channel,
+// the hop of the channel is removed from the path of a token going back and added to the path of the other tokens.
+// The port and channel identifiers are the same on both ends, as for the indexes of the order books.
+func CounterpartyDenom(port string, channel string, denom string) string {
+	prefix := ibctransfertypes.GetDenomPrefix(port, channel)
+	if strings.HasPrefix(denom, prefix) {
+		return strings.TrimPrefix(denom, prefix)
+	}
+	return prefix + denom
+}
@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"interchange/x/dex/types"
+)
+
+func TestCounterpartyDenom(t *testing.T) {
+	require.Equal(t, "dex/channel-0/stake", types.CounterpartyDenom("dex", "channel-0", "stake"))
+	require.Equal(t, "stake", types.CounterpartyDenom("dex", "channel-0", "dex/channel-0/stake"))
+	require.Equal(t, "dex/channel-0/dex/channel-1/stake", types.CounterpartyDenom("dex", "channel-0", "dex/channel-1/stake"))
+}
+
+func TestOrderBookChannel(t *testing.T) {
+	index := types.OrderBookIndex("dex", "channel-12", "dex/channel-3/stake", "token")
+	port, channel, found := types.OrderBookChannel(index, "dex/channel-3/stake", "token")
+	require.True(t, found)
+	require.Equal(t, "dex", port)
+	require.Equal(t, "channel-12", channel)
+
+	_, _, found = types.OrderBookChannel(index, "stake", "token")
+	require.False(t, found)
+}
+
+func TestRouteLegOrderAmount(t *testing.T) {
+	sell := types.RouteLeg{OrderType: types.OrderTypeSell, Price: 3}
+	amount, err := sell.OrderAmount(10)
+	require.NoError(t, err)
+	require.Equal(t, int32(10), amount)
+	buy := types.RouteLeg{OrderType: types.OrderTypeBuy, Price: 3}
+	amount, err = buy.OrderAmount(10)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), amount)
+
+	// The amount must be within the bounds of an order, the tokens of a buy order may exceed an int32
+	_, err = buy.OrderAmount(2)
+	require.ErrorIs(t, err, types.ErrZeroAmount)
+	_, err = sell.OrderAmount(int64(types.MaxAmount) + 1)
+	require.ErrorIs(t, err, types.ErrMaxAmount)
+	_, err = sell.OrderAmount(-1)
+	require.ErrorIs(t, err, types.ErrNegativeAmount)
+	buy.Price = types.MaxPrice
+	amount, err = buy.OrderAmount(int64(types.MaxAmount) * int64(types.MaxPrice))
+	require.NoError(t, err)
+	require.Equal(t, types.MaxAmount, amount)
+}
+
+func TestRoutedOrderValidate(t *testing.T) {
+	leg := types.RouteLeg{Port: "dex", Channel: "channel-0", OrderType: types.OrderTypeSell, AmountDenom: "stake", PriceDenom: "token", Price: 2}
+	for _, tc := range []struct {
+		desc  string
+		order types.RoutedOrder
+		valid bool
+	}{
+		{desc: "direct", order: types.RoutedOrder{Legs: []types.RouteLeg{leg}}, valid: true},
+		{desc: "two legs", order: types.RoutedOrder{Legs: []types.RouteLeg{leg, leg}, Leg: 1}, valid: true},
+		{desc: "no leg", order: types.RoutedOrder{}},
+		{desc: "too many legs", order: types.RoutedOrder{Legs: []types.RouteLeg{leg, leg, leg}}},
+		{desc: "current leg out of range", order: types.RoutedOrder{Legs: []types.RouteLeg{leg}, Leg: 1}},
+		{desc: "invalid price", order: types.RoutedOrder{Legs: []types.RouteLeg{{
+			Port: "dex", Channel: "channel-0", OrderType: types.OrderTypeBuy, AmountDenom: "stake", PriceDenom: "token",
+		}}}},
+		{desc: "invalid order type", order: types.RoutedOrder{Legs: []types.RouteLeg{{
+			Port: "dex", Channel: "channel-0", OrderType: "swap", AmountDenom: "stake", PriceDenom: "token", Price: 2,
+		}}}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.order.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
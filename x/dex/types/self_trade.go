@@ -0,0 +1,65 @@
+package types
+
+import "fmt"
+
+// SelfTrade is the outcome of the self-trade prevention while matching an order
+type SelfTrade struct {
+	// amount of the incoming order cancelled
+	Prevented int32
+	// resting orders cancelled with their cancelled amount
+	Cancelled []Order
+}
+
+// add merges the outcome of a liquidation into the outcome of the whole matching
+func (s *SelfTrade) add(other SelfTrade) {
+	s.Prevented += other.Prevented
+	s.Cancelled = append(s.Cancelled, other.Cancelled...)
+}
+
+// validateSelfTradePrevention checks the self-trade prevention mode is known
+func validateSelfTradePrevention(stp SelfTradePrevention) error {
+	if _, ok := SelfTradePrevention_name[int32(stp)]; !ok {
+		return fmt.Errorf("invalid self-trade prevention %d", stp)
+	}
+	return nil
+}
+
+// 最良の注文が受信した注文と同じ作成者のものである場合、自己取引防止を適用する
+// 注文を約定してよい場合はfalseを返す
+func (book *OrderBook) preventSelfTrade(order *Order, stp SelfTradePrevention) (selfTrade SelfTrade, prevented bool) {
+	orderCount := len(book.Orders)
+	best := book.Orders[orderCount-1]
+	if order.Creator == "" || best.Creator != order.Creator {
+		return selfTrade, false
+	}
+
+	switch stp {
+	case CancelNewest:
+		// 受信した注文の残りを取り消す
+		selfTrade.Prevented = order.Amount
+		order.Amount = 0
+	case CancelOldest:
+		// 板上の注文を取り消し、受信した注文の約定を続ける
+		selfTrade.Cancelled = []Order{*best}
+		book.Orders = book.Orders[:orderCount-1]
+	case DecrementBoth:
+		// 両方の注文を少ない方の数量だけ減らす
+		amount := order.Amount
+		if best.Amount < amount {
+			amount = best.Amount
+		}
+		cancelled := *best
+		cancelled.Amount = amount
+		selfTrade.Prevented = amount
+		selfTrade.Cancelled = []Order{cancelled}
+
+		order.Amount -= amount
+		best.Amount -= amount
+		if best.Amount == 0 {
+			book.Orders = book.Orders[:orderCount-1]
+		}
+	default:
+		return selfTrade, false
+	}
+	return selfTrade, true
+}
@@ -9,16 +9,22 @@ func NewSellOrderBook(AmountDenom string, PriceDenom string) SellOrderBook {
 	}
 }
 
-func (s *SellOrderBook) AppendOrder(creator string, amount int32, price int32) (int32, error) {
-	return s.Book.appendOrder(creator, amount, price, Decreasing)
+func (s *SellOrderBook) AppendOrder(creator string, amount int32, price int32, maxOpenOrders uint32) (int32, error) {
+	return s.Book.appendOrder(creator, amount, price, Decreasing, maxOpenOrders)
+}
+
+// 売り注文の数量と価格を変更し、変更前の注文を返す
+func (s *SellOrderBook) AmendOrder(id int32, amount int32, price int32) (Order, error) {
+	return s.Book.amendOrder(id, amount, price, Decreasing)
 }
 
 // オーダーブックで売り注文を約定しようとし、すべての副作用を返します。
-func (s *SellOrderBook) FillBuyOrder(order Order) (
+func (s *SellOrderBook) FillBuyOrder(order Order, stp SelfTradePrevention) (
 	remainingBuyOrder Order, //残りの買い注文
 	liquidated []Order, //清算済み
 	purchase int32, //購入
 	filled bool,
+	selfTrade SelfTrade, //自己取引防止で取り消された数量
 ) {
 	var liquidatedList []Order //清算リスト
 	totalPurchase := int32(0)  //購入合計
@@ -28,8 +34,10 @@ func (s *SellOrderBook) FillBuyOrder(order Order) (
 	for {
 		var match bool
 		var liquidation Order //清算
-		remainingBuyOrder, liquidation, purchase, match, filled = s.LiquidateFromBuyOrder(
+		var prevented SelfTrade
+		remainingBuyOrder, liquidation, purchase, match, filled, prevented = s.LiquidateFromBuyOrder(
 			remainingBuyOrder,
+			stp,
 		)
 		if !match {
 			break
@@ -38,38 +46,48 @@ func (s *SellOrderBook) FillBuyOrder(order Order) (
 		// 利益を更新する
 		totalPurchase += purchase
 
-		// 清算リスト
-		liquidatedList = append(liquidatedList, liquidation)
+		// 自己取引防止で取り消された注文は清算されない
+		selfTrade.add(prevented)
+		if liquidation.Amount > 0 {
+			// 清算リスト
+			liquidatedList = append(liquidatedList, liquidation)
+		}
 
 		if filled {
 			break
 		}
 	}
 
-	return remainingBuyOrder, liquidatedList, totalPurchase, filled
+	return remainingBuyOrder, liquidatedList, totalPurchase, filled, selfTrade
 }
 
 // 売り注文から最初の買い注文を清算
 // 一致するものが見つからない場合、もしくは、一致する場合はfalseを返す
-func (s *SellOrderBook) LiquidateFromBuyOrder(order Order) (
+func (s *SellOrderBook) LiquidateFromBuyOrder(order Order, stp SelfTradePrevention) (
 	remainingBuyOrder Order,
 	liquidatedSellOrder Order,
 	purchase int32,
 	match bool,
 	filled bool,
+	selfTrade SelfTrade,
 ) {
 	remainingBuyOrder = order
 
 	// 注文がない場合は一致しない
 	orderCount := len(s.Book.Orders)
 	if orderCount == 0 {
-		return order, liquidatedSellOrder, purchase, false, false
+		return order, liquidatedSellOrder, purchase, false, false, selfTrade
 	}
 
 	// Check if match
 	lowestAsk := s.Book.Orders[orderCount-1]
 	if order.Price < lowestAsk.Price {
-		return order, liquidatedSellOrder, purchase, false, false
+		return order, liquidatedSellOrder, purchase, false, false, selfTrade
+	}
+
+	// 同じ作成者の注文とは約定させない
+	if selfTrade, prevented := s.Book.preventSelfTrade(&remainingBuyOrder, stp); prevented {
+		return remainingBuyOrder, liquidatedSellOrder, purchase, true, remainingBuyOrder.Amount == 0, selfTrade
 	}
 
 	liquidatedSellOrder = *lowestAsk
@@ -88,7 +106,7 @@ func (s *SellOrderBook) LiquidateFromBuyOrder(order Order) (
 			s.Book.Orders[orderCount-1] = lowestAsk
 		}
 
-		return remainingBuyOrder, liquidatedSellOrder, purchase, true, true
+		return remainingBuyOrder, liquidatedSellOrder, purchase, true, true, selfTrade
 	}
 
 	// 完全に満たされていない
@@ -96,5 +114,5 @@ func (s *SellOrderBook) LiquidateFromBuyOrder(order Order) (
 	s.Book.Orders = s.Book.Orders[:orderCount-1]
 	remainingBuyOrder.Amount -= lowestAsk.Amount
 
-	return remainingBuyOrder, liquidatedSellOrder, purchase, true, false
+	return remainingBuyOrder, liquidatedSellOrder, purchase, true, false, selfTrade
 }
@@ -32,19 +32,27 @@ func TestSellOrderBook_AppendOrder(t *testing.T) {
 
 	// Prevent zero amount
 	seller, amount, price := GenOrder()
-	_, err := sellBook.AppendOrder(seller, 0, price)
+	_, err := sellBook.AppendOrder(seller, 0, price, 0)
 	require.ErrorIs(t, err, types.ErrZeroAmount)
 
+	// Prevent negative amount
+	_, err = sellBook.AppendOrder(seller, -amount, price, 0)
+	require.ErrorIs(t, err, types.ErrNegativeAmount)
+
 	// Prevent big amount
-	_, err = sellBook.AppendOrder(seller, types.MaxAmount+1, price)
+	_, err = sellBook.AppendOrder(seller, types.MaxAmount+1, price, 0)
 	require.ErrorIs(t, err, types.ErrMaxAmount)
 
 	// Prevent zero price
-	_, err = sellBook.AppendOrder(seller, amount, 0)
+	_, err = sellBook.AppendOrder(seller, amount, 0, 0)
 	require.ErrorIs(t, err, types.ErrZeroPrice)
 
+	// Prevent negative price
+	_, err = sellBook.AppendOrder(seller, amount, -price, 0)
+	require.ErrorIs(t, err, types.ErrNegativePrice)
+
 	// Prevent big price
-	_, err = sellBook.AppendOrder(seller, amount, types.MaxPrice+1)
+	_, err = sellBook.AppendOrder(seller, amount, types.MaxPrice+1, 0)
 	require.ErrorIs(t, err, types.ErrMaxPrice)
 
 	// Can append sell orders
@@ -57,7 +65,7 @@ func TestSellOrderBook_AppendOrder(t *testing.T) {
 			Amount:  amount,
 			Price:   price,
 		}
-		orderID, err := sellBook.AppendOrder(creator, amount, price)
+		orderID, err := sellBook.AppendOrder(creator, amount, price, 0)
 
 		// Checks
 		require.NoError(t, err)
@@ -94,7 +102,7 @@ func simulateLiquidateFromBuyOrder(
 		return expectedBook.Book.Orders[i].Price > expectedBook.Book.Orders[j].Price
 	}))
 
-	remaining, liquidated, purchase, match, filled := book.LiquidateFromBuyOrder(inputOrder)
+	remaining, liquidated, purchase, match, filled, _ := book.LiquidateFromBuyOrder(inputOrder, types.SelfTradeAllowed)
 
 	require.Equal(t, expectedBook, book)
 	require.Equal(t, expected.Remaining, remaining)
@@ -108,7 +116,7 @@ func TestLiquidateFromBuyOrder(t *testing.T) {
 	// No match for empty book
 	inputOrder := types.Order{Id: 10, Creator: MockAccount("1"), Amount: 100, Price: 10}
 	book := OrderListToSellOrderBook([]types.Order{})
-	_, _, _, match, _ := book.LiquidateFromBuyOrder(inputOrder)
+	_, _, _, match, _, _ := book.LiquidateFromBuyOrder(inputOrder, types.SelfTradeAllowed)
 	require.False(t, match)
 
 	// 売り注文
@@ -120,7 +128,7 @@ func TestLiquidateFromBuyOrder(t *testing.T) {
 
 	// Test no match if lowest ask too high (25 < 30)
 	book = OrderListToSellOrderBook(inputBook)
-	_, _, _, match, _ = book.LiquidateFromBuyOrder(inputOrder)
+	_, _, _, match, _, _ = book.LiquidateFromBuyOrder(inputOrder, types.SelfTradeAllowed)
 	require.False(t, match)
 
 	// Entirely filled (30 > 15)
@@ -194,7 +202,7 @@ func simulateFillBuyOrder(
 		return expectedBook.Book.Orders[i].Price > expectedBook.Book.Orders[j].Price
 	}))
 
-	remaining, liquidated, purchase, filled := book.FillBuyOrder(inputOrder)
+	remaining, liquidated, purchase, filled, _ := book.FillBuyOrder(inputOrder, types.SelfTradeAllowed)
 
 	require.Equal(t, expectedBook, book)
 	require.Equal(t, expected.Remaining, remaining)
@@ -280,3 +288,83 @@ func TestFillBuyOrder(t *testing.T) {
 	}
 	simulateFillBuyOrder(t, inputBook, inputOrder, expected)
 }
+
+func TestFillBuyOrderSelfTradePrevention(t *testing.T) {
+	inputBook := []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 25},
+		{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
+		{Id: 2, Creator: MockAccount("2"), Amount: 30, Price: 15},
+	}
+	inputOrder := types.Order{Id: 10, Creator: MockAccount("1"), Amount: 60, Price: 22}
+	liquidated := []types.Order{
+		{Id: 2, Creator: MockAccount("2"), Amount: 30, Price: 15},
+	}
+
+	for _, tc := range []struct {
+		desc      string
+		stp       types.SelfTradePrevention
+		expected  fillBuyRes
+		selfTrade types.SelfTrade
+	}{
+		{
+			desc: "cancel newest",
+			stp:  types.CancelNewest,
+			expected: fillBuyRes{
+				Book: []types.Order{
+					{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 25},
+					{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
+				},
+				Remaining:  types.Order{Id: 10, Creator: MockAccount("1"), Amount: 0, Price: 22},
+				Liquidated: liquidated,
+				Purchase:   int32(30),
+				Filled:     true,
+			},
+			selfTrade: types.SelfTrade{Prevented: 30},
+		},
+		{
+			desc: "cancel oldest",
+			stp:  types.CancelOldest,
+			expected: fillBuyRes{
+				Book: []types.Order{
+					{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 25},
+				},
+				Remaining:  types.Order{Id: 10, Creator: MockAccount("1"), Amount: 30, Price: 22},
+				Liquidated: liquidated,
+				Purchase:   int32(30),
+				Filled:     false,
+			},
+			selfTrade: types.SelfTrade{Cancelled: []types.Order{
+				{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
+			}},
+		},
+		{
+			desc: "decrement both",
+			stp:  types.DecrementBoth,
+			expected: fillBuyRes{
+				Book: []types.Order{
+					{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 25},
+					{Id: 1, Creator: MockAccount("1"), Amount: 170, Price: 20},
+				},
+				Remaining:  types.Order{Id: 10, Creator: MockAccount("1"), Amount: 0, Price: 22},
+				Liquidated: liquidated,
+				Purchase:   int32(30),
+				Filled:     true,
+			},
+			selfTrade: types.SelfTrade{Prevented: 30, Cancelled: []types.Order{
+				{Id: 1, Creator: MockAccount("1"), Amount: 30, Price: 20},
+			}},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			book := OrderListToSellOrderBook(inputBook)
+			remaining, liquidated, purchase, filled, selfTrade := book.FillBuyOrder(inputOrder, tc.stp)
+
+			require.Equal(t, OrderListToSellOrderBook(tc.expected.Book), book)
+			require.Equal(t, tc.expected.Remaining, remaining)
+			require.Equal(t, tc.expected.Liquidated, liquidated)
+			require.Equal(t, tc.expected.Purchase, purchase)
+			require.Equal(t, tc.expected.Filled, filled)
+			require.Equal(t, tc.selfTrade, selfTrade)
+		})
+	}
+}
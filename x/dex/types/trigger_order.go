@@ -0,0 +1,65 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MinPrice is the price of the orders sent by stop-market sell orders, they match any buy order
+const MinPrice = int32(1)
+
+const (
+	// MaxTriggerOrdersPerCreator is the maximum number of open trigger orders of a creator
+	MaxTriggerOrdersPerCreator = 100
+	// MaxTriggerOrderExecutions is the maximum number of trigger orders sent in a block,
+	// the other triggered orders are sent in the next blocks
+	MaxTriggerOrderExecutions = 100
+)
+
+// Validate checks the trigger order fields
+func (o TriggerOrder) Validate() error {
+	if err := validateOrderType(o.OrderType); err != nil {
+		return err
+	}
+	if _, ok := TriggerType_name[int32(o.TriggerType)]; !ok {
+		return fmt.Errorf("invalid trigger type %d", o.TriggerType)
+	}
+	if err := checkAmountAndPrice(o.Amount, o.TriggerPrice); err != nil {
+		return err
+	}
+	if o.TriggerType == StopMarket && o.OrderType == OrderTypeSell {
+		if o.Price != 0 && o.Price != MinPrice {
+			return errors.New("stop-market sell orders have no price")
+		}
+		return nil
+	}
+	if o.TriggerType == StopMarket && o.Price == 0 {
+		return errors.New("stop-market buy orders need a price bounding their escrow")
+	}
+	return checkAmountAndPrice(o.Amount, o.Price)
+}
+
+// OrderPrice returns the price of the order sent when triggered
+func (o TriggerOrder) OrderPrice() int32 {
+	if o.TriggerType == StopMarket && o.OrderType == OrderTypeSell {
+		return MinPrice
+	}
+	return o.Price
+}
+
+// IsTriggered checks if the last price of the pair reached the trigger price.
+// Stop orders are triggered when the price moves against the order, take-profit
+// orders when it moves in its favor.
+func (o TriggerOrder) IsTriggered(lastPrice int32) bool {
+	if o.TriggersOnFall() {
+		return lastPrice <= o.TriggerPrice
+	}
+	return lastPrice >= o.TriggerPrice
+}
+
+// TriggersOnFall checks if the order is triggered when the last price falls to the trigger
+// price, stop sell orders and take-profit buy orders, or when it rises to it
+func (o TriggerOrder) TriggersOnFall() bool {
+	stop := o.TriggerType != TakeProfit
+	return (o.OrderType == OrderTypeSell) == stop
+}
@@ -0,0 +1,1438 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/trigger_order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TriggerType defines when a trigger order is sent and at which price.
+type TriggerType int32
+
+const (
+	// sent when the last price moves against the order, sell orders are sent at the
+	// lowest price and buy orders at their price, which is required as it bounds the
+	// tokens escrowed for them
+	StopMarket TriggerType = 0
+	// sent at their price when the last price moves against the order
+	StopLimit TriggerType = 1
+	// sent at their price when the last price moves in favor of the order
+	TakeProfit TriggerType = 2
+)
+
+var TriggerType_name = map[int32]string{
+	0: "TRIGGER_TYPE_STOP_MARKET",
+	1: "TRIGGER_TYPE_STOP_LIMIT",
+	2: "TRIGGER_TYPE_TAKE_PROFIT",
+}
+
+var TriggerType_value = map[string]int32{
+	"TRIGGER_TYPE_STOP_MARKET": 0,
+	"TRIGGER_TYPE_STOP_LIMIT":  1,
+	"TRIGGER_TYPE_TAKE_PROFIT": 2,
+}
+
+func (x TriggerType) String() string {
+	return proto.EnumName(TriggerType_name, int32(x))
+}
+
+func (TriggerType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ef75871619447a51, []int{0}
+}
+
+// TriggerOrder is an order held with its escrow until the last trade price of its
+// pair reaches the trigger price, it is then sent as a regular order.
+type TriggerOrder struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Port    string `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	Channel string `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	// sell or buy
+	OrderType   string      `protobuf:"bytes,5,opt,name=orderType,proto3" json:"orderType,omitempty"`
+	TriggerType TriggerType `protobuf:"varint,6,opt,name=triggerType,proto3,enum=interchange.dex.TriggerType" json:"triggerType,omitempty"`
+	// index of the order book of the pair
+	PairIndex    string `protobuf:"bytes,7,opt,name=pairIndex,proto3" json:"pairIndex,omitempty"`
+	AmountDenom  string `protobuf:"bytes,8,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
+	PriceDenom   string `protobuf:"bytes,9,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
+	Amount       int32  `protobuf:"varint,10,opt,name=amount,proto3" json:"amount,omitempty"`
+	TriggerPrice int32  `protobuf:"varint,11,opt,name=triggerPrice,proto3" json:"triggerPrice,omitempty"`
+	// price of the order sent when triggered
+	Price int32 `protobuf:"varint,12,opt,name=price,proto3" json:"price,omitempty"`
+	// timeout of the packet sent when triggered, relative to the block time
+	PacketTimeout uint64 `protobuf:"varint,13,opt,name=packetTimeout,proto3" json:"packetTimeout,omitempty"`
+	// set when the order could neither be sent nor refunded once triggered, it is no
+	// longer triggered and its escrow is refunded when it is cancelled
+	Failed bool `protobuf:"varint,14,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (m *TriggerOrder) Reset()         { *m = TriggerOrder{} }
+func (m *TriggerOrder) String() string { return proto.CompactTextString(m) }
+func (*TriggerOrder) ProtoMessage()    {}
+func (*TriggerOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef75871619447a51, []int{0}
+}
+func (m *TriggerOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerOrder.Merge(m, src)
+}
+func (m *TriggerOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerOrder proto.InternalMessageInfo
+
+func (m *TriggerOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TriggerOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *TriggerOrder) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *TriggerOrder) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *TriggerOrder) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *TriggerOrder) GetTriggerType() TriggerType {
+	if m != nil {
+		return m.TriggerType
+	}
+	return StopMarket
+}
+
+func (m *TriggerOrder) GetPairIndex() string {
+	if m != nil {
+		return m.PairIndex
+	}
+	return ""
+}
+
+func (m *TriggerOrder) GetAmountDenom() string {
+	if m != nil {
+		return m.AmountDenom
+	}
+	return ""
+}
+
+func (m *TriggerOrder) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *TriggerOrder) GetAmount() int32 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TriggerOrder) GetTriggerPrice() int32 {
+	if m != nil {
+		return m.TriggerPrice
+	}
+	return 0
+}
+
+func (m *TriggerOrder) GetPrice() int32 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *TriggerOrder) GetPacketTimeout() uint64 {
+	if m != nil {
+		return m.PacketTimeout
+	}
+	return 0
+}
+
+func (m *TriggerOrder) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+// EventTriggerOrderFailed is emitted when a triggered order can neither be sent nor refunded,
+// the order is kept as failed until its creator cancels it.
+type EventTriggerOrderFailed struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// error returned when refunding the order
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventTriggerOrderFailed) Reset()         { *m = EventTriggerOrderFailed{} }
+func (m *EventTriggerOrderFailed) String() string { return proto.CompactTextString(m) }
+func (*EventTriggerOrderFailed) ProtoMessage()    {}
+func (*EventTriggerOrderFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef75871619447a51, []int{1}
+}
+func (m *EventTriggerOrderFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTriggerOrderFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTriggerOrderFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTriggerOrderFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTriggerOrderFailed.Merge(m, src)
+}
+func (m *EventTriggerOrderFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTriggerOrderFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTriggerOrderFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTriggerOrderFailed proto.InternalMessageInfo
+
+func (m *EventTriggerOrderFailed) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventTriggerOrderFailed) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventTriggerOrderFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// LastPrice is the price of the last trade matched in an order book.
+type LastPrice struct {
+	Index  string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Price  int32  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Height int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *LastPrice) Reset()         { *m = LastPrice{} }
+func (m *LastPrice) String() string { return proto.CompactTextString(m) }
+func (*LastPrice) ProtoMessage()    {}
+func (*LastPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef75871619447a51, []int{2}
+}
+func (m *LastPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LastPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastPrice.Merge(m, src)
+}
+func (m *LastPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastPrice proto.InternalMessageInfo
+
+func (m *LastPrice) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *LastPrice) GetPrice() int32 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *LastPrice) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("interchange.dex.TriggerType", TriggerType_name, TriggerType_value)
+	proto.RegisterType((*TriggerOrder)(nil), "interchange.dex.TriggerOrder")
+	proto.RegisterType((*EventTriggerOrderFailed)(nil), "interchange.dex.EventTriggerOrderFailed")
+	proto.RegisterType((*LastPrice)(nil), "interchange.dex.LastPrice")
+}
+
+func init() { proto.RegisterFile("dex/trigger_order.proto", fileDescriptor_ef75871619447a51) }
+
+var fileDescriptor_ef75871619447a51 = []byte{
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6b, 0x1a, 0x41,
+	0x14, 0xc7, 0x1d, 0x63, 0x4c, 0x7c, 0x46, 0x2b, 0x43, 0xd0, 0x41, 0xc2, 0xb2, 0x48, 0x0f, 0x12,
+	0x8a, 0xd2, 0xf6, 0x5e, 0x48, 0xa9, 0x09, 0x12, 0x45, 0xd9, 0xcc, 0xa5, 0xed, 0x41, 0xa6, 0xee,
+	0xcb, 0x3a, 0x18, 0x77, 0x96, 0x71, 0x52, 0xcc, 0x37, 0x28, 0x39, 0xf5, 0x5e, 0x72, 0xea, 0x57,
+	0xe8, 0x87, 0xe8, 0x31, 0xc7, 0x1e, 0x8b, 0x7e, 0x91, 0xb2, 0xb3, 0xdb, 0xb8, 0x69, 0x4e, 0xbd,
+	0xed, 0xff, 0xff, 0xff, 0xcd, 0xdb, 0x79, 0xf3, 0x66, 0xa0, 0xe1, 0xe3, 0xaa, 0x6b, 0xb4, 0x0c,
+	0x02, 0xd4, 0x13, 0xa5, 0x7d, 0xd4, 0x9d, 0x48, 0x2b, 0xa3, 0xe8, 0x33, 0x19, 0x1a, 0xd4, 0xd3,
+	0x99, 0x08, 0x03, 0xec, 0xf8, 0xb8, 0x6a, 0x1e, 0x06, 0x2a, 0x50, 0x36, 0xeb, 0xc6, 0x5f, 0x09,
+	0xd6, 0xfa, 0xb1, 0x03, 0x07, 0x3c, 0x59, 0x3e, 0x8a, 0x57, 0xd3, 0x2a, 0xe4, 0xa5, 0xcf, 0x88,
+	0x4b, 0xda, 0x05, 0x2f, 0x2f, 0x7d, 0xca, 0x60, 0x6f, 0xaa, 0x51, 0x18, 0xa5, 0x59, 0xde, 0x25,
+	0xed, 0x92, 0xf7, 0x57, 0x52, 0x0a, 0x85, 0x48, 0x69, 0xc3, 0x76, 0xac, 0x6d, 0xbf, 0x2d, 0x3d,
+	0x13, 0x61, 0x88, 0x57, 0xac, 0x90, 0xd2, 0x89, 0xa4, 0x47, 0x50, 0xb2, 0xdb, 0xe3, 0x37, 0x11,
+	0xb2, 0x5d, 0x9b, 0x6d, 0x0d, 0xfa, 0x06, 0xca, 0x69, 0x13, 0x36, 0x2f, 0xba, 0xa4, 0x5d, 0x7d,
+	0x75, 0xd4, 0xf9, 0xa7, 0x87, 0x0e, 0xdf, 0x32, 0x5e, 0x76, 0x41, 0x5c, 0x3d, 0x12, 0x52, 0xf7,
+	0x43, 0x1f, 0x57, 0x6c, 0x2f, 0xa9, 0xfe, 0x60, 0x50, 0x17, 0xca, 0x62, 0xa1, 0xae, 0x43, 0xf3,
+	0x0e, 0x43, 0xb5, 0x60, 0xfb, 0x36, 0xcf, 0x5a, 0xd4, 0x01, 0x88, 0xb4, 0x9c, 0x62, 0x02, 0x94,
+	0x2c, 0x90, 0x71, 0x68, 0x1d, 0x8a, 0x09, 0xce, 0xc0, 0x25, 0xed, 0x5d, 0x2f, 0x55, 0xb4, 0x05,
+	0x07, 0xe9, 0x36, 0xc6, 0x31, 0xcc, 0xca, 0x36, 0x7d, 0xe4, 0xd1, 0x43, 0xd8, 0xb5, 0x95, 0xd8,
+	0x81, 0x0d, 0x13, 0x41, 0x9f, 0x43, 0x25, 0x12, 0xd3, 0x39, 0x1a, 0x2e, 0x17, 0xa8, 0xae, 0x0d,
+	0xab, 0xd8, 0x23, 0x7f, 0x6c, 0xc6, 0xff, 0xbd, 0x14, 0xf2, 0x0a, 0x7d, 0x56, 0x75, 0x49, 0x7b,
+	0xdf, 0x4b, 0x55, 0xeb, 0x23, 0x34, 0x7a, 0x9f, 0x31, 0x34, 0xd9, 0xd1, 0x9d, 0xda, 0xe8, 0x3f,
+	0x06, 0x58, 0x87, 0xa2, 0x46, 0xb1, 0x54, 0x61, 0x3a, 0xc2, 0x54, 0xb5, 0x46, 0x50, 0x1a, 0x88,
+	0xa5, 0x79, 0xd8, 0xbd, 0xb4, 0xa7, 0x4a, 0x2c, 0x93, 0x88, 0x6d, 0x4f, 0xf9, 0x6c, 0x4f, 0x75,
+	0x28, 0xce, 0x50, 0x06, 0xb3, 0xe4, 0x4e, 0xec, 0x78, 0xa9, 0x3a, 0xfe, 0x46, 0xa0, 0x9c, 0x19,
+	0x1d, 0x7d, 0x01, 0x8c, 0x7b, 0xfd, 0xb3, 0xb3, 0x9e, 0x37, 0xe1, 0xef, 0xc7, 0xbd, 0xc9, 0x05,
+	0x1f, 0x8d, 0x27, 0xc3, 0x13, 0xef, 0xbc, 0xc7, 0x6b, 0xb9, 0x66, 0xf5, 0xf6, 0xce, 0x85, 0x0b,
+	0xa3, 0xa2, 0xa1, 0xd0, 0x73, 0x34, 0xf4, 0x18, 0x1a, 0x4f, 0xe9, 0x41, 0x7f, 0xd8, 0xe7, 0x35,
+	0xd2, 0xac, 0xdc, 0xde, 0xb9, 0xa5, 0x18, 0x1e, 0xc8, 0x85, 0x34, 0x4f, 0x2a, 0xf3, 0x93, 0xf3,
+	0xde, 0x64, 0xec, 0x8d, 0x4e, 0xfb, 0xbc, 0x96, 0x4f, 0x2a, 0x73, 0x31, 0xc7, 0xb1, 0x56, 0x97,
+	0xd2, 0x34, 0x0b, 0x5f, 0xbe, 0x3b, 0xb9, 0xb7, 0x2f, 0x7f, 0xae, 0x1d, 0x72, 0xbf, 0x76, 0xc8,
+	0xef, 0xb5, 0x43, 0xbe, 0x6e, 0x9c, 0xdc, 0xfd, 0xc6, 0xc9, 0xfd, 0xda, 0x38, 0xb9, 0x0f, 0x8d,
+	0xcc, 0xfd, 0xeb, 0xae, 0xba, 0xf6, 0xa9, 0xdd, 0x44, 0xb8, 0xfc, 0x54, 0xb4, 0x8f, 0xe7, 0xf5,
+	0x9f, 0x01, 0x00, 0xa9, 0xb2, 0x95, 0xb2, 0x7e, 0x03, 0x00, 0x00,
+}
+
+func (m *TriggerOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.PacketTimeout != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.PacketTimeout))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Price != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.TriggerPrice != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.TriggerPrice))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.AmountDenom) > 0 {
+		i -= len(m.AmountDenom)
+		copy(dAtA[i:], m.AmountDenom)
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(len(m.AmountDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PairIndex) > 0 {
+		i -= len(m.PairIndex)
+		copy(dAtA[i:], m.PairIndex)
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(len(m.PairIndex)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TriggerType != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.TriggerType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTriggerOrderFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTriggerOrderFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTriggerOrderFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Price != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTriggerOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTriggerOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TriggerOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	if m.TriggerType != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.TriggerType))
+	}
+	l = len(m.PairIndex)
+	if l > 0 {
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	l = len(m.AmountDenom)
+	if l > 0 {
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.Amount))
+	}
+	if m.TriggerPrice != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.TriggerPrice))
+	}
+	if m.Price != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.Price))
+	}
+	if m.PacketTimeout != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.PacketTimeout))
+	}
+	if m.Failed {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventTriggerOrderFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *LastPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.Price))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.Height))
+	}
+	return n
+}
+
+func sovTriggerOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTriggerOrder(x uint64) (n int) {
+	return sovTriggerOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TriggerOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTriggerOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerType", wireType)
+			}
+			m.TriggerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerType |= TriggerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			m.TriggerPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerPrice |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeout", wireType)
+			}
+			m.PacketTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTriggerOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTriggerOrderFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTriggerOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTriggerOrderFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTriggerOrderFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTriggerOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTriggerOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTriggerOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTriggerOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTriggerOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTriggerOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTriggerOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTriggerOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTriggerOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTriggerOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTriggerOrder = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"interchange/x/dex/types"
+)
+
+func TestTriggerOrderIsTriggered(t *testing.T) {
+	for _, tc := range []struct {
+		desc        string
+		orderType   string
+		triggerType types.TriggerType
+		below       bool
+		above       bool
+	}{
+		{desc: "sell stop-market", orderType: types.OrderTypeSell, triggerType: types.StopMarket, below: true},
+		{desc: "sell stop-limit", orderType: types.OrderTypeSell, triggerType: types.StopLimit, below: true},
+		{desc: "sell take-profit", orderType: types.OrderTypeSell, triggerType: types.TakeProfit, above: true},
+		{desc: "buy stop-market", orderType: types.OrderTypeBuy, triggerType: types.StopMarket, above: true},
+		{desc: "buy stop-limit", orderType: types.OrderTypeBuy, triggerType: types.StopLimit, above: true},
+		{desc: "buy take-profit", orderType: types.OrderTypeBuy, triggerType: types.TakeProfit, below: true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			order := types.TriggerOrder{OrderType: tc.orderType, TriggerType: tc.triggerType, TriggerPrice: 20}
+			require.True(t, order.IsTriggered(20))
+			require.Equal(t, tc.below, order.IsTriggered(19))
+			require.Equal(t, tc.above, order.IsTriggered(21))
+		})
+	}
+}
+
+func TestTriggerOrderValidate(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		order types.TriggerOrder
+		price int32
+		valid bool
+	}{
+		{
+			desc:  "stop-market sell at any price",
+			order: types.TriggerOrder{OrderType: types.OrderTypeSell, TriggerType: types.StopMarket, Amount: 10, TriggerPrice: 20},
+			price: types.MinPrice,
+			valid: true,
+		},
+		{
+			desc:  "stop-market buy up to its price",
+			order: types.TriggerOrder{OrderType: types.OrderTypeBuy, TriggerType: types.StopMarket, Amount: 10, TriggerPrice: 20, Price: 25},
+			price: 25,
+			valid: true,
+		},
+		{
+			desc:  "stop-limit",
+			order: types.TriggerOrder{OrderType: types.OrderTypeSell, TriggerType: types.StopLimit, Amount: 10, TriggerPrice: 20, Price: 18},
+			price: 18,
+			valid: true,
+		},
+		{
+			desc:  "stop-market sell with price",
+			order: types.TriggerOrder{OrderType: types.OrderTypeSell, TriggerType: types.StopMarket, Amount: 10, TriggerPrice: 20, Price: 18},
+		},
+		{
+			desc:  "stop-market buy without price",
+			order: types.TriggerOrder{OrderType: types.OrderTypeBuy, TriggerType: types.StopMarket, Amount: 10, TriggerPrice: 20},
+		},
+		{
+			desc:  "take-profit without price",
+			order: types.TriggerOrder{OrderType: types.OrderTypeSell, TriggerType: types.TakeProfit, Amount: 10, TriggerPrice: 20},
+		},
+		{
+			desc:  "invalid order type",
+			order: types.TriggerOrder{OrderType: "swap", TriggerType: types.StopLimit, Amount: 10, TriggerPrice: 20, Price: 18},
+		},
+		{
+			desc:  "invalid trigger type",
+			order: types.TriggerOrder{OrderType: types.OrderTypeSell, TriggerType: 3, Amount: 10, TriggerPrice: 20, Price: 18},
+		},
+		{
+			desc:  "no trigger price",
+			order: types.TriggerOrder{OrderType: types.OrderTypeSell, TriggerType: types.StopLimit, Amount: 10, Price: 18},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.order.Validate()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.price, tc.order.OrderPrice())
+		})
+	}
+}
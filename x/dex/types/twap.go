@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TWAPRetention is the period the TWAP records are kept for, the TWAP can be computed
+// for any period starting after the retention
+const TWAPRetention = 48 * time.Hour
+
+// PriceOracle defines the interface the dex keeper provides to the modules consuming its prices (noalias)
+type PriceOracle interface {
+	// GetTWAP returns the time weighted average price of a pair between two times,
+	// in price denom per amount denom, from the trades matched on this chain and the
+	// orders of this chain settled by the counterparty chain
+	GetTWAP(ctx sdk.Context, pairIndex string, start time.Time, end time.Time) (sdk.Dec, error)
+}
+
+// CumulativePriceAt returns the cumulative price at a time after the record
+func (r TWAPRecord) CumulativePriceAt(t time.Time) sdk.Dec {
+	elapsed := t.Sub(r.Time)
+	if elapsed <= 0 {
+		return r.CumulativePrice
+	}
+	return r.CumulativePrice.Add(sdk.NewDec(int64(r.Price)).Mul(DurationToDec(elapsed)))
+}
+
+// Validate checks the record is well formed
+func (r TWAPRecord) Validate() error {
+	if r.Price < 0 {
+		return fmt.Errorf("twap record price cannot be negative: %d", r.Price)
+	}
+	if r.CumulativePrice.IsNil() || r.CumulativePrice.IsNegative() {
+		return fmt.Errorf("invalid twap record cumulative price: %s", r.CumulativePrice)
+	}
+	return nil
+}
+
+// DurationToDec returns the duration in seconds
+func DurationToDec(d time.Duration) sdk.Dec {
+	return sdk.NewDecWithPrec(d.Nanoseconds(), 9)
+}
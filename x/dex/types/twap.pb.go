@@ -0,0 +1,466 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/twap.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TWAPRecord is the price accumulator of a pair at the time of a block with trades.
+type TWAPRecord struct {
+	// index of the order book of the pair
+	PairIndex string    `protobuf:"bytes,1,opt,name=pairIndex,proto3" json:"pairIndex,omitempty"`
+	Time      time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// price of the last trade at the time
+	Price int32 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	// sum of the prices weighted by the seconds they lasted, since the first trade of the pair
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulativePrice"`
+}
+
+func (m *TWAPRecord) Reset()         { *m = TWAPRecord{} }
+func (m *TWAPRecord) String() string { return proto.CompactTextString(m) }
+func (*TWAPRecord) ProtoMessage()    {}
+func (*TWAPRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10aa4b136085207a, []int{0}
+}
+func (m *TWAPRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TWAPRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TWAPRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TWAPRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TWAPRecord.Merge(m, src)
+}
+func (m *TWAPRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TWAPRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TWAPRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TWAPRecord proto.InternalMessageInfo
+
+func (m *TWAPRecord) GetPairIndex() string {
+	if m != nil {
+		return m.PairIndex
+	}
+	return ""
+}
+
+func (m *TWAPRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *TWAPRecord) GetPrice() int32 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TWAPRecord)(nil), "interchange.dex.TWAPRecord")
+}
+
+func init() { proto.RegisterFile("dex/twap.proto", fileDescriptor_10aa4b136085207a) }
+
+var fileDescriptor_10aa4b136085207a = []byte{
+	// 293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x41, 0x4b, 0xfb, 0x30,
+	0x18, 0xc6, 0x9b, 0xff, 0x7f, 0x13, 0x17, 0xc1, 0x41, 0x19, 0x38, 0x86, 0xa4, 0xc5, 0x83, 0xf4,
+	0x62, 0x82, 0x7a, 0xf1, 0x6a, 0xf1, 0xe2, 0x6d, 0x94, 0x81, 0xe2, 0xad, 0x4d, 0x5f, 0xb3, 0xe0,
+	0xda, 0x94, 0x36, 0xd5, 0xfa, 0x2d, 0xf6, 0xb1, 0x76, 0xdc, 0xc1, 0x83, 0x78, 0x98, 0xd2, 0x7e,
+	0x11, 0x69, 0xba, 0xa1, 0x78, 0x4a, 0x9e, 0x3c, 0x79, 0x7e, 0x2f, 0xef, 0x83, 0x0f, 0x63, 0xa8,
+	0x98, 0x7e, 0x09, 0x33, 0x9a, 0xe5, 0x4a, 0x2b, 0x7b, 0x28, 0x53, 0x0d, 0x39, 0x9f, 0x87, 0xa9,
+	0x00, 0x1a, 0x43, 0x35, 0x19, 0x09, 0x25, 0x94, 0xf1, 0x58, 0x7b, 0xeb, 0xbe, 0x4d, 0x1c, 0xa1,
+	0x94, 0x58, 0x00, 0x33, 0x2a, 0x2a, 0x1f, 0x99, 0x96, 0x09, 0x14, 0x3a, 0x4c, 0xb6, 0x9c, 0x93,
+	0x37, 0x84, 0xf1, 0xec, 0xee, 0x7a, 0x1a, 0x00, 0x57, 0x79, 0x6c, 0x1f, 0xe3, 0x41, 0x16, 0xca,
+	0xfc, 0x36, 0x8d, 0xa1, 0x1a, 0x23, 0x17, 0x79, 0x83, 0xe0, 0xe7, 0xc1, 0xbe, 0xc2, 0xbd, 0x36,
+	0x3f, 0xfe, 0xe7, 0x22, 0xef, 0xe0, 0x62, 0x42, 0x3b, 0x38, 0xdd, 0xc1, 0xe9, 0x6c, 0x07, 0xf7,
+	0xf7, 0x57, 0x1b, 0xc7, 0x5a, 0x7e, 0x3a, 0x28, 0x30, 0x09, 0x7b, 0x84, 0xfb, 0x59, 0x2e, 0x39,
+	0x8c, 0xff, 0xbb, 0xc8, 0xeb, 0x07, 0x9d, 0xb0, 0xef, 0xf1, 0x90, 0x97, 0x49, 0xb9, 0x08, 0xb5,
+	0x7c, 0x86, 0xa9, 0xf1, 0x7b, 0xed, 0x4c, 0x9f, 0xb6, 0xf1, 0x8f, 0x8d, 0x73, 0x2a, 0xa4, 0x9e,
+	0x97, 0x11, 0xe5, 0x2a, 0x61, 0x5c, 0x15, 0x89, 0x2a, 0xb6, 0xc7, 0x59, 0x11, 0x3f, 0x31, 0xfd,
+	0x9a, 0x41, 0x41, 0x6f, 0x80, 0x07, 0x7f, 0x31, 0xfe, 0xf9, 0xaa, 0x26, 0x68, 0x5d, 0x13, 0xf4,
+	0x55, 0x13, 0xb4, 0x6c, 0x88, 0xb5, 0x6e, 0x88, 0xf5, 0xde, 0x10, 0xeb, 0xe1, 0xe8, 0x57, 0x71,
+	0xac, 0x62, 0xa6, 0xd6, 0x96, 0x13, 0xed, 0x99, 0x35, 0x2e, 0xbf, 0x07, 0x00, 0xff, 0xef, 0x93,
+	0xe1, 0x6a, 0x01, 0x00, 0x00,
+}
+
+func (m *TWAPRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TWAPRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TWAPRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Price != 0 {
+		i = encodeVarintTwap(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x18
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTwap(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.PairIndex) > 0 {
+		i -= len(m.PairIndex)
+		copy(dAtA[i:], m.PairIndex)
+		i = encodeVarintTwap(dAtA, i, uint64(len(m.PairIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TWAPRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairIndex)
+	if l > 0 {
+		n += 1 + l + sovTwap(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTwap(uint64(l))
+	if m.Price != 0 {
+		n += 1 + sovTwap(uint64(m.Price))
+	}
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovTwap(uint64(l))
+	return n
+}
+
+func sovTwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTwap(x uint64) (n int) {
+	return sovTwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TWAPRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TWAPRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TWAPRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTwap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTwap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTwap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTwap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTwap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTwap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTwap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTwap = fmt.Errorf("proto: unexpected end of group")
+)